@@ -0,0 +1,167 @@
+// Command braze-mock-server serves the in-memory Braze API used by the
+// provider tests over HTTP, so that Terraform configurations can be planned
+// and applied locally without Braze credentials.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+)
+
+const (
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 10 * time.Second
+	stateFileMode     = 0o600
+)
+
+func main() {
+	var (
+		host        string
+		port        int
+		fixturePath string
+		statePath   string
+	)
+
+	flag.StringVar(&host, "host", "127.0.0.1", "address to listen on")
+	flag.IntVar(&port, "port", 8080, "port to listen on")
+	flag.StringVar(&fixturePath, "fixture", "", "JSON or YAML file to seed content blocks, email templates, catalogs and catalog items from")
+	flag.StringVar(&statePath, "state", "", "JSON or YAML file to write the server state to on shutdown; loaded on startup if it exists and no fixture is given")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := run(ctx, net.JoinHostPort(host, strconv.Itoa(port)), fixturePath, statePath)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+func run(ctx context.Context, addr, fixturePath, statePath string) error {
+	server, err := newServer(fixturePath, statePath)
+	if err != nil {
+		return err
+	}
+
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           server,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	log.Printf("serving Braze mock API on http://%s", listener.Addr())
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		serveErr <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("serve: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	err = httpServer.Shutdown(shutdownCtx)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+
+	if statePath != "" {
+		err = writeFixture(statePath, server.Fixture())
+		if err != nil {
+			return err
+		}
+
+		log.Printf("saved state to %s", statePath)
+	}
+
+	return nil
+}
+
+// newServer creates a server seeded from fixturePath, or from statePath if
+// no fixture is given and a saved state exists.
+func newServer(fixturePath, statePath string) (*brazeclienttesting.Server, error) {
+	server, err := brazeclienttesting.NewBrazeServer()
+	if err != nil {
+		return nil, fmt.Errorf("create server: %w", err)
+	}
+
+	seedPath := fixturePath
+	if seedPath == "" && statePath != "" {
+		if _, err := os.Stat(statePath); err == nil {
+			seedPath = statePath
+		}
+	}
+
+	if seedPath != "" {
+		fixture, err := readFixture(seedPath)
+		if err != nil {
+			return nil, err
+		}
+
+		server.LoadFixture(fixture)
+		log.Printf("loaded %s", seedPath)
+	}
+
+	return server, nil
+}
+
+func readFixture(path string) (brazeclienttesting.Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return brazeclienttesting.Fixture{}, fmt.Errorf("read fixture: %w", err)
+	}
+
+	//nolint:wrapcheck
+	return brazeclienttesting.ParseFixture(data)
+}
+
+func writeFixture(path string, fixture brazeclienttesting.Fixture) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		data, err = brazeclienttesting.MarshalFixtureYAML(fixture)
+	default:
+		data, err = json.MarshalIndent(fixture, "", "  ")
+	}
+
+	if err != nil {
+		return fmt.Errorf("encode state: %w", err)
+	}
+
+	err = os.WriteFile(path, data, stateFileMode)
+	if err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+
+	return nil
+}
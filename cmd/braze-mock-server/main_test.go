@@ -0,0 +1,79 @@
+//nolint:testpackage
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testFixture = `
+content_blocks:
+  - id: cb-1
+    name: greeting
+    content: Hello
+    tags: [marketing]
+catalogs:
+  - name: products
+    fields:
+      - name: id
+        type: string
+    items:
+      - id: sku-1
+        fields:
+          price: 9.5
+`
+
+func TestServerStateRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, stateFile := range []string{"state.json", "state.yaml"} {
+		t.Run(stateFile, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			fixturePath := filepath.Join(dir, "fixture.yaml")
+			statePath := filepath.Join(dir, stateFile)
+
+			err := os.WriteFile(fixturePath, []byte(testFixture), stateFileMode)
+			if err != nil {
+				t.Fatalf("write fixture: %v", err)
+			}
+
+			seeded, err := newServer(fixturePath, statePath)
+			if err != nil {
+				t.Fatalf("newServer() error = %v", err)
+			}
+
+			if blocks := seeded.Fixture().ContentBlocks; len(blocks) != 1 || blocks[0].ID != "cb-1" {
+				t.Fatalf("expected the fixture content block, got %v", blocks)
+			}
+
+			seeded.SetContentBlock("cb-2", "farewell", "Goodbye", "", nil)
+
+			err = writeFixture(statePath, seeded.Fixture())
+			if err != nil {
+				t.Fatalf("writeFixture() error = %v", err)
+			}
+
+			restored, err := newServer("", statePath)
+			if err != nil {
+				t.Fatalf("newServer() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(seeded.Fixture(), restored.Fixture()) {
+				t.Errorf("expected state to round-trip, got %+v, want %+v", restored.Fixture(), seeded.Fixture())
+			}
+
+			reseeded, err := newServer(fixturePath, statePath)
+			if err != nil {
+				t.Fatalf("newServer() error = %v", err)
+			}
+
+			if blocks := reseeded.Fixture().ContentBlocks; len(blocks) != 1 {
+				t.Errorf("expected the fixture to take precedence over the saved state, got %v", blocks)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+data "braze_campaigns" "transactional" {
+  tag      = "transactional"
+  archived = false
+}
+
+output "api_triggered_campaign_ids" {
+  value = {
+    for campaign in data.braze_campaigns.transactional.campaigns : campaign.name => campaign.id
+    if campaign.api_triggered
+  }
+}
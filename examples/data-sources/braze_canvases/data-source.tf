@@ -0,0 +1,11 @@
+data "braze_canvases" "order_updates" {
+  name_regex = "^Order updates"
+  archived   = false
+}
+
+output "api_triggered_canvas_ids" {
+  value = {
+    for canvas in data.braze_canvases.order_updates.canvases : canvas.name => canvas.id
+    if canvas.api_triggered
+  }
+}
@@ -0,0 +1,5 @@
+data "braze_custom_attributes" "all" {}
+
+output "blocklisted_custom_attributes" {
+  value = [for attribute in data.braze_custom_attributes.all.custom_attributes : attribute.name if attribute.status == "Blocklisted"]
+}
@@ -0,0 +1,8 @@
+data "braze_custom_events" "all" {}
+
+check "checkout_event_active" {
+  assert {
+    condition     = contains([for event in data.braze_custom_events.all.custom_events : event.name if event.status == "Active"], "completed_checkout")
+    error_message = "The completed_checkout custom event is missing or blocklisted."
+  }
+}
@@ -0,0 +1,5 @@
+data "braze_products" "all" {}
+
+output "product_ids" {
+  value = data.braze_products.all.products[*].name
+}
@@ -0,0 +1,7 @@
+data "braze_segments" "lapsed_users" {
+  name = "Lapsed users"
+}
+
+output "lapsed_users_segment_id" {
+  value = one(data.braze_segments.lapsed_users.segments).id
+}
@@ -0,0 +1,21 @@
+resource "braze_campaign_trigger_schedule" "renewal_reminder" {
+  campaign_id = "00000000-0000-0000-0000-000000000000"
+
+  recipients = [
+    {
+      external_user_id        = "user-1"
+      trigger_properties_json = jsonencode({ plan = "annual" })
+    },
+    {
+      external_user_id = "user-2"
+    },
+  ]
+
+  trigger_properties_json = jsonencode({
+    renewal_date = "2030-01-15"
+  })
+
+  schedule = {
+    time = "2030-01-01T09:00:00Z"
+  }
+}
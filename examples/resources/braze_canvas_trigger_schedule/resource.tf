@@ -0,0 +1,13 @@
+resource "braze_canvas_trigger_schedule" "winter_sale" {
+  canvas_id = "00000000-0000-0000-0000-000000000000"
+  broadcast = true
+
+  canvas_entry_properties_json = jsonencode({
+    discount_code = "WINTER"
+  })
+
+  schedule = {
+    time            = "2030-01-01T00:00:00Z"
+    at_optimal_time = true
+  }
+}
@@ -0,0 +1,12 @@
+resource "braze_dashboard_user" "example" {
+  email               = "jane.doe@example.com"
+  given_name          = "Jane"
+  family_name         = "Doe"
+  department          = "engineering"
+  company_permissions = []
+
+  workspaces = [{
+    workspace_name = "Production"
+    permissions    = ["basic_access", "view_campaigns"]
+  }]
+}
@@ -0,0 +1,11 @@
+resource "braze_preference_center" "example" {
+  name                        = "example_preference_center"
+  preference_center_title     = "Email preferences"
+  preference_center_page_html = "<h1>Email preferences</h1>{{ preference_center_form }}"
+  confirmation_page_html      = "<p>Your preferences have been saved.</p>"
+  state                       = "active"
+
+  options = {
+    meta_viewport_content = "width=device-width, initial-scale=1"
+  }
+}
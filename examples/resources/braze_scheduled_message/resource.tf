@@ -0,0 +1,18 @@
+resource "braze_scheduled_message" "maintenance_notice" {
+  broadcast  = true
+  segment_id = "00000000-0000-0000-0000-000000000000"
+
+  schedule = {
+    time          = "2030-01-01T09:00:00Z"
+    in_local_time = true
+  }
+
+  messages = {
+    email_json = jsonencode({
+      app_id  = "00000000-0000-0000-0000-000000000000"
+      from    = "Example <notices@example.com>"
+      subject = "Scheduled maintenance"
+      body    = "<p>We will be down for maintenance tonight.</p>"
+    })
+  }
+}
@@ -0,0 +1,14 @@
+resource "braze_send_id" "spring_launch" {
+  campaign_id = "00000000-0000-0000-0000-000000000000"
+  send_id     = "spring-launch-2030"
+}
+
+resource "braze_campaign_trigger_schedule" "spring_launch" {
+  campaign_id = braze_send_id.spring_launch.campaign_id
+  send_id     = braze_send_id.spring_launch.send_id
+  broadcast   = true
+
+  schedule = {
+    time = "2030-03-20T09:00:00Z"
+  }
+}
@@ -0,0 +1,17 @@
+package brazeclient
+
+import (
+	"fmt"
+)
+
+// Format renders the error as its message, or as its id and message for
+// error details, so that ErrorResponseStatusCode.Error stays readable.
+func (s ErrorResponseErrorsItem) Format(f fmt.State, _ rune) {
+	if s.IsErrorDetail() {
+		fmt.Fprintf(f, "%s: %s", s.ErrorDetail.ID, s.ErrorDetail.Message)
+
+		return
+	}
+
+	fmt.Fprint(f, s.String)
+}
@@ -6,12 +6,19 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
 	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/otelogen"
 	"github.com/ogen-go/ogen/uri"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func trimTrailingSlashes(u *url.URL) {
@@ -21,6 +28,18 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// CreateCampaignTriggerSchedule invokes createCampaignTriggerSchedule operation.
+	//
+	// Schedule an API-triggered campaign to be sent at a designated time.
+	//
+	// POST /campaigns/trigger/schedule/create
+	CreateCampaignTriggerSchedule(ctx context.Context, request *CreateCampaignTriggerScheduleRequest) (*CreateCampaignTriggerScheduleResponse, error)
+	// CreateCanvasTriggerSchedule invokes createCanvasTriggerSchedule operation.
+	//
+	// Schedule an API-triggered Canvas to be sent at a designated time.
+	//
+	// POST /canvas/trigger/schedule/create
+	CreateCanvasTriggerSchedule(ctx context.Context, request *CreateCanvasTriggerScheduleRequest) (*CreateCanvasTriggerScheduleResponse, error)
 	// CreateCatalog invokes createCatalog operation.
 	//
 	// Create catalog.
@@ -39,12 +58,48 @@ type Invoker interface {
 	//
 	// POST /content_blocks/create
 	CreateContentBlock(ctx context.Context, request *CreateContentBlockRequest) (*CreateContentBlockResponse, error)
+	// CreateDashboardUser invokes createDashboardUser operation.
+	//
+	// Create a dashboard user account with permissions in workspaces.
+	//
+	// POST /scim/v2/Users
+	CreateDashboardUser(ctx context.Context, request *CreateDashboardUserRequest, params CreateDashboardUserParams) (*DashboardUser, error)
 	// CreateEmailTemplate invokes createEmailTemplate operation.
 	//
 	// Create an Email Template on the Braze dashboard.
 	//
 	// POST /templates/email/create
 	CreateEmailTemplate(ctx context.Context, request *CreateEmailTemplateRequest) (*CreateEmailTemplateResponse, error)
+	// CreatePreferenceCenter invokes createPreferenceCenter operation.
+	//
+	// Create a preference center to manage how users subscribe to your messaging.
+	//
+	// POST /preference_center/v1
+	CreatePreferenceCenter(ctx context.Context, request *CreatePreferenceCenterRequest) (*CreatePreferenceCenterResponse, error)
+	// CreateScheduledMessage invokes createScheduledMessage operation.
+	//
+	// Schedule a message to be sent at a designated time.
+	//
+	// POST /messages/schedule/create
+	CreateScheduledMessage(ctx context.Context, request *CreateScheduledMessageRequest) (*CreateScheduledMessageResponse, error)
+	// CreateSendID invokes createSendID operation.
+	//
+	// Create a send identifier for a campaign, to track the analytics of individual sends.
+	//
+	// POST /sends/id/create
+	CreateSendID(ctx context.Context, request *CreateSendIDRequest) (*CreateSendIDResponse, error)
+	// DeleteCampaignTriggerSchedule invokes deleteCampaignTriggerSchedule operation.
+	//
+	// Cancel a scheduled API-triggered campaign before it is sent.
+	//
+	// POST /campaigns/trigger/schedule/delete
+	DeleteCampaignTriggerSchedule(ctx context.Context, request *DeleteCampaignTriggerScheduleRequest) (*DeleteCampaignTriggerScheduleResponse, error)
+	// DeleteCanvasTriggerSchedule invokes deleteCanvasTriggerSchedule operation.
+	//
+	// Cancel a scheduled API-triggered Canvas before it is sent.
+	//
+	// POST /canvas/trigger/schedule/delete
+	DeleteCanvasTriggerSchedule(ctx context.Context, request *DeleteCanvasTriggerScheduleRequest) (*DeleteCanvasTriggerScheduleResponse, error)
 	// DeleteCatalog invokes deleteCatalog operation.
 	//
 	// Delete catalog.
@@ -57,6 +112,30 @@ type Invoker interface {
 	//
 	// DELETE /catalogs/{catalog_name}/items/{item_id}
 	DeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (*DeleteCatalogItemResponse, error)
+	// DeleteDashboardUser invokes deleteDashboardUser operation.
+	//
+	// Permanently delete a dashboard user account.
+	//
+	// DELETE /scim/v2/Users/{id}
+	DeleteDashboardUser(ctx context.Context, params DeleteDashboardUserParams) error
+	// DeleteScheduledMessage invokes deleteScheduledMessage operation.
+	//
+	// Cancel a scheduled message before it is sent.
+	//
+	// POST /messages/schedule/delete
+	DeleteScheduledMessage(ctx context.Context, request *DeleteScheduledMessageRequest) (*DeleteScheduledMessageResponse, error)
+	// GetCampaignDetails invokes getCampaignDetails operation.
+	//
+	// Retrieve the details of a campaign.
+	//
+	// GET /campaigns/details
+	GetCampaignDetails(ctx context.Context, params GetCampaignDetailsParams) (*GetCampaignDetailsResponse, error)
+	// GetCanvasDetails invokes getCanvasDetails operation.
+	//
+	// Retrieve the details of a Canvas.
+	//
+	// GET /canvas/details
+	GetCanvasDetails(ctx context.Context, params GetCanvasDetailsParams) (*GetCanvasDetailsResponse, error)
 	// GetCatalogItem invokes getCatalogItem operation.
 	//
 	// Get catalog item.
@@ -69,12 +148,42 @@ type Invoker interface {
 	//
 	// GET /content_blocks/info
 	GetContentBlockInfo(ctx context.Context, params GetContentBlockInfoParams) (*GetContentBlockInfoResponse, error)
+	// GetDashboardUser invokes getDashboardUser operation.
+	//
+	// Return a dashboard user account by its SCIM identifier.
+	//
+	// GET /scim/v2/Users/{id}
+	GetDashboardUser(ctx context.Context, params GetDashboardUserParams) (*DashboardUser, error)
 	// GetEmailTemplateInfo invokes getEmailTemplateInfo operation.
 	//
 	// Call information for your existing Email Templates.
 	//
 	// GET /templates/email/info
 	GetEmailTemplateInfo(ctx context.Context, params GetEmailTemplateInfoParams) (*GetEmailTemplateInfoResponse, error)
+	// GetPreferenceCenter invokes getPreferenceCenter operation.
+	//
+	// View the details for a preference center, including its HTML.
+	//
+	// GET /preference_center/v1/{preference_center_external_id}
+	GetPreferenceCenter(ctx context.Context, params GetPreferenceCenterParams) (*GetPreferenceCenterResponse, error)
+	// GetSegmentDetails invokes getSegmentDetails operation.
+	//
+	// Retrieve the details of a segment.
+	//
+	// GET /segments/details
+	GetSegmentDetails(ctx context.Context, params GetSegmentDetailsParams) (*GetSegmentDetailsResponse, error)
+	// ListCampaigns invokes listCampaigns operation.
+	//
+	// List your campaigns, including their tags and whether they are API campaigns.
+	//
+	// GET /campaigns/list
+	ListCampaigns(ctx context.Context, params ListCampaignsParams) (*ListCampaignsResponse, error)
+	// ListCanvases invokes listCanvases operation.
+	//
+	// List your Canvases, including their tags.
+	//
+	// GET /canvas/list
+	ListCanvases(ctx context.Context, params ListCanvasesParams) (*ListCanvasesResponse, error)
 	// ListCatalogItems invokes listCatalogItems operation.
 	//
 	// List catalog items.
@@ -86,37 +195,104 @@ type Invoker interface {
 	// List catalogs.
 	//
 	// GET /catalogs
-	ListCatalogs(ctx context.Context) (*ListCatalogsResponse, error)
+	ListCatalogs(ctx context.Context, params ListCatalogsParams) (*ListCatalogsResponseHeaders, error)
 	// ListContentBlocks invokes listContentBlocks operation.
 	//
 	// List your existing Content Blocks information.
 	//
 	// GET /content_blocks/list
 	ListContentBlocks(ctx context.Context, params ListContentBlocksParams) (*ListContentBlocksResponse, error)
+	// ListCustomAttributes invokes listCustomAttributes operation.
+	//
+	// List the custom attributes recorded in the workspace, with their data types, descriptions, tags
+	// and status.
+	//
+	// GET /custom_attributes
+	ListCustomAttributes(ctx context.Context, params ListCustomAttributesParams) (*ListCustomAttributesResponseHeaders, error)
+	// ListCustomEvents invokes listCustomEvents operation.
+	//
+	// List the custom events recorded in the workspace, with their descriptions, tags and status.
+	//
+	// GET /events
+	ListCustomEvents(ctx context.Context, params ListCustomEventsParams) (*ListCustomEventsResponseHeaders, error)
 	// ListEmailTemplates invokes listEmailTemplates operation.
 	//
 	// List your existing Email Templates information.
 	//
 	// GET /templates/email/list
 	ListEmailTemplates(ctx context.Context, params ListEmailTemplatesParams) (*ListEmailTemplatesResponse, error)
+	// ListPreferenceCenters invokes listPreferenceCenters operation.
+	//
+	// List your available preference centers.
+	//
+	// GET /preference_center/v1/list
+	ListPreferenceCenters(ctx context.Context) (*ListPreferenceCentersResponse, error)
+	// ListProducts invokes listProducts operation.
+	//
+	// List the product identifiers of purchases logged in the workspace.
+	//
+	// GET /purchases/product_list
+	ListProducts(ctx context.Context, params ListProductsParams) (*ListProductsResponse, error)
+	// ListSegments invokes listSegments operation.
+	//
+	// List your segments, including their tags and whether analytics tracking is enabled.
+	//
+	// GET /segments/list
+	ListSegments(ctx context.Context, params ListSegmentsParams) (*ListSegmentsResponse, error)
 	// ReplaceCatalogItem invokes replaceCatalogItem operation.
 	//
 	// Replace catalog item.
 	//
 	// PUT /catalogs/{catalog_name}/items/{item_id}
 	ReplaceCatalogItem(ctx context.Context, request *ReplaceCatalogItemRequest, params ReplaceCatalogItemParams) (*CatalogItemOperationResponse, error)
+	// SearchDashboardUsers invokes searchDashboardUsers operation.
+	//
+	// Look up dashboard users matching a SCIM filter on userName.
+	//
+	// GET /scim/v2/Users
+	SearchDashboardUsers(ctx context.Context, params SearchDashboardUsersParams) (*SearchDashboardUsersResponse, error)
+	// UpdateCampaignTriggerSchedule invokes updateCampaignTriggerSchedule operation.
+	//
+	// Update the schedule of an API-triggered campaign.
+	//
+	// POST /campaigns/trigger/schedule/update
+	UpdateCampaignTriggerSchedule(ctx context.Context, request *UpdateCampaignTriggerScheduleRequest) (*UpdateCampaignTriggerScheduleResponse, error)
+	// UpdateCanvasTriggerSchedule invokes updateCanvasTriggerSchedule operation.
+	//
+	// Update the schedule of an API-triggered Canvas.
+	//
+	// POST /canvas/trigger/schedule/update
+	UpdateCanvasTriggerSchedule(ctx context.Context, request *UpdateCanvasTriggerScheduleRequest) (*UpdateCanvasTriggerScheduleResponse, error)
 	// UpdateContentBlock invokes updateContentBlock operation.
 	//
 	// Update a Content Block on the Braze dashboard.
 	//
 	// POST /content_blocks/update
 	UpdateContentBlock(ctx context.Context, request *UpdateContentBlockRequest) (UpdateContentBlockRes, error)
+	// UpdateDashboardUser invokes updateDashboardUser operation.
+	//
+	// Replace the name, department and permissions of a dashboard user account.
+	//
+	// PUT /scim/v2/Users/{id}
+	UpdateDashboardUser(ctx context.Context, request *UpdateDashboardUserRequest, params UpdateDashboardUserParams) (*DashboardUser, error)
 	// UpdateEmailTemplate invokes updateEmailTemplate operation.
 	//
 	// Update an Email Template on the Braze dashboard.
 	//
 	// POST /templates/email/update
 	UpdateEmailTemplate(ctx context.Context, request *UpdateEmailTemplateRequest) (*UpdateEmailTemplateResponse, error)
+	// UpdatePreferenceCenter invokes updatePreferenceCenter operation.
+	//
+	// Update an existing preference center.
+	//
+	// PUT /preference_center/v1/{preference_center_external_id}
+	UpdatePreferenceCenter(ctx context.Context, request *UpdatePreferenceCenterRequest, params UpdatePreferenceCenterParams) (*UpdatePreferenceCenterResponse, error)
+	// UpdateScheduledMessage invokes updateScheduledMessage operation.
+	//
+	// Update the schedule or messages of a scheduled message.
+	//
+	// POST /messages/schedule/update
+	UpdateScheduledMessage(ctx context.Context, request *UpdateScheduledMessageRequest) (*UpdateScheduledMessageResponse, error)
 }
 
 // Client implements OAS client.
@@ -160,6 +336,226 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
+// CreateCampaignTriggerSchedule invokes createCampaignTriggerSchedule operation.
+//
+// Schedule an API-triggered campaign to be sent at a designated time.
+//
+// POST /campaigns/trigger/schedule/create
+func (c *Client) CreateCampaignTriggerSchedule(ctx context.Context, request *CreateCampaignTriggerScheduleRequest) (*CreateCampaignTriggerScheduleResponse, error) {
+	res, err := c.sendCreateCampaignTriggerSchedule(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendCreateCampaignTriggerSchedule(ctx context.Context, request *CreateCampaignTriggerScheduleRequest) (res *CreateCampaignTriggerScheduleResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createCampaignTriggerSchedule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/campaigns/trigger/schedule/create"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCampaignTriggerScheduleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/campaigns/trigger/schedule/create"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateCampaignTriggerScheduleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, CreateCampaignTriggerScheduleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateCampaignTriggerScheduleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateCanvasTriggerSchedule invokes createCanvasTriggerSchedule operation.
+//
+// Schedule an API-triggered Canvas to be sent at a designated time.
+//
+// POST /canvas/trigger/schedule/create
+func (c *Client) CreateCanvasTriggerSchedule(ctx context.Context, request *CreateCanvasTriggerScheduleRequest) (*CreateCanvasTriggerScheduleResponse, error) {
+	res, err := c.sendCreateCanvasTriggerSchedule(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendCreateCanvasTriggerSchedule(ctx context.Context, request *CreateCanvasTriggerScheduleRequest) (res *CreateCanvasTriggerScheduleResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createCanvasTriggerSchedule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/canvas/trigger/schedule/create"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCanvasTriggerScheduleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/canvas/trigger/schedule/create"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateCanvasTriggerScheduleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, CreateCanvasTriggerScheduleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateCanvasTriggerScheduleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // CreateCatalog invokes createCatalog operation.
 //
 // Create catalog.
@@ -180,12 +576,47 @@ func (c *Client) sendCreateCatalog(ctx context.Context, request *CreateCatalogRe
 	}(); err != nil {
 		return res, errors.Wrap(err, "validate")
 	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createCatalog"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/catalogs"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCatalogOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
 	pathParts[0] = "/catalogs"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -198,7 +629,7 @@ func (c *Client) sendCreateCatalog(ctx context.Context, request *CreateCatalogRe
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
+			stage = "Security:BrazeApiKey"
 			switch err := c.securityBrazeApiKey(ctx, CreateCatalogOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
@@ -227,6 +658,7 @@ func (c *Client) sendCreateCatalog(ctx context.Context, request *CreateCatalogRe
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -234,6 +666,7 @@ func (c *Client) sendCreateCatalog(ctx context.Context, request *CreateCatalogRe
 	body := resp.Body
 	defer body.Close()
 
+	stage = "DecodeResponse"
 	result, err := decodeCreateCatalogResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
@@ -262,7 +695,41 @@ func (c *Client) sendCreateCatalogItem(ctx context.Context, request *CreateCatal
 	}(); err != nil {
 		return res, errors.Wrap(err, "validate")
 	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createCatalogItem"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/catalogs/{catalog_name}/items/{item_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateCatalogItemOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
 	pathParts[0] = "/catalogs/"
@@ -305,6 +772,7 @@ func (c *Client) sendCreateCatalogItem(ctx context.Context, request *CreateCatal
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -317,7 +785,7 @@ func (c *Client) sendCreateCatalogItem(ctx context.Context, request *CreateCatal
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
+			stage = "Security:BrazeApiKey"
 			switch err := c.securityBrazeApiKey(ctx, CreateCatalogItemOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
@@ -346,6 +814,7 @@ func (c *Client) sendCreateCatalogItem(ctx context.Context, request *CreateCatal
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -353,6 +822,7 @@ func (c *Client) sendCreateCatalogItem(ctx context.Context, request *CreateCatal
 	body := resp.Body
 	defer body.Close()
 
+	stage = "DecodeResponse"
 	result, err := decodeCreateCatalogItemResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
@@ -381,12 +851,47 @@ func (c *Client) sendCreateContentBlock(ctx context.Context, request *CreateCont
 	}(); err != nil {
 		return res, errors.Wrap(err, "validate")
 	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createContentBlock"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/content_blocks/create"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateContentBlockOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
 	pathParts[0] = "/content_blocks/create"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -399,7 +904,7 @@ func (c *Client) sendCreateContentBlock(ctx context.Context, request *CreateCont
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
+			stage = "Security:BrazeApiKey"
 			switch err := c.securityBrazeApiKey(ctx, CreateContentBlockOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
@@ -428,6 +933,7 @@ func (c *Client) sendCreateContentBlock(ctx context.Context, request *CreateCont
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -435,6 +941,7 @@ func (c *Client) sendCreateContentBlock(ctx context.Context, request *CreateCont
 	body := resp.Body
 	defer body.Close()
 
+	stage = "DecodeResponse"
 	result, err := decodeCreateContentBlockResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
@@ -443,17 +950,17 @@ func (c *Client) sendCreateContentBlock(ctx context.Context, request *CreateCont
 	return result, nil
 }
 
-// CreateEmailTemplate invokes createEmailTemplate operation.
+// CreateDashboardUser invokes createDashboardUser operation.
 //
-// Create an Email Template on the Braze dashboard.
+// Create a dashboard user account with permissions in workspaces.
 //
-// POST /templates/email/create
-func (c *Client) CreateEmailTemplate(ctx context.Context, request *CreateEmailTemplateRequest) (*CreateEmailTemplateResponse, error) {
-	res, err := c.sendCreateEmailTemplate(ctx, request)
+// POST /scim/v2/Users
+func (c *Client) CreateDashboardUser(ctx context.Context, request *CreateDashboardUserRequest, params CreateDashboardUserParams) (*DashboardUser, error) {
+	res, err := c.sendCreateDashboardUser(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateEmailTemplate(ctx context.Context, request *CreateEmailTemplateRequest) (res *CreateEmailTemplateResponse, err error) {
+func (c *Client) sendCreateDashboardUser(ctx context.Context, request *CreateDashboardUserRequest, params CreateDashboardUserParams) (res *DashboardUser, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -463,32 +970,81 @@ func (c *Client) sendCreateEmailTemplate(ctx context.Context, request *CreateEma
 	}(); err != nil {
 		return res, errors.Wrap(err, "validate")
 	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createDashboardUser"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/scim/v2/Users"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateDashboardUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/templates/email/create"
+	pathParts[0] = "/scim/v2/Users"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateEmailTemplateRequest(request, r); err != nil {
+	if err := encodeCreateDashboardUserRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.XRequestOrigin))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, CreateEmailTemplateOperation, r); {
+			stage = "Security:BrazeScimToken"
+			switch err := c.securityBrazeScimToken(ctx, CreateDashboardUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
-				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+				return res, errors.Wrap(err, "security \"BrazeScimToken\"")
 			}
 		}
 
@@ -510,6 +1066,7 @@ func (c *Client) sendCreateEmailTemplate(ctx context.Context, request *CreateEma
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -517,7 +1074,8 @@ func (c *Client) sendCreateEmailTemplate(ctx context.Context, request *CreateEma
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeCreateEmailTemplateResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeCreateDashboardUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -525,52 +1083,81 @@ func (c *Client) sendCreateEmailTemplate(ctx context.Context, request *CreateEma
 	return result, nil
 }
 
-// DeleteCatalog invokes deleteCatalog operation.
+// CreateEmailTemplate invokes createEmailTemplate operation.
 //
-// Delete catalog.
+// Create an Email Template on the Braze dashboard.
 //
-// DELETE /catalogs/{catalog_name}
-func (c *Client) DeleteCatalog(ctx context.Context, params DeleteCatalogParams) (*DeleteCatalogResponse, error) {
-	res, err := c.sendDeleteCatalog(ctx, params)
+// POST /templates/email/create
+func (c *Client) CreateEmailTemplate(ctx context.Context, request *CreateEmailTemplateRequest) (*CreateEmailTemplateResponse, error) {
+	res, err := c.sendCreateEmailTemplate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteCatalog(ctx context.Context, params DeleteCatalogParams) (res *DeleteCatalogResponse, err error) {
+func (c *Client) sendCreateEmailTemplate(ctx context.Context, request *CreateEmailTemplateRequest) (res *CreateEmailTemplateResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createEmailTemplate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/templates/email/create"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateEmailTemplateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/catalogs/"
-	{
-		// Encode "catalog_name" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "catalog_name",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.CatalogName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/templates/email/create"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateEmailTemplateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, DeleteCatalogOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, CreateEmailTemplateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -598,6 +1185,7 @@ func (c *Client) sendDeleteCatalog(ctx context.Context, params DeleteCatalogPara
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -605,7 +1193,8 @@ func (c *Client) sendDeleteCatalog(ctx context.Context, params DeleteCatalogPara
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeDeleteCatalogResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeCreateEmailTemplateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -613,71 +1202,81 @@ func (c *Client) sendDeleteCatalog(ctx context.Context, params DeleteCatalogPara
 	return result, nil
 }
 
-// DeleteCatalogItem invokes deleteCatalogItem operation.
+// CreatePreferenceCenter invokes createPreferenceCenter operation.
 //
-// Delete catalog item.
+// Create a preference center to manage how users subscribe to your messaging.
 //
-// DELETE /catalogs/{catalog_name}/items/{item_id}
-func (c *Client) DeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (*DeleteCatalogItemResponse, error) {
-	res, err := c.sendDeleteCatalogItem(ctx, params)
+// POST /preference_center/v1
+func (c *Client) CreatePreferenceCenter(ctx context.Context, request *CreatePreferenceCenterRequest) (*CreatePreferenceCenterResponse, error) {
+	res, err := c.sendCreatePreferenceCenter(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendDeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (res *DeleteCatalogItemResponse, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/catalogs/"
-	{
-		// Encode "catalog_name" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "catalog_name",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.CatalogName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+func (c *Client) sendCreatePreferenceCenter(ctx context.Context, request *CreatePreferenceCenterRequest) (res *CreatePreferenceCenterResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
 		}
-		pathParts[1] = encoded
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
 	}
-	pathParts[2] = "/items/"
-	{
-		// Encode "item_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "item_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ItemID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createPreferenceCenter"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/preference_center/v1"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreatePreferenceCenterOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
 		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[3] = encoded
-	}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/preference_center/v1"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreatePreferenceCenterRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, DeleteCatalogItemOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, CreatePreferenceCenterOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -705,6 +1304,7 @@ func (c *Client) sendDeleteCatalogItem(ctx context.Context, params DeleteCatalog
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -712,7 +1312,8 @@ func (c *Client) sendDeleteCatalogItem(ctx context.Context, params DeleteCatalog
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeDeleteCatalogItemResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeCreatePreferenceCenterResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -720,71 +1321,72 @@ func (c *Client) sendDeleteCatalogItem(ctx context.Context, params DeleteCatalog
 	return result, nil
 }
 
-// GetCatalogItem invokes getCatalogItem operation.
+// CreateScheduledMessage invokes createScheduledMessage operation.
 //
-// Get catalog item.
+// Schedule a message to be sent at a designated time.
 //
-// GET /catalogs/{catalog_name}/items/{item_id}
-func (c *Client) GetCatalogItem(ctx context.Context, params GetCatalogItemParams) (*GetCatalogItemResponse, error) {
-	res, err := c.sendGetCatalogItem(ctx, params)
+// POST /messages/schedule/create
+func (c *Client) CreateScheduledMessage(ctx context.Context, request *CreateScheduledMessageRequest) (*CreateScheduledMessageResponse, error) {
+	res, err := c.sendCreateScheduledMessage(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetCatalogItem(ctx context.Context, params GetCatalogItemParams) (res *GetCatalogItemResponse, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/catalogs/"
-	{
-		// Encode "catalog_name" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "catalog_name",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.CatalogName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
+func (c *Client) sendCreateScheduledMessage(ctx context.Context, request *CreateScheduledMessageRequest) (res *CreateScheduledMessageResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createScheduledMessage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/messages/schedule/create"),
 	}
-	pathParts[2] = "/items/"
-	{
-		// Encode "item_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "item_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ItemID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateScheduledMessageOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
 		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[3] = encoded
-	}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/messages/schedule/create"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateScheduledMessageRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, GetCatalogItemOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, CreateScheduledMessageOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -812,6 +1414,7 @@ func (c *Client) sendGetCatalogItem(ctx context.Context, params GetCatalogItemPa
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -819,7 +1422,8 @@ func (c *Client) sendGetCatalogItem(ctx context.Context, params GetCatalogItemPa
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeGetCatalogItemResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeCreateScheduledMessageResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -827,68 +1431,72 @@ func (c *Client) sendGetCatalogItem(ctx context.Context, params GetCatalogItemPa
 	return result, nil
 }
 
-// GetContentBlockInfo invokes getContentBlockInfo operation.
+// CreateSendID invokes createSendID operation.
 //
-// Call information for your existing Content Blocks.
+// Create a send identifier for a campaign, to track the analytics of individual sends.
 //
-// GET /content_blocks/info
-func (c *Client) GetContentBlockInfo(ctx context.Context, params GetContentBlockInfoParams) (*GetContentBlockInfoResponse, error) {
-	res, err := c.sendGetContentBlockInfo(ctx, params)
+// POST /sends/id/create
+func (c *Client) CreateSendID(ctx context.Context, request *CreateSendIDRequest) (*CreateSendIDResponse, error) {
+	res, err := c.sendCreateSendID(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetContentBlockInfo(ctx context.Context, params GetContentBlockInfoParams) (res *GetContentBlockInfoResponse, err error) {
+func (c *Client) sendCreateSendID(ctx context.Context, request *CreateSendIDRequest) (res *CreateSendIDResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createSendID"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/sends/id/create"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateSendIDOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/content_blocks/info"
+	pathParts[0] = "/sends/id/create"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "content_block_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "content_block_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.ContentBlockID))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "include_inclusion_data" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "include_inclusion_data",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.IncludeInclusionData.Get(); ok {
-				return e.EncodeValue(conv.BoolToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "GET", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateSendIDRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, GetContentBlockInfoOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, CreateSendIDOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -916,6 +1524,7 @@ func (c *Client) sendGetContentBlockInfo(ctx context.Context, params GetContentB
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -923,7 +1532,8 @@ func (c *Client) sendGetContentBlockInfo(ctx context.Context, params GetContentB
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeGetContentBlockInfoResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeCreateSendIDResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -931,51 +1541,72 @@ func (c *Client) sendGetContentBlockInfo(ctx context.Context, params GetContentB
 	return result, nil
 }
 
-// GetEmailTemplateInfo invokes getEmailTemplateInfo operation.
+// DeleteCampaignTriggerSchedule invokes deleteCampaignTriggerSchedule operation.
 //
-// Call information for your existing Email Templates.
+// Cancel a scheduled API-triggered campaign before it is sent.
 //
-// GET /templates/email/info
-func (c *Client) GetEmailTemplateInfo(ctx context.Context, params GetEmailTemplateInfoParams) (*GetEmailTemplateInfoResponse, error) {
-	res, err := c.sendGetEmailTemplateInfo(ctx, params)
+// POST /campaigns/trigger/schedule/delete
+func (c *Client) DeleteCampaignTriggerSchedule(ctx context.Context, request *DeleteCampaignTriggerScheduleRequest) (*DeleteCampaignTriggerScheduleResponse, error) {
+	res, err := c.sendDeleteCampaignTriggerSchedule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetEmailTemplateInfo(ctx context.Context, params GetEmailTemplateInfoParams) (res *GetEmailTemplateInfoResponse, err error) {
+func (c *Client) sendDeleteCampaignTriggerSchedule(ctx context.Context, request *DeleteCampaignTriggerScheduleRequest) (res *DeleteCampaignTriggerScheduleResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteCampaignTriggerSchedule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/campaigns/trigger/schedule/delete"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCampaignTriggerScheduleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/templates/email/info"
+	pathParts[0] = "/campaigns/trigger/schedule/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "email_template_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "email_template_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.EmailTemplateID))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "GET", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeDeleteCampaignTriggerScheduleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, GetEmailTemplateInfoOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, DeleteCampaignTriggerScheduleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1003,6 +1634,7 @@ func (c *Client) sendGetEmailTemplateInfo(ctx context.Context, params GetEmailTe
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1010,7 +1642,8 @@ func (c *Client) sendGetEmailTemplateInfo(ctx context.Context, params GetEmailTe
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeGetEmailTemplateInfoResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeDeleteCampaignTriggerScheduleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1018,79 +1651,3569 @@ func (c *Client) sendGetEmailTemplateInfo(ctx context.Context, params GetEmailTe
 	return result, nil
 }
 
-// ListCatalogItems invokes listCatalogItems operation.
+// DeleteCanvasTriggerSchedule invokes deleteCanvasTriggerSchedule operation.
 //
-// List catalog items.
+// Cancel a scheduled API-triggered Canvas before it is sent.
 //
-// GET /catalogs/{catalog_name}/items
-func (c *Client) ListCatalogItems(ctx context.Context, params ListCatalogItemsParams) (*ListCatalogItemsResponseHeaders, error) {
-	res, err := c.sendListCatalogItems(ctx, params)
+// POST /canvas/trigger/schedule/delete
+func (c *Client) DeleteCanvasTriggerSchedule(ctx context.Context, request *DeleteCanvasTriggerScheduleRequest) (*DeleteCanvasTriggerScheduleResponse, error) {
+	res, err := c.sendDeleteCanvasTriggerSchedule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListCatalogItems(ctx context.Context, params ListCatalogItemsParams) (res *ListCatalogItemsResponseHeaders, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/catalogs/"
-	{
-		// Encode "catalog_name" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "catalog_name",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.CatalogName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
+func (c *Client) sendDeleteCanvasTriggerSchedule(ctx context.Context, request *DeleteCanvasTriggerScheduleRequest) (res *DeleteCanvasTriggerScheduleResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteCanvasTriggerSchedule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/canvas/trigger/schedule/delete"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCanvasTriggerScheduleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
 		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/items"
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/canvas/trigger/schedule/delete"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "cursor" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "cursor",
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDeleteCanvasTriggerScheduleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, DeleteCanvasTriggerScheduleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteCanvasTriggerScheduleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteCatalog invokes deleteCatalog operation.
+//
+// Delete catalog.
+//
+// DELETE /catalogs/{catalog_name}
+func (c *Client) DeleteCatalog(ctx context.Context, params DeleteCatalogParams) (*DeleteCatalogResponse, error) {
+	res, err := c.sendDeleteCatalog(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteCatalog(ctx context.Context, params DeleteCatalogParams) (res *DeleteCatalogResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteCatalog"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/catalogs/{catalog_name}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCatalogOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/catalogs/"
+	{
+		// Encode "catalog_name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "catalog_name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CatalogName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, DeleteCatalogOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteCatalogResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteCatalogItem invokes deleteCatalogItem operation.
+//
+// Delete catalog item.
+//
+// DELETE /catalogs/{catalog_name}/items/{item_id}
+func (c *Client) DeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (*DeleteCatalogItemResponse, error) {
+	res, err := c.sendDeleteCatalogItem(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (res *DeleteCatalogItemResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteCatalogItem"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/catalogs/{catalog_name}/items/{item_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteCatalogItemOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/catalogs/"
+	{
+		// Encode "catalog_name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "catalog_name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CatalogName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/items/"
+	{
+		// Encode "item_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "item_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ItemID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, DeleteCatalogItemOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteCatalogItemResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteDashboardUser invokes deleteDashboardUser operation.
+//
+// Permanently delete a dashboard user account.
+//
+// DELETE /scim/v2/Users/{id}
+func (c *Client) DeleteDashboardUser(ctx context.Context, params DeleteDashboardUserParams) error {
+	_, err := c.sendDeleteDashboardUser(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteDashboardUser(ctx context.Context, params DeleteDashboardUserParams) (res *DeleteDashboardUserNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteDashboardUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/scim/v2/Users/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteDashboardUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/scim/v2/Users/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.XRequestOrigin))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeScimToken"
+			switch err := c.securityBrazeScimToken(ctx, DeleteDashboardUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeScimToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteDashboardUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteScheduledMessage invokes deleteScheduledMessage operation.
+//
+// Cancel a scheduled message before it is sent.
+//
+// POST /messages/schedule/delete
+func (c *Client) DeleteScheduledMessage(ctx context.Context, request *DeleteScheduledMessageRequest) (*DeleteScheduledMessageResponse, error) {
+	res, err := c.sendDeleteScheduledMessage(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDeleteScheduledMessage(ctx context.Context, request *DeleteScheduledMessageRequest) (res *DeleteScheduledMessageResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteScheduledMessage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/messages/schedule/delete"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteScheduledMessageOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/messages/schedule/delete"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDeleteScheduledMessageRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, DeleteScheduledMessageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteScheduledMessageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCampaignDetails invokes getCampaignDetails operation.
+//
+// Retrieve the details of a campaign.
+//
+// GET /campaigns/details
+func (c *Client) GetCampaignDetails(ctx context.Context, params GetCampaignDetailsParams) (*GetCampaignDetailsResponse, error) {
+	res, err := c.sendGetCampaignDetails(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCampaignDetails(ctx context.Context, params GetCampaignDetailsParams) (res *GetCampaignDetailsResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCampaignDetails"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/campaigns/details"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCampaignDetailsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/campaigns/details"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "campaign_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "campaign_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.CampaignID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, GetCampaignDetailsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetCampaignDetailsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCanvasDetails invokes getCanvasDetails operation.
+//
+// Retrieve the details of a Canvas.
+//
+// GET /canvas/details
+func (c *Client) GetCanvasDetails(ctx context.Context, params GetCanvasDetailsParams) (*GetCanvasDetailsResponse, error) {
+	res, err := c.sendGetCanvasDetails(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCanvasDetails(ctx context.Context, params GetCanvasDetailsParams) (res *GetCanvasDetailsResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCanvasDetails"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/canvas/details"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCanvasDetailsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/canvas/details"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "canvas_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "canvas_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.CanvasID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, GetCanvasDetailsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetCanvasDetailsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCatalogItem invokes getCatalogItem operation.
+//
+// Get catalog item.
+//
+// GET /catalogs/{catalog_name}/items/{item_id}
+func (c *Client) GetCatalogItem(ctx context.Context, params GetCatalogItemParams) (*GetCatalogItemResponse, error) {
+	res, err := c.sendGetCatalogItem(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCatalogItem(ctx context.Context, params GetCatalogItemParams) (res *GetCatalogItemResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCatalogItem"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/catalogs/{catalog_name}/items/{item_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCatalogItemOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/catalogs/"
+	{
+		// Encode "catalog_name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "catalog_name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CatalogName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/items/"
+	{
+		// Encode "item_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "item_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ItemID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, GetCatalogItemOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetCatalogItemResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetContentBlockInfo invokes getContentBlockInfo operation.
+//
+// Call information for your existing Content Blocks.
+//
+// GET /content_blocks/info
+func (c *Client) GetContentBlockInfo(ctx context.Context, params GetContentBlockInfoParams) (*GetContentBlockInfoResponse, error) {
+	res, err := c.sendGetContentBlockInfo(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetContentBlockInfo(ctx context.Context, params GetContentBlockInfoParams) (res *GetContentBlockInfoResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getContentBlockInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/content_blocks/info"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetContentBlockInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/content_blocks/info"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "content_block_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "content_block_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.ContentBlockID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "include_inclusion_data" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "include_inclusion_data",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeInclusionData.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, GetContentBlockInfoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetContentBlockInfoResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetDashboardUser invokes getDashboardUser operation.
+//
+// Return a dashboard user account by its SCIM identifier.
+//
+// GET /scim/v2/Users/{id}
+func (c *Client) GetDashboardUser(ctx context.Context, params GetDashboardUserParams) (*DashboardUser, error) {
+	res, err := c.sendGetDashboardUser(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetDashboardUser(ctx context.Context, params GetDashboardUserParams) (res *DashboardUser, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDashboardUser"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/scim/v2/Users/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetDashboardUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/scim/v2/Users/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.XRequestOrigin))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeScimToken"
+			switch err := c.securityBrazeScimToken(ctx, GetDashboardUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeScimToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetDashboardUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetEmailTemplateInfo invokes getEmailTemplateInfo operation.
+//
+// Call information for your existing Email Templates.
+//
+// GET /templates/email/info
+func (c *Client) GetEmailTemplateInfo(ctx context.Context, params GetEmailTemplateInfoParams) (*GetEmailTemplateInfoResponse, error) {
+	res, err := c.sendGetEmailTemplateInfo(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetEmailTemplateInfo(ctx context.Context, params GetEmailTemplateInfoParams) (res *GetEmailTemplateInfoResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getEmailTemplateInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/templates/email/info"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetEmailTemplateInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/templates/email/info"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "email_template_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "email_template_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.EmailTemplateID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, GetEmailTemplateInfoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetEmailTemplateInfoResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPreferenceCenter invokes getPreferenceCenter operation.
+//
+// View the details for a preference center, including its HTML.
+//
+// GET /preference_center/v1/{preference_center_external_id}
+func (c *Client) GetPreferenceCenter(ctx context.Context, params GetPreferenceCenterParams) (*GetPreferenceCenterResponse, error) {
+	res, err := c.sendGetPreferenceCenter(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPreferenceCenter(ctx context.Context, params GetPreferenceCenterParams) (res *GetPreferenceCenterResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPreferenceCenter"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/preference_center/v1/{preference_center_external_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPreferenceCenterOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/preference_center/v1/"
+	{
+		// Encode "preference_center_external_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "preference_center_external_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.PreferenceCenterExternalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, GetPreferenceCenterOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetPreferenceCenterResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetSegmentDetails invokes getSegmentDetails operation.
+//
+// Retrieve the details of a segment.
+//
+// GET /segments/details
+func (c *Client) GetSegmentDetails(ctx context.Context, params GetSegmentDetailsParams) (*GetSegmentDetailsResponse, error) {
+	res, err := c.sendGetSegmentDetails(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetSegmentDetails(ctx context.Context, params GetSegmentDetailsParams) (res *GetSegmentDetailsResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getSegmentDetails"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/segments/details"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetSegmentDetailsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/segments/details"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "segment_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "segment_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.SegmentID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, GetSegmentDetailsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetSegmentDetailsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCampaigns invokes listCampaigns operation.
+//
+// List your campaigns, including their tags and whether they are API campaigns.
+//
+// GET /campaigns/list
+func (c *Client) ListCampaigns(ctx context.Context, params ListCampaignsParams) (*ListCampaignsResponse, error) {
+	res, err := c.sendListCampaigns(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCampaigns(ctx context.Context, params ListCampaignsParams) (res *ListCampaignsResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCampaigns"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/campaigns/list"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListCampaignsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/campaigns/list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "include_archived" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "include_archived",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeArchived.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort_direction" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort_direction",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortDirection.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "last_edit.time[gt]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "last_edit.time[gt]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.LastEditTimeGt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListCampaignsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListCampaignsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCanvases invokes listCanvases operation.
+//
+// List your Canvases, including their tags.
+//
+// GET /canvas/list
+func (c *Client) ListCanvases(ctx context.Context, params ListCanvasesParams) (*ListCanvasesResponse, error) {
+	res, err := c.sendListCanvases(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCanvases(ctx context.Context, params ListCanvasesParams) (res *ListCanvasesResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCanvases"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/canvas/list"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListCanvasesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/canvas/list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "include_archived" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "include_archived",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IncludeArchived.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort_direction" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort_direction",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortDirection.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "last_edit.time[gt]" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "last_edit.time[gt]",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.LastEditTimeGt.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListCanvasesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListCanvasesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCatalogItems invokes listCatalogItems operation.
+//
+// List catalog items.
+//
+// GET /catalogs/{catalog_name}/items
+func (c *Client) ListCatalogItems(ctx context.Context, params ListCatalogItemsParams) (*ListCatalogItemsResponseHeaders, error) {
+	res, err := c.sendListCatalogItems(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCatalogItems(ctx context.Context, params ListCatalogItemsParams) (res *ListCatalogItemsResponseHeaders, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCatalogItems"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/catalogs/{catalog_name}/items"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListCatalogItemsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/catalogs/"
+	{
+		// Encode "catalog_name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "catalog_name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CatalogName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/items"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListCatalogItemsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListCatalogItemsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCatalogs invokes listCatalogs operation.
+//
+// List catalogs.
+//
+// GET /catalogs
+func (c *Client) ListCatalogs(ctx context.Context, params ListCatalogsParams) (*ListCatalogsResponseHeaders, error) {
+	res, err := c.sendListCatalogs(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCatalogs(ctx context.Context, params ListCatalogsParams) (res *ListCatalogsResponseHeaders, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCatalogs"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/catalogs"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListCatalogsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/catalogs"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListCatalogsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListCatalogsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListContentBlocks invokes listContentBlocks operation.
+//
+// List your existing Content Blocks information.
+//
+// GET /content_blocks/list
+func (c *Client) ListContentBlocks(ctx context.Context, params ListContentBlocksParams) (*ListContentBlocksResponse, error) {
+	res, err := c.sendListContentBlocks(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListContentBlocks(ctx context.Context, params ListContentBlocksParams) (res *ListContentBlocksResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listContentBlocks"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/content_blocks/list"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListContentBlocksOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/content_blocks/list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "modified_after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "modified_after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModifiedAfter.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "modified_before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "modified_before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModifiedBefore.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListContentBlocksOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListContentBlocksResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCustomAttributes invokes listCustomAttributes operation.
+//
+// List the custom attributes recorded in the workspace, with their data types, descriptions, tags
+// and status.
+//
+// GET /custom_attributes
+func (c *Client) ListCustomAttributes(ctx context.Context, params ListCustomAttributesParams) (*ListCustomAttributesResponseHeaders, error) {
+	res, err := c.sendListCustomAttributes(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCustomAttributes(ctx context.Context, params ListCustomAttributesParams) (res *ListCustomAttributesResponseHeaders, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCustomAttributes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/custom_attributes"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListCustomAttributesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/custom_attributes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListCustomAttributesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListCustomAttributesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCustomEvents invokes listCustomEvents operation.
+//
+// List the custom events recorded in the workspace, with their descriptions, tags and status.
+//
+// GET /events
+func (c *Client) ListCustomEvents(ctx context.Context, params ListCustomEventsParams) (*ListCustomEventsResponseHeaders, error) {
+	res, err := c.sendListCustomEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCustomEvents(ctx context.Context, params ListCustomEventsParams) (res *ListCustomEventsResponseHeaders, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCustomEvents"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/events"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListCustomEventsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/events"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListCustomEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListCustomEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListEmailTemplates invokes listEmailTemplates operation.
+//
+// List your existing Email Templates information.
+//
+// GET /templates/email/list
+func (c *Client) ListEmailTemplates(ctx context.Context, params ListEmailTemplatesParams) (*ListEmailTemplatesResponse, error) {
+	res, err := c.sendListEmailTemplates(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListEmailTemplates(ctx context.Context, params ListEmailTemplatesParams) (res *ListEmailTemplatesResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listEmailTemplates"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/templates/email/list"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListEmailTemplatesOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/templates/email/list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "modified_after" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "modified_after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModifiedAfter.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "modified_before" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "modified_before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModifiedBefore.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListEmailTemplatesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListEmailTemplatesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListPreferenceCenters invokes listPreferenceCenters operation.
+//
+// List your available preference centers.
+//
+// GET /preference_center/v1/list
+func (c *Client) ListPreferenceCenters(ctx context.Context) (*ListPreferenceCentersResponse, error) {
+	res, err := c.sendListPreferenceCenters(ctx)
+	return res, err
+}
+
+func (c *Client) sendListPreferenceCenters(ctx context.Context) (res *ListPreferenceCentersResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listPreferenceCenters"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/preference_center/v1/list"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPreferenceCentersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/preference_center/v1/list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListPreferenceCentersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListPreferenceCentersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListProducts invokes listProducts operation.
+//
+// List the product identifiers of purchases logged in the workspace.
+//
+// GET /purchases/product_list
+func (c *Client) ListProducts(ctx context.Context, params ListProductsParams) (*ListProductsResponse, error) {
+	res, err := c.sendListProducts(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListProducts(ctx context.Context, params ListProductsParams) (res *ListProductsResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listProducts"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/purchases/product_list"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListProductsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/purchases/product_list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListProductsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListProductsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListSegments invokes listSegments operation.
+//
+// List your segments, including their tags and whether analytics tracking is enabled.
+//
+// GET /segments/list
+func (c *Client) ListSegments(ctx context.Context, params ListSegmentsParams) (*ListSegmentsResponse, error) {
+	res, err := c.sendListSegments(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListSegments(ctx context.Context, params ListSegmentsParams) (res *ListSegmentsResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listSegments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/segments/list"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListSegmentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/segments/list"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort_direction" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort_direction",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SortDirection.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ListSegmentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListSegmentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ReplaceCatalogItem invokes replaceCatalogItem operation.
+//
+// Replace catalog item.
+//
+// PUT /catalogs/{catalog_name}/items/{item_id}
+func (c *Client) ReplaceCatalogItem(ctx context.Context, request *ReplaceCatalogItemRequest, params ReplaceCatalogItemParams) (*CatalogItemOperationResponse, error) {
+	res, err := c.sendReplaceCatalogItem(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendReplaceCatalogItem(ctx context.Context, request *ReplaceCatalogItemRequest, params ReplaceCatalogItemParams) (res *CatalogItemOperationResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("replaceCatalogItem"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/catalogs/{catalog_name}/items/{item_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ReplaceCatalogItemOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/catalogs/"
+	{
+		// Encode "catalog_name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "catalog_name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CatalogName))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/items/"
+	{
+		// Encode "item_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "item_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ItemID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeReplaceCatalogItemRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, ReplaceCatalogItemOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeReplaceCatalogItemResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// SearchDashboardUsers invokes searchDashboardUsers operation.
+//
+// Look up dashboard users matching a SCIM filter on userName.
+//
+// GET /scim/v2/Users
+func (c *Client) SearchDashboardUsers(ctx context.Context, params SearchDashboardUsersParams) (*SearchDashboardUsersResponse, error) {
+	res, err := c.sendSearchDashboardUsers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendSearchDashboardUsers(ctx context.Context, params SearchDashboardUsersParams) (res *SearchDashboardUsersResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("searchDashboardUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/scim/v2/Users"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, SearchDashboardUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/scim/v2/Users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "filter" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "filter",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Cursor.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
+			return e.EncodeValue(conv.StringToString(params.Filter))
 		}); err != nil {
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
 	u.RawQuery = q.Values().Encode()
 
+	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.XRequestOrigin))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, ListCatalogItemsOperation, r); {
+			stage = "Security:BrazeScimToken"
+			switch err := c.securityBrazeScimToken(ctx, SearchDashboardUsersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
-				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+				return res, errors.Wrap(err, "security \"BrazeScimToken\"")
 			}
 		}
 
@@ -1112,6 +5235,7 @@ func (c *Client) sendListCatalogItems(ctx context.Context, params ListCatalogIte
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1119,7 +5243,8 @@ func (c *Client) sendListCatalogItems(ctx context.Context, params ListCatalogIte
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeListCatalogItemsResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeSearchDashboardUsersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1127,34 +5252,72 @@ func (c *Client) sendListCatalogItems(ctx context.Context, params ListCatalogIte
 	return result, nil
 }
 
-// ListCatalogs invokes listCatalogs operation.
+// UpdateCampaignTriggerSchedule invokes updateCampaignTriggerSchedule operation.
 //
-// List catalogs.
+// Update the schedule of an API-triggered campaign.
 //
-// GET /catalogs
-func (c *Client) ListCatalogs(ctx context.Context) (*ListCatalogsResponse, error) {
-	res, err := c.sendListCatalogs(ctx)
+// POST /campaigns/trigger/schedule/update
+func (c *Client) UpdateCampaignTriggerSchedule(ctx context.Context, request *UpdateCampaignTriggerScheduleRequest) (*UpdateCampaignTriggerScheduleResponse, error) {
+	res, err := c.sendUpdateCampaignTriggerSchedule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListCatalogs(ctx context.Context) (res *ListCatalogsResponse, err error) {
+func (c *Client) sendUpdateCampaignTriggerSchedule(ctx context.Context, request *UpdateCampaignTriggerScheduleRequest) (res *UpdateCampaignTriggerScheduleResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateCampaignTriggerSchedule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/campaigns/trigger/schedule/update"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateCampaignTriggerScheduleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/catalogs"
+	pathParts[0] = "/campaigns/trigger/schedule/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateCampaignTriggerScheduleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, ListCatalogsOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, UpdateCampaignTriggerScheduleOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1182,6 +5345,7 @@ func (c *Client) sendListCatalogs(ctx context.Context) (res *ListCatalogsRespons
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1189,7 +5353,8 @@ func (c *Client) sendListCatalogs(ctx context.Context) (res *ListCatalogsRespons
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeListCatalogsResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeUpdateCampaignTriggerScheduleResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1197,105 +5362,191 @@ func (c *Client) sendListCatalogs(ctx context.Context) (res *ListCatalogsRespons
 	return result, nil
 }
 
-// ListContentBlocks invokes listContentBlocks operation.
+// UpdateCanvasTriggerSchedule invokes updateCanvasTriggerSchedule operation.
 //
-// List your existing Content Blocks information.
+// Update the schedule of an API-triggered Canvas.
 //
-// GET /content_blocks/list
-func (c *Client) ListContentBlocks(ctx context.Context, params ListContentBlocksParams) (*ListContentBlocksResponse, error) {
-	res, err := c.sendListContentBlocks(ctx, params)
+// POST /canvas/trigger/schedule/update
+func (c *Client) UpdateCanvasTriggerSchedule(ctx context.Context, request *UpdateCanvasTriggerScheduleRequest) (*UpdateCanvasTriggerScheduleResponse, error) {
+	res, err := c.sendUpdateCanvasTriggerSchedule(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendListContentBlocks(ctx context.Context, params ListContentBlocksParams) (res *ListContentBlocksResponse, err error) {
+func (c *Client) sendUpdateCanvasTriggerSchedule(ctx context.Context, request *UpdateCanvasTriggerScheduleRequest) (res *UpdateCanvasTriggerScheduleResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateCanvasTriggerSchedule"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/canvas/trigger/schedule/update"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateCanvasTriggerScheduleOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/content_blocks/list"
+	pathParts[0] = "/canvas/trigger/schedule/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateCanvasTriggerScheduleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
 	{
-		// Encode "modified_after" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "modified_after",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, UpdateCanvasTriggerScheduleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+			}
 		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ModifiedAfter.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
 		}
 	}
-	{
-		// Encode "modified_before" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "modified_before",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ModifiedBefore.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	body := resp.Body
+	defer body.Close()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+	stage = "DecodeResponse"
+	result, err := decodeUpdateCanvasTriggerScheduleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UpdateContentBlock invokes updateContentBlock operation.
+//
+// Update a Content Block on the Braze dashboard.
+//
+// POST /content_blocks/update
+func (c *Client) UpdateContentBlock(ctx context.Context, request *UpdateContentBlockRequest) (UpdateContentBlockRes, error) {
+	res, err := c.sendUpdateContentBlock(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpdateContentBlock(ctx context.Context, request *UpdateContentBlockRequest) (res UpdateContentBlockRes, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
 		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateContentBlock"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/content_blocks/update"),
 	}
-	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateContentBlockOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/content_blocks/update"
+	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateContentBlockRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, ListContentBlocksOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, UpdateContentBlockOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1323,6 +5574,7 @@ func (c *Client) sendListContentBlocks(ctx context.Context, params ListContentBl
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1330,7 +5582,8 @@ func (c *Client) sendListContentBlocks(ctx context.Context, params ListContentBl
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeListContentBlocksResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeUpdateContentBlockResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1338,111 +5591,119 @@ func (c *Client) sendListContentBlocks(ctx context.Context, params ListContentBl
 	return result, nil
 }
 
-// ListEmailTemplates invokes listEmailTemplates operation.
+// UpdateDashboardUser invokes updateDashboardUser operation.
 //
-// List your existing Email Templates information.
+// Replace the name, department and permissions of a dashboard user account.
 //
-// GET /templates/email/list
-func (c *Client) ListEmailTemplates(ctx context.Context, params ListEmailTemplatesParams) (*ListEmailTemplatesResponse, error) {
-	res, err := c.sendListEmailTemplates(ctx, params)
+// PUT /scim/v2/Users/{id}
+func (c *Client) UpdateDashboardUser(ctx context.Context, request *UpdateDashboardUserRequest, params UpdateDashboardUserParams) (*DashboardUser, error) {
+	res, err := c.sendUpdateDashboardUser(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListEmailTemplates(ctx context.Context, params ListEmailTemplatesParams) (res *ListEmailTemplatesResponse, err error) {
+func (c *Client) sendUpdateDashboardUser(ctx context.Context, request *UpdateDashboardUserRequest, params UpdateDashboardUserParams) (res *DashboardUser, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateDashboardUser"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/scim/v2/Users/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateDashboardUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/templates/email/list"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
+	var pathParts [2]string
+	pathParts[0] = "/scim/v2/Users/"
 	{
-		// Encode "modified_after" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "modified_after",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ModifiedAfter.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	{
-		// Encode "modified_before" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "modified_before",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.ModifiedBefore.Get(); ok {
-				return e.EncodeValue(conv.DateTimeToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
-	{
-		// Encode "limit" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Limit.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
+	if err := encodeUpdateDashboardUserRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
 	}
+
+	stage = "EncodeHeaderParams"
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		// Encode "offset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Offset.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
-			}
-			return nil
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.XRequestOrigin))
 		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+			return res, errors.Wrap(err, "encode header")
 		}
 	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, ListEmailTemplatesOperation, r); {
+			stage = "Security:BrazeScimToken"
+			switch err := c.securityBrazeScimToken(ctx, UpdateDashboardUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
-				return res, errors.Wrap(err, "security \"BrazeApiKey\"")
+				return res, errors.Wrap(err, "security \"BrazeScimToken\"")
 			}
 		}
 
@@ -1464,6 +5725,7 @@ func (c *Client) sendListEmailTemplates(ctx context.Context, params ListEmailTem
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1471,7 +5733,8 @@ func (c *Client) sendListEmailTemplates(ctx context.Context, params ListEmailTem
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeListEmailTemplatesResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeUpdateDashboardUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1479,17 +5742,17 @@ func (c *Client) sendListEmailTemplates(ctx context.Context, params ListEmailTem
 	return result, nil
 }
 
-// ReplaceCatalogItem invokes replaceCatalogItem operation.
+// UpdateEmailTemplate invokes updateEmailTemplate operation.
 //
-// Replace catalog item.
+// Update an Email Template on the Braze dashboard.
 //
-// PUT /catalogs/{catalog_name}/items/{item_id}
-func (c *Client) ReplaceCatalogItem(ctx context.Context, request *ReplaceCatalogItemRequest, params ReplaceCatalogItemParams) (*CatalogItemOperationResponse, error) {
-	res, err := c.sendReplaceCatalogItem(ctx, request, params)
+// POST /templates/email/update
+func (c *Client) UpdateEmailTemplate(ctx context.Context, request *UpdateEmailTemplateRequest) (*UpdateEmailTemplateResponse, error) {
+	res, err := c.sendUpdateEmailTemplate(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendReplaceCatalogItem(ctx context.Context, request *ReplaceCatalogItemRequest, params ReplaceCatalogItemParams) (res *CatalogItemOperationResponse, err error) {
+func (c *Client) sendUpdateEmailTemplate(ctx context.Context, request *UpdateEmailTemplateRequest) (res *UpdateEmailTemplateResponse, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -1499,54 +5762,52 @@ func (c *Client) sendReplaceCatalogItem(ctx context.Context, request *ReplaceCat
 	}(); err != nil {
 		return res, errors.Wrap(err, "validate")
 	}
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/catalogs/"
-	{
-		// Encode "catalog_name" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "catalog_name",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.CatalogName))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateEmailTemplate"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/templates/email/update"),
 	}
-	pathParts[2] = "/items/"
-	{
-		// Encode "item_id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "item_id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.ItemID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateEmailTemplateOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
 		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		pathParts[3] = encoded
-	}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/templates/email/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PUT", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeReplaceCatalogItemRequest(request, r); err != nil {
+	if err := encodeUpdateEmailTemplateRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1554,8 +5815,8 @@ func (c *Client) sendReplaceCatalogItem(ctx context.Context, request *ReplaceCat
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, ReplaceCatalogItemOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, UpdateEmailTemplateOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1583,6 +5844,7 @@ func (c *Client) sendReplaceCatalogItem(ctx context.Context, request *ReplaceCat
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1590,7 +5852,8 @@ func (c *Client) sendReplaceCatalogItem(ctx context.Context, request *ReplaceCat
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeReplaceCatalogItemResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeUpdateEmailTemplateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1598,17 +5861,17 @@ func (c *Client) sendReplaceCatalogItem(ctx context.Context, request *ReplaceCat
 	return result, nil
 }
 
-// UpdateContentBlock invokes updateContentBlock operation.
+// UpdatePreferenceCenter invokes updatePreferenceCenter operation.
 //
-// Update a Content Block on the Braze dashboard.
+// Update an existing preference center.
 //
-// POST /content_blocks/update
-func (c *Client) UpdateContentBlock(ctx context.Context, request *UpdateContentBlockRequest) (UpdateContentBlockRes, error) {
-	res, err := c.sendUpdateContentBlock(ctx, request)
+// PUT /preference_center/v1/{preference_center_external_id}
+func (c *Client) UpdatePreferenceCenter(ctx context.Context, request *UpdatePreferenceCenterRequest, params UpdatePreferenceCenterParams) (*UpdatePreferenceCenterResponse, error) {
+	res, err := c.sendUpdatePreferenceCenter(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateContentBlock(ctx context.Context, request *UpdateContentBlockRequest) (res UpdateContentBlockRes, err error) {
+func (c *Client) sendUpdatePreferenceCenter(ctx context.Context, request *UpdatePreferenceCenterRequest, params UpdatePreferenceCenterParams) (res *UpdatePreferenceCenterResponse, err error) {
 	// Validate request before sending.
 	if err := func() error {
 		if err := request.Validate(); err != nil {
@@ -1618,17 +5881,70 @@ func (c *Client) sendUpdateContentBlock(ctx context.Context, request *UpdateCont
 	}(); err != nil {
 		return res, errors.Wrap(err, "validate")
 	}
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updatePreferenceCenter"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/preference_center/v1/{preference_center_external_id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdatePreferenceCenterOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/content_blocks/update"
+	var pathParts [2]string
+	pathParts[0] = "/preference_center/v1/"
+	{
+		// Encode "preference_center_external_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "preference_center_external_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.PreferenceCenterExternalID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateContentBlockRequest(request, r); err != nil {
+	if err := encodeUpdatePreferenceCenterRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1636,8 +5952,8 @@ func (c *Client) sendUpdateContentBlock(ctx context.Context, request *UpdateCont
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, UpdateContentBlockOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, UpdatePreferenceCenterOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1665,6 +5981,7 @@ func (c *Client) sendUpdateContentBlock(ctx context.Context, request *UpdateCont
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1672,7 +5989,8 @@ func (c *Client) sendUpdateContentBlock(ctx context.Context, request *UpdateCont
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeUpdateContentBlockResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeUpdatePreferenceCenterResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1680,37 +5998,63 @@ func (c *Client) sendUpdateContentBlock(ctx context.Context, request *UpdateCont
 	return result, nil
 }
 
-// UpdateEmailTemplate invokes updateEmailTemplate operation.
+// UpdateScheduledMessage invokes updateScheduledMessage operation.
 //
-// Update an Email Template on the Braze dashboard.
+// Update the schedule or messages of a scheduled message.
 //
-// POST /templates/email/update
-func (c *Client) UpdateEmailTemplate(ctx context.Context, request *UpdateEmailTemplateRequest) (*UpdateEmailTemplateResponse, error) {
-	res, err := c.sendUpdateEmailTemplate(ctx, request)
+// POST /messages/schedule/update
+func (c *Client) UpdateScheduledMessage(ctx context.Context, request *UpdateScheduledMessageRequest) (*UpdateScheduledMessageResponse, error) {
+	res, err := c.sendUpdateScheduledMessage(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendUpdateEmailTemplate(ctx context.Context, request *UpdateEmailTemplateRequest) (res *UpdateEmailTemplateResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
+func (c *Client) sendUpdateScheduledMessage(ctx context.Context, request *UpdateScheduledMessageRequest) (res *UpdateScheduledMessageResponse, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateScheduledMessage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/messages/schedule/update"),
 	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateScheduledMessageOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
 
+	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/templates/email/update"
+	pathParts[0] = "/messages/schedule/update"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeRequest"
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeUpdateEmailTemplateRequest(request, r); err != nil {
+	if err := encodeUpdateScheduledMessageRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -1718,8 +6062,8 @@ func (c *Client) sendUpdateEmailTemplate(ctx context.Context, request *UpdateEma
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-
-			switch err := c.securityBrazeApiKey(ctx, UpdateEmailTemplateOperation, r); {
+			stage = "Security:BrazeApiKey"
+			switch err := c.securityBrazeApiKey(ctx, UpdateScheduledMessageOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1747,6 +6091,7 @@ func (c *Client) sendUpdateEmailTemplate(ctx context.Context, request *UpdateEma
 		}
 	}
 
+	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1754,7 +6099,8 @@ func (c *Client) sendUpdateEmailTemplate(ctx context.Context, request *UpdateEma
 	body := resp.Body
 	defer body.Close()
 
-	result, err := decodeUpdateEmailTemplateResponse(resp)
+	stage = "DecodeResponse"
+	result, err := decodeUpdateScheduledMessageResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
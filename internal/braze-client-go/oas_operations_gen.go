@@ -6,20 +6,48 @@ package brazeclient
 type OperationName = string
 
 const (
-	CreateCatalogOperation        OperationName = "CreateCatalog"
-	CreateCatalogItemOperation    OperationName = "CreateCatalogItem"
-	CreateContentBlockOperation   OperationName = "CreateContentBlock"
-	CreateEmailTemplateOperation  OperationName = "CreateEmailTemplate"
-	DeleteCatalogOperation        OperationName = "DeleteCatalog"
-	DeleteCatalogItemOperation    OperationName = "DeleteCatalogItem"
-	GetCatalogItemOperation       OperationName = "GetCatalogItem"
-	GetContentBlockInfoOperation  OperationName = "GetContentBlockInfo"
-	GetEmailTemplateInfoOperation OperationName = "GetEmailTemplateInfo"
-	ListCatalogItemsOperation     OperationName = "ListCatalogItems"
-	ListCatalogsOperation         OperationName = "ListCatalogs"
-	ListContentBlocksOperation    OperationName = "ListContentBlocks"
-	ListEmailTemplatesOperation   OperationName = "ListEmailTemplates"
-	ReplaceCatalogItemOperation   OperationName = "ReplaceCatalogItem"
-	UpdateContentBlockOperation   OperationName = "UpdateContentBlock"
-	UpdateEmailTemplateOperation  OperationName = "UpdateEmailTemplate"
+	CreateCampaignTriggerScheduleOperation OperationName = "CreateCampaignTriggerSchedule"
+	CreateCanvasTriggerScheduleOperation   OperationName = "CreateCanvasTriggerSchedule"
+	CreateCatalogOperation                 OperationName = "CreateCatalog"
+	CreateCatalogItemOperation             OperationName = "CreateCatalogItem"
+	CreateContentBlockOperation            OperationName = "CreateContentBlock"
+	CreateDashboardUserOperation           OperationName = "CreateDashboardUser"
+	CreateEmailTemplateOperation           OperationName = "CreateEmailTemplate"
+	CreatePreferenceCenterOperation        OperationName = "CreatePreferenceCenter"
+	CreateScheduledMessageOperation        OperationName = "CreateScheduledMessage"
+	CreateSendIDOperation                  OperationName = "CreateSendID"
+	DeleteCampaignTriggerScheduleOperation OperationName = "DeleteCampaignTriggerSchedule"
+	DeleteCanvasTriggerScheduleOperation   OperationName = "DeleteCanvasTriggerSchedule"
+	DeleteCatalogOperation                 OperationName = "DeleteCatalog"
+	DeleteCatalogItemOperation             OperationName = "DeleteCatalogItem"
+	DeleteDashboardUserOperation           OperationName = "DeleteDashboardUser"
+	DeleteScheduledMessageOperation        OperationName = "DeleteScheduledMessage"
+	GetCampaignDetailsOperation            OperationName = "GetCampaignDetails"
+	GetCanvasDetailsOperation              OperationName = "GetCanvasDetails"
+	GetCatalogItemOperation                OperationName = "GetCatalogItem"
+	GetContentBlockInfoOperation           OperationName = "GetContentBlockInfo"
+	GetDashboardUserOperation              OperationName = "GetDashboardUser"
+	GetEmailTemplateInfoOperation          OperationName = "GetEmailTemplateInfo"
+	GetPreferenceCenterOperation           OperationName = "GetPreferenceCenter"
+	GetSegmentDetailsOperation             OperationName = "GetSegmentDetails"
+	ListCampaignsOperation                 OperationName = "ListCampaigns"
+	ListCanvasesOperation                  OperationName = "ListCanvases"
+	ListCatalogItemsOperation              OperationName = "ListCatalogItems"
+	ListCatalogsOperation                  OperationName = "ListCatalogs"
+	ListContentBlocksOperation             OperationName = "ListContentBlocks"
+	ListCustomAttributesOperation          OperationName = "ListCustomAttributes"
+	ListCustomEventsOperation              OperationName = "ListCustomEvents"
+	ListEmailTemplatesOperation            OperationName = "ListEmailTemplates"
+	ListPreferenceCentersOperation         OperationName = "ListPreferenceCenters"
+	ListProductsOperation                  OperationName = "ListProducts"
+	ListSegmentsOperation                  OperationName = "ListSegments"
+	ReplaceCatalogItemOperation            OperationName = "ReplaceCatalogItem"
+	SearchDashboardUsersOperation          OperationName = "SearchDashboardUsers"
+	UpdateCampaignTriggerScheduleOperation OperationName = "UpdateCampaignTriggerSchedule"
+	UpdateCanvasTriggerScheduleOperation   OperationName = "UpdateCanvasTriggerSchedule"
+	UpdateContentBlockOperation            OperationName = "UpdateContentBlock"
+	UpdateDashboardUserOperation           OperationName = "UpdateDashboardUser"
+	UpdateEmailTemplateOperation           OperationName = "UpdateEmailTemplate"
+	UpdatePreferenceCenterOperation        OperationName = "UpdatePreferenceCenter"
+	UpdateScheduledMessageOperation        OperationName = "UpdateScheduledMessage"
 )
@@ -133,6 +133,62 @@ func decodeCreateCatalogItemParams(args [2]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
+// CreateDashboardUserParams is parameters of createDashboardUser operation.
+type CreateDashboardUserParams struct {
+	// The origin domain allowlisted for the SCIM token.
+	XRequestOrigin string
+}
+
+func unpackCreateDashboardUserParams(packed middleware.Parameters) (params CreateDashboardUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Request-Origin",
+			In:   "header",
+		}
+		params.XRequestOrigin = packed[key].(string)
+	}
+	return params
+}
+
+func decodeCreateDashboardUserParams(args [0]string, argsEscaped bool, r *http.Request) (params CreateDashboardUserParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-Request-Origin.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XRequestOrigin = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Request-Origin",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // DeleteCatalogParams is parameters of deleteCatalog operation.
 type DeleteCatalogParams struct {
 	CatalogName string
@@ -316,6 +372,232 @@ func decodeDeleteCatalogItemParams(args [2]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
+// DeleteDashboardUserParams is parameters of deleteDashboardUser operation.
+type DeleteDashboardUserParams struct {
+	// The origin domain allowlisted for the SCIM token.
+	XRequestOrigin string
+	// The SCIM identifier of the dashboard user.
+	ID string
+}
+
+func unpackDeleteDashboardUserParams(packed middleware.Parameters) (params DeleteDashboardUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Request-Origin",
+			In:   "header",
+		}
+		params.XRequestOrigin = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDeleteDashboardUserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteDashboardUserParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-Request-Origin.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XRequestOrigin = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Request-Origin",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCampaignDetailsParams is parameters of getCampaignDetails operation.
+type GetCampaignDetailsParams struct {
+	// Campaign identifier.
+	CampaignID string
+}
+
+func unpackGetCampaignDetailsParams(packed middleware.Parameters) (params GetCampaignDetailsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "campaign_id",
+			In:   "query",
+		}
+		params.CampaignID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetCampaignDetailsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetCampaignDetailsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: campaign_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "campaign_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaign_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCanvasDetailsParams is parameters of getCanvasDetails operation.
+type GetCanvasDetailsParams struct {
+	// Canvas identifier.
+	CanvasID string
+}
+
+func unpackGetCanvasDetailsParams(packed middleware.Parameters) (params GetCanvasDetailsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "canvas_id",
+			In:   "query",
+		}
+		params.CanvasID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetCanvasDetailsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetCanvasDetailsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: canvas_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "canvas_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.CanvasID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "canvas_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // GetCatalogItemParams is parameters of getCatalogItem operation.
 type GetCatalogItemParams struct {
 	CatalogName string
@@ -549,35 +831,42 @@ func decodeGetContentBlockInfoParams(args [0]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetEmailTemplateInfoParams is parameters of getEmailTemplateInfo operation.
-type GetEmailTemplateInfoParams struct {
-	// Email Template identifier.
-	EmailTemplateID string
+// GetDashboardUserParams is parameters of getDashboardUser operation.
+type GetDashboardUserParams struct {
+	// The origin domain allowlisted for the SCIM token.
+	XRequestOrigin string
+	// The SCIM identifier of the dashboard user.
+	ID string
 }
 
-func unpackGetEmailTemplateInfoParams(packed middleware.Parameters) (params GetEmailTemplateInfoParams) {
+func unpackGetDashboardUserParams(packed middleware.Parameters) (params GetDashboardUserParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "email_template_id",
-			In:   "query",
+			Name: "X-Request-Origin",
+			In:   "header",
 		}
-		params.EmailTemplateID = packed[key].(string)
+		params.XRequestOrigin = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetEmailTemplateInfoParams(args [0]string, argsEscaped bool, r *http.Request) (params GetEmailTemplateInfoParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: email_template_id.
+func decodeGetDashboardUserParams(args [1]string, argsEscaped bool, r *http.Request) (params GetDashboardUserParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-Request-Origin.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "email_template_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -588,7 +877,7 @@ func decodeGetEmailTemplateInfoParams(args [0]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				params.EmailTemplateID = c
+				params.XRequestOrigin = c
 				return nil
 			}); err != nil {
 				return err
@@ -599,43 +888,136 @@ func decodeGetEmailTemplateInfoParams(args [0]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "email_template_id",
-			In:   "query",
+			Name: "X-Request-Origin",
+			In:   "header",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-// ListCatalogItemsParams is parameters of listCatalogItems operation.
-type ListCatalogItemsParams struct {
-	CatalogName string
-	Cursor      OptString `json:",omitempty,omitzero"`
-}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-func unpackListCatalogItemsParams(packed middleware.Parameters) (params ListCatalogItemsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "catalog_name",
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
 			In:   "path",
+			Err:  err,
 		}
-		params.CatalogName = packed[key].(string)
 	}
+	return params, nil
+}
+
+// GetEmailTemplateInfoParams is parameters of getEmailTemplateInfo operation.
+type GetEmailTemplateInfoParams struct {
+	// Email Template identifier.
+	EmailTemplateID string
+}
+
+func unpackGetEmailTemplateInfoParams(packed middleware.Parameters) (params GetEmailTemplateInfoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "cursor",
+			Name: "email_template_id",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Cursor = v.(OptString)
-		}
+		params.EmailTemplateID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeListCatalogItemsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListCatalogItemsParams, _ error) {
+func decodeGetEmailTemplateInfoParams(args [0]string, argsEscaped bool, r *http.Request) (params GetEmailTemplateInfoParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: catalog_name.
+	// Decode query: email_template_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "email_template_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.EmailTemplateID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "email_template_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPreferenceCenterParams is parameters of getPreferenceCenter operation.
+type GetPreferenceCenterParams struct {
+	// Preference Center identifier.
+	PreferenceCenterExternalID string
+}
+
+func unpackGetPreferenceCenterParams(packed middleware.Parameters) (params GetPreferenceCenterParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "preference_center_external_id",
+			In:   "path",
+		}
+		params.PreferenceCenterExternalID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetPreferenceCenterParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPreferenceCenterParams, _ error) {
+	// Decode path: preference_center_external_id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -647,7 +1029,7 @@ func decodeListCatalogItemsParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "catalog_name",
+				Param:   "preference_center_external_id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -664,7 +1046,7 @@ func decodeListCatalogItemsParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.CatalogName = c
+				params.PreferenceCenterExternalID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -675,48 +1057,65 @@ func decodeListCatalogItemsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "catalog_name",
+			Name: "preference_center_external_id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: cursor.
+	return params, nil
+}
+
+// GetSegmentDetailsParams is parameters of getSegmentDetails operation.
+type GetSegmentDetailsParams struct {
+	// Segment identifier.
+	SegmentID string
+}
+
+func unpackGetSegmentDetailsParams(packed middleware.Parameters) (params GetSegmentDetailsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "segment_id",
+			In:   "query",
+		}
+		params.SegmentID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetSegmentDetailsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetSegmentDetailsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: segment_id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "cursor",
+			Name:    "segment_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotCursorVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotCursorVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Cursor.SetTo(paramsDotCursorVal)
+
+				params.SegmentID = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "cursor",
+			Name: "segment_id",
 			In:   "query",
 			Err:  err,
 		}
@@ -724,129 +1123,164 @@ func decodeListCatalogItemsParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// ListContentBlocksParams is parameters of listContentBlocks operation.
-type ListContentBlocksParams struct {
-	// Retrieve only Content Blocks updated at or after the given time.
-	ModifiedAfter OptDateTime `json:",omitempty,omitzero"`
-	// Retrieve only Content Blocks updated at or before the given time.
-	ModifiedBefore OptDateTime `json:",omitempty,omitzero"`
-	// Maximum number of Content Blocks to retrieve (default 100, max 1000).
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of Content Blocks to skip before returning results.
-	Offset OptInt `json:",omitempty,omitzero"`
+// ListCampaignsParams is parameters of listCampaigns operation.
+type ListCampaignsParams struct {
+	// The page of campaigns to return, starting at 0. Each page has up to 100 campaigns.
+	Page OptInt `json:",omitempty,omitzero"`
+	// Whether to include archived campaigns.
+	IncludeArchived OptBool `json:",omitempty,omitzero"`
+	// Sort by creation time, asc for oldest first or desc for newest first.
+	SortDirection OptListCampaignsSortDirection `json:",omitempty,omitzero"`
+	// Retrieve only campaigns edited after the given time.
+	LastEditTimeGt OptDateTime `json:",omitempty,omitzero"`
 }
 
-func unpackListContentBlocksParams(packed middleware.Parameters) (params ListContentBlocksParams) {
+func unpackListCampaignsParams(packed middleware.Parameters) (params ListCampaignsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "modified_after",
+			Name: "page",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.ModifiedAfter = v.(OptDateTime)
+			params.Page = v.(OptInt)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "modified_before",
+			Name: "include_archived",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.ModifiedBefore = v.(OptDateTime)
+			params.IncludeArchived = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "sort_direction",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.SortDirection = v.(OptListCampaignsSortDirection)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "last_edit.time[gt]",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.LastEditTimeGt = v.(OptDateTime)
 		}
 	}
 	return params
 }
 
-func decodeListContentBlocksParams(args [0]string, argsEscaped bool, r *http.Request) (params ListContentBlocksParams, _ error) {
+func decodeListCampaignsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListCampaignsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: modified_after.
+	// Set default value for query: page.
+	{
+		val := int(0)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "modified_after",
+			Name:    "page",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotModifiedAfterVal time.Time
+				var paramsDotPageVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDateTime(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotModifiedAfterVal = c
+					paramsDotPageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ModifiedAfter.SetTo(paramsDotModifiedAfterVal)
+				params.Page.SetTo(paramsDotPageVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "modified_after",
+			Name: "page",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: modified_before.
+	// Set default value for query: include_archived.
+	{
+		val := bool(false)
+		params.IncludeArchived.SetTo(val)
+	}
+	// Decode query: include_archived.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "modified_before",
+			Name:    "include_archived",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotModifiedBeforeVal time.Time
+				var paramsDotIncludeArchivedVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDateTime(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotModifiedBeforeVal = c
+					paramsDotIncludeArchivedVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ModifiedBefore.SetTo(paramsDotModifiedBeforeVal)
+				params.IncludeArchived.SetTo(paramsDotIncludeArchivedVal)
 				return nil
 			}); err != nil {
 				return err
@@ -855,63 +1289,48 @@ func decodeListContentBlocksParams(args [0]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "modified_before",
+			Name: "include_archived",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(100)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: sort_direction.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "sort_direction",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotSortDirectionVal ListCampaignsSortDirection
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotSortDirectionVal = ListCampaignsSortDirection(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.SortDirection.SetTo(paramsDotSortDirectionVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.SortDirection.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -926,78 +1345,48 @@ func decodeListContentBlocksParams(args [0]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "sort_direction",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode query: last_edit.time[gt].
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "last_edit.time[gt]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotLastEditTimeGtVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLastEditTimeGtVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.LastEditTimeGt.SetTo(paramsDotLastEditTimeGtVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "last_edit.time[gt]",
 			In:   "query",
 			Err:  err,
 		}
@@ -1005,129 +1394,164 @@ func decodeListContentBlocksParams(args [0]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// ListEmailTemplatesParams is parameters of listEmailTemplates operation.
-type ListEmailTemplatesParams struct {
-	// Retrieve only Email Templates updated at or after the given time.
-	ModifiedAfter OptDateTime `json:",omitempty,omitzero"`
-	// Retrieve only Email Templates updated at or before the given time.
-	ModifiedBefore OptDateTime `json:",omitempty,omitzero"`
-	// Maximum number of Email Templates to retrieve.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of Email Templates to skip before returning results.
-	Offset OptInt `json:",omitempty,omitzero"`
+// ListCanvasesParams is parameters of listCanvases operation.
+type ListCanvasesParams struct {
+	// The page of Canvases to return, starting at 0. Each page has up to 100 Canvases.
+	Page OptInt `json:",omitempty,omitzero"`
+	// Whether to include archived Canvases.
+	IncludeArchived OptBool `json:",omitempty,omitzero"`
+	// Sort by creation time, asc for oldest first or desc for newest first.
+	SortDirection OptListCanvasesSortDirection `json:",omitempty,omitzero"`
+	// Retrieve only Canvases edited after the given time.
+	LastEditTimeGt OptDateTime `json:",omitempty,omitzero"`
 }
 
-func unpackListEmailTemplatesParams(packed middleware.Parameters) (params ListEmailTemplatesParams) {
+func unpackListCanvasesParams(packed middleware.Parameters) (params ListCanvasesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "modified_after",
+			Name: "page",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.ModifiedAfter = v.(OptDateTime)
+			params.Page = v.(OptInt)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "modified_before",
+			Name: "include_archived",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.ModifiedBefore = v.(OptDateTime)
+			params.IncludeArchived = v.(OptBool)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "sort_direction",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.SortDirection = v.(OptListCanvasesSortDirection)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "last_edit.time[gt]",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.LastEditTimeGt = v.(OptDateTime)
 		}
 	}
 	return params
 }
 
-func decodeListEmailTemplatesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListEmailTemplatesParams, _ error) {
+func decodeListCanvasesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListCanvasesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: modified_after.
+	// Set default value for query: page.
+	{
+		val := int(0)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "modified_after",
+			Name:    "page",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotModifiedAfterVal time.Time
+				var paramsDotPageVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDateTime(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotModifiedAfterVal = c
+					paramsDotPageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ModifiedAfter.SetTo(paramsDotModifiedAfterVal)
+				params.Page.SetTo(paramsDotPageVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "modified_after",
+			Name: "page",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: modified_before.
+	// Set default value for query: include_archived.
+	{
+		val := bool(false)
+		params.IncludeArchived.SetTo(val)
+	}
+	// Decode query: include_archived.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "modified_before",
+			Name:    "include_archived",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotModifiedBeforeVal time.Time
+				var paramsDotIncludeArchivedVal bool
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToDateTime(val)
+					c, err := conv.ToBool(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotModifiedBeforeVal = c
+					paramsDotIncludeArchivedVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ModifiedBefore.SetTo(paramsDotModifiedBeforeVal)
+				params.IncludeArchived.SetTo(paramsDotIncludeArchivedVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1136,63 +1560,48 @@ func decodeListEmailTemplatesParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "modified_before",
+			Name: "include_archived",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(100)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: sort_direction.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "sort_direction",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotSortDirectionVal ListCanvasesSortDirection
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotSortDirectionVal = ListCanvasesSortDirection(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.SortDirection.SetTo(paramsDotSortDirectionVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.SortDirection.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           1000,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -1207,78 +1616,48 @@ func decodeListEmailTemplatesParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "sort_direction",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode query: last_edit.time[gt].
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "last_edit.time[gt]",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotLastEditTimeGtVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLastEditTimeGtVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.LastEditTimeGt.SetTo(paramsDotLastEditTimeGtVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "last_edit.time[gt]",
 			In:   "query",
 			Err:  err,
 		}
@@ -1286,13 +1665,13 @@ func decodeListEmailTemplatesParams(args [0]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// ReplaceCatalogItemParams is parameters of replaceCatalogItem operation.
-type ReplaceCatalogItemParams struct {
+// ListCatalogItemsParams is parameters of listCatalogItems operation.
+type ListCatalogItemsParams struct {
 	CatalogName string
-	ItemID      string
+	Cursor      OptString `json:",omitempty,omitzero"`
 }
 
-func unpackReplaceCatalogItemParams(packed middleware.Parameters) (params ReplaceCatalogItemParams) {
+func unpackListCatalogItemsParams(packed middleware.Parameters) (params ListCatalogItemsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "catalog_name",
@@ -1302,15 +1681,18 @@ func unpackReplaceCatalogItemParams(packed middleware.Parameters) (params Replac
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "item_id",
-			In:   "path",
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
 		}
-		params.ItemID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeReplaceCatalogItemParams(args [2]string, argsEscaped bool, r *http.Request) (params ReplaceCatalogItemParams, _ error) {
+func decodeListCatalogItemsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListCatalogItemsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: catalog_name.
 	if err := func() error {
 		param := args[0]
@@ -1356,47 +1738,1450 @@ func decodeReplaceCatalogItemParams(args [2]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
-	// Decode path: item_id.
+	// Decode query: cursor.
 	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "item_id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ItemID = c
+				params.Cursor.SetTo(paramsDotCursorVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "item_id",
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListCatalogsParams is parameters of listCatalogs operation.
+type ListCatalogsParams struct {
+	Cursor OptString `json:",omitempty,omitzero"`
+}
+
+func unpackListCatalogsParams(packed middleware.Parameters) (params ListCatalogsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListCatalogsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListCatalogsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListContentBlocksParams is parameters of listContentBlocks operation.
+type ListContentBlocksParams struct {
+	// Retrieve only Content Blocks updated at or after the given time.
+	ModifiedAfter OptDateTime `json:",omitempty,omitzero"`
+	// Retrieve only Content Blocks updated at or before the given time.
+	ModifiedBefore OptDateTime `json:",omitempty,omitzero"`
+	// Maximum number of Content Blocks to retrieve (default 100, max 1000).
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of Content Blocks to skip before returning results.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListContentBlocksParams(packed middleware.Parameters) (params ListContentBlocksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "modified_after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ModifiedAfter = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "modified_before",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ModifiedBefore = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListContentBlocksParams(args [0]string, argsEscaped bool, r *http.Request) (params ListContentBlocksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: modified_after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "modified_after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotModifiedAfterVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotModifiedAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ModifiedAfter.SetTo(paramsDotModifiedAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "modified_after",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: modified_before.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "modified_before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotModifiedBeforeVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotModifiedBeforeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ModifiedBefore.SetTo(paramsDotModifiedBeforeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "modified_before",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListCustomAttributesParams is parameters of listCustomAttributes operation.
+type ListCustomAttributesParams struct {
+	Cursor OptString `json:",omitempty,omitzero"`
+}
+
+func unpackListCustomAttributesParams(packed middleware.Parameters) (params ListCustomAttributesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListCustomAttributesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListCustomAttributesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListCustomEventsParams is parameters of listCustomEvents operation.
+type ListCustomEventsParams struct {
+	Cursor OptString `json:",omitempty,omitzero"`
+}
+
+func unpackListCustomEventsParams(packed middleware.Parameters) (params ListCustomEventsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeListCustomEventsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListCustomEventsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListEmailTemplatesParams is parameters of listEmailTemplates operation.
+type ListEmailTemplatesParams struct {
+	// Retrieve only Email Templates updated at or after the given time.
+	ModifiedAfter OptDateTime `json:",omitempty,omitzero"`
+	// Retrieve only Email Templates updated at or before the given time.
+	ModifiedBefore OptDateTime `json:",omitempty,omitzero"`
+	// Maximum number of Email Templates to retrieve.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of Email Templates to skip before returning results.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListEmailTemplatesParams(packed middleware.Parameters) (params ListEmailTemplatesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "modified_after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ModifiedAfter = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "modified_before",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ModifiedBefore = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListEmailTemplatesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListEmailTemplatesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: modified_after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "modified_after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotModifiedAfterVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotModifiedAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ModifiedAfter.SetTo(paramsDotModifiedAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "modified_after",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: modified_before.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "modified_before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotModifiedBeforeVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotModifiedBeforeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ModifiedBefore.SetTo(paramsDotModifiedBeforeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "modified_before",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           1000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListProductsParams is parameters of listProducts operation.
+type ListProductsParams struct {
+	// The page of products to return, starting at 0. Each page has up to 250 products.
+	Page OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListProductsParams(packed middleware.Parameters) (params ListProductsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListProductsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListProductsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: page.
+	{
+		val := int(0)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListSegmentsParams is parameters of listSegments operation.
+type ListSegmentsParams struct {
+	// The page of segments to return, starting at 0. Each page has up to 100 segments.
+	Page OptInt `json:",omitempty,omitzero"`
+	// Sort by creation time, asc for oldest first or desc for newest first.
+	SortDirection OptListSegmentsSortDirection `json:",omitempty,omitzero"`
+}
+
+func unpackListSegmentsParams(packed middleware.Parameters) (params ListSegmentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort_direction",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortDirection = v.(OptListSegmentsSortDirection)
+		}
+	}
+	return params
+}
+
+func decodeListSegmentsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListSegmentsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: page.
+	{
+		val := int(0)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sort_direction.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort_direction",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortDirectionVal ListSegmentsSortDirection
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortDirectionVal = ListSegmentsSortDirection(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortDirection.SetTo(paramsDotSortDirectionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortDirection.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort_direction",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ReplaceCatalogItemParams is parameters of replaceCatalogItem operation.
+type ReplaceCatalogItemParams struct {
+	CatalogName string
+	ItemID      string
+}
+
+func unpackReplaceCatalogItemParams(packed middleware.Parameters) (params ReplaceCatalogItemParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "catalog_name",
+			In:   "path",
+		}
+		params.CatalogName = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "item_id",
+			In:   "path",
+		}
+		params.ItemID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeReplaceCatalogItemParams(args [2]string, argsEscaped bool, r *http.Request) (params ReplaceCatalogItemParams, _ error) {
+	// Decode path: catalog_name.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "catalog_name",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.CatalogName = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "catalog_name",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: item_id.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "item_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ItemID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "item_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchDashboardUsersParams is parameters of searchDashboardUsers operation.
+type SearchDashboardUsersParams struct {
+	// The origin domain allowlisted for the SCIM token.
+	XRequestOrigin string
+	// A SCIM filter of the form userName eq "user@example.com".
+	Filter string
+}
+
+func unpackSearchDashboardUsersParams(packed middleware.Parameters) (params SearchDashboardUsersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Request-Origin",
+			In:   "header",
+		}
+		params.XRequestOrigin = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter",
+			In:   "query",
+		}
+		params.Filter = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSearchDashboardUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchDashboardUsersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-Request-Origin.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XRequestOrigin = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Request-Origin",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode query: filter.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Filter = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateDashboardUserParams is parameters of updateDashboardUser operation.
+type UpdateDashboardUserParams struct {
+	// The origin domain allowlisted for the SCIM token.
+	XRequestOrigin string
+	// The SCIM identifier of the dashboard user.
+	ID string
+}
+
+func unpackUpdateDashboardUserParams(packed middleware.Parameters) (params UpdateDashboardUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "X-Request-Origin",
+			In:   "header",
+		}
+		params.XRequestOrigin = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateDashboardUserParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateDashboardUserParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode header: X-Request-Origin.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "X-Request-Origin",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.XRequestOrigin = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "X-Request-Origin",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdatePreferenceCenterParams is parameters of updatePreferenceCenter operation.
+type UpdatePreferenceCenterParams struct {
+	// Preference Center identifier.
+	PreferenceCenterExternalID string
+}
+
+func unpackUpdatePreferenceCenterParams(packed middleware.Parameters) (params UpdatePreferenceCenterParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "preference_center_external_id",
+			In:   "path",
+		}
+		params.PreferenceCenterExternalID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdatePreferenceCenterParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdatePreferenceCenterParams, _ error) {
+	// Decode path: preference_center_external_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "preference_center_external_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.PreferenceCenterExternalID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "preference_center_external_id",
 			In:   "path",
 			Err:  err,
 		}
@@ -10,6 +10,34 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 )
 
+func encodeCreateCampaignTriggerScheduleRequest(
+	req *CreateCampaignTriggerScheduleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateCanvasTriggerScheduleRequest(
+	req *CreateCanvasTriggerScheduleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateCatalogRequest(
 	req *CreateCatalogRequest,
 	r *http.Request,
@@ -52,6 +80,20 @@ func encodeCreateContentBlockRequest(
 	return nil
 }
 
+func encodeCreateDashboardUserRequest(
+	req *CreateDashboardUserRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateEmailTemplateRequest(
 	req *CreateEmailTemplateRequest,
 	r *http.Request,
@@ -66,6 +108,90 @@ func encodeCreateEmailTemplateRequest(
 	return nil
 }
 
+func encodeCreatePreferenceCenterRequest(
+	req *CreatePreferenceCenterRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateScheduledMessageRequest(
+	req *CreateScheduledMessageRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateSendIDRequest(
+	req *CreateSendIDRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeDeleteCampaignTriggerScheduleRequest(
+	req *DeleteCampaignTriggerScheduleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeDeleteCanvasTriggerScheduleRequest(
+	req *DeleteCanvasTriggerScheduleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeDeleteScheduledMessageRequest(
+	req *DeleteScheduledMessageRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeReplaceCatalogItemRequest(
 	req *ReplaceCatalogItemRequest,
 	r *http.Request,
@@ -80,6 +206,34 @@ func encodeReplaceCatalogItemRequest(
 	return nil
 }
 
+func encodeUpdateCampaignTriggerScheduleRequest(
+	req *UpdateCampaignTriggerScheduleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateCanvasTriggerScheduleRequest(
+	req *UpdateCanvasTriggerScheduleRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateContentBlockRequest(
 	req *UpdateContentBlockRequest,
 	r *http.Request,
@@ -94,6 +248,20 @@ func encodeUpdateContentBlockRequest(
 	return nil
 }
 
+func encodeUpdateDashboardUserRequest(
+	req *UpdateDashboardUserRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateEmailTemplateRequest(
 	req *UpdateEmailTemplateRequest,
 	r *http.Request,
@@ -107,3 +275,31 @@ func encodeUpdateEmailTemplateRequest(
 	ht.SetBody(r, bytes.NewReader(encoded), contentType)
 	return nil
 }
+
+func encodeUpdatePreferenceCenterRequest(
+	req *UpdatePreferenceCenterRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateScheduledMessageRequest(
+	req *UpdateScheduledMessageRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
@@ -11,44 +11,120 @@ import (
 )
 
 var (
+	rn27AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn38AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
 	rn1AllowedHeaders = map[string]string{
-		"GET":  "Authorization",
 		"POST": "Authorization,Content-Type",
 	}
+	rn19AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn50AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn29AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn39AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
 	rn3AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn21AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn51AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn4AllowedHeaders = map[string]string{
+		"GET":  "Authorization",
+		"POST": "Authorization,Content-Type",
+	}
+	rn6AllowedHeaders = map[string]string{
 		"DELETE": "Authorization",
 	}
-	rn14AllowedHeaders = map[string]string{
+	rn40AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn5AllowedHeaders = map[string]string{
+	rn8AllowedHeaders = map[string]string{
 		"DELETE": "Authorization",
 		"GET":    "Authorization",
 		"POST":   "Authorization,Content-Type",
 		"PUT":    "Authorization,Content-Type",
 	}
-	rn7AllowedHeaders = map[string]string{
+	rn10AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn31AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn41AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn52AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn11AllowedHeaders = map[string]string{
+	rn42AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn43AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
 	rn15AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn25AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn54AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn14AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type",
+	}
+	rn45AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn35AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+		"PUT": "Authorization,Content-Type",
+	}
+	rn47AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn12AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Request-Origin",
+		"POST": "Authorization,Content-Type,X-Request-Origin",
+	}
+	rn23AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Request-Origin",
+		"GET":    "Authorization,X-Request-Origin",
+		"PUT":    "Authorization,Content-Type,X-Request-Origin",
+	}
+	rn37AllowedHeaders = map[string]string{
+		"GET": "Authorization",
+	}
+	rn49AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
 	rn17AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn9AllowedHeaders = map[string]string{
+	rn13AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
-	rn13AllowedHeaders = map[string]string{
+	rn33AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn16AllowedHeaders = map[string]string{
+	rn44AllowedHeaders = map[string]string{
 		"GET": "Authorization",
 	}
-	rn18AllowedHeaders = map[string]string{
+	rn53AllowedHeaders = map[string]string{
 		"POST": "Authorization,Content-Type",
 	}
 )
@@ -116,85 +192,72 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "atalogs"
+				case 'a': // Prefix: "a"
 
-					if l := len("atalogs"); len(elem) >= l && elem[0:l] == "atalogs" {
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleListCatalogsRequest([0]string{}, elemIsEscaped, w, r)
-						case "POST":
-							s.handleCreateCatalogRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "GET,POST",
-								allowedHeaders: rn1AllowedHeaders,
-								acceptPost:     "application/json",
-								acceptPatch:    "",
-							})
-						}
-
-						return
+						break
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'm': // Prefix: "mpaigns/"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("mpaigns/"); len(elem) >= l && elem[0:l] == "mpaigns/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "catalog_name"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+						if len(elem) == 0 {
+							break
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+						switch elem[0] {
+						case 'd': // Prefix: "details"
 
-						if len(elem) == 0 {
-							switch r.Method {
-							case "DELETE":
-								s.handleDeleteCatalogRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, notAllowedParams{
-									allowedMethods: "DELETE",
-									allowedHeaders: rn3AllowedHeaders,
-									acceptPost:     "",
-									acceptPatch:    "",
-								})
+							if l := len("details"); len(elem) >= l && elem[0:l] == "details" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/items"
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetCampaignDetailsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn27AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
 
-							if l := len("/items"); len(elem) >= l && elem[0:l] == "/items" {
+						case 'l': // Prefix: "list"
+
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleListCatalogItemsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleListCampaignsRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, notAllowedParams{
 										allowedMethods: "GET",
-										allowedHeaders: rn14AllowedHeaders,
+										allowedHeaders: rn38AllowedHeaders,
 										acceptPost:     "",
 										acceptPatch:    "",
 									})
@@ -202,51 +265,61 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								return
 							}
+
+						case 't': // Prefix: "trigger/schedule/"
+
+							if l := len("trigger/schedule/"); len(elem) >= l && elem[0:l] == "trigger/schedule/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'c': // Prefix: "create"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "item_id"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleCreateCampaignTriggerScheduleRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn1AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'd': // Prefix: "delete"
+
+								if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+									elem = elem[l:]
+								} else {
 									break
 								}
-								args[1] = elem
-								elem = ""
 
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
-									case "DELETE":
-										s.handleDeleteCatalogItemRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
-									case "GET":
-										s.handleGetCatalogItemRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
 									case "POST":
-										s.handleCreateCatalogItemRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
-									case "PUT":
-										s.handleReplaceCatalogItemRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
+										s.handleDeleteCampaignTriggerScheduleRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, notAllowedParams{
-											allowedMethods: "DELETE,GET,POST,PUT",
-											allowedHeaders: rn5AllowedHeaders,
+											allowedMethods: "POST",
+											allowedHeaders: rn19AllowedHeaders,
 											acceptPost:     "application/json",
 											acceptPatch:    "",
 										})
@@ -255,98 +328,1704 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							}
+							case 'u': // Prefix: "update"
 
-						}
+								if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUpdateCampaignTriggerScheduleRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn50AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
 
-				case 'o': // Prefix: "ontent_blocks/"
+									return
+								}
 
-					if l := len("ontent_blocks/"); len(elem) >= l && elem[0:l] == "ontent_blocks/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'c': // Prefix: "create"
+						}
 
-						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+					case 'n': // Prefix: "nvas/"
+
+						if l := len("nvas/"); len(elem) >= l && elem[0:l] == "nvas/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleCreateContentBlockRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, notAllowedParams{
-									allowedMethods: "POST",
-									allowedHeaders: rn7AllowedHeaders,
-									acceptPost:     "application/json",
-									acceptPatch:    "",
-								})
-							}
-
-							return
+							break
 						}
+						switch elem[0] {
+						case 'd': // Prefix: "details"
 
-					case 'i': // Prefix: "info"
+							if l := len("details"); len(elem) >= l && elem[0:l] == "details" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						if l := len("info"); len(elem) >= l && elem[0:l] == "info" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetCanvasDetailsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn29AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetContentBlockInfoRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, notAllowedParams{
-									allowedMethods: "GET",
-									allowedHeaders: rn11AllowedHeaders,
-									acceptPost:     "",
-									acceptPatch:    "",
-								})
+								return
 							}
 
-							return
-						}
+						case 'l': // Prefix: "list"
 
-					case 'l': // Prefix: "list"
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListCanvasesRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn39AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleListContentBlocksRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, notAllowedParams{
-									allowedMethods: "GET",
-									allowedHeaders: rn15AllowedHeaders,
-									acceptPost:     "",
-									acceptPatch:    "",
-								})
+								return
 							}
 
-							return
-						}
+						case 't': // Prefix: "trigger/schedule/"
+
+							if l := len("trigger/schedule/"); len(elem) >= l && elem[0:l] == "trigger/schedule/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "create"
+
+								if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleCreateCanvasTriggerScheduleRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn3AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'd': // Prefix: "delete"
+
+								if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleDeleteCanvasTriggerScheduleRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn21AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							case 'u': // Prefix: "update"
+
+								if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUpdateCanvasTriggerScheduleRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "POST",
+											allowedHeaders: rn51AllowedHeaders,
+											acceptPost:     "application/json",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 't': // Prefix: "talogs"
+
+						if l := len("talogs"); len(elem) >= l && elem[0:l] == "talogs" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListCatalogsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleCreateCatalogRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET,POST",
+									allowedHeaders: rn4AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "catalog_name"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteCatalogRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "DELETE",
+										allowedHeaders: rn6AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/items"
+
+								if l := len("/items"); len(elem) >= l && elem[0:l] == "/items" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListCatalogItemsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "GET",
+											allowedHeaders: rn40AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "item_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteCatalogItemRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetCatalogItemRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateCatalogItemRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleReplaceCatalogItemRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, notAllowedParams{
+												allowedMethods: "DELETE,GET,POST,PUT",
+												allowedHeaders: rn8AllowedHeaders,
+												acceptPost:     "application/json",
+												acceptPatch:    "",
+											})
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'o': // Prefix: "ontent_blocks/"
+
+					if l := len("ontent_blocks/"); len(elem) >= l && elem[0:l] == "ontent_blocks/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "create"
+
+						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleCreateContentBlockRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "POST",
+									allowedHeaders: rn10AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					case 'i': // Prefix: "info"
+
+						if l := len("info"); len(elem) >= l && elem[0:l] == "info" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetContentBlockInfoRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn31AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					case 'l': // Prefix: "list"
+
+						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleListContentBlocksRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn41AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					case 'u': // Prefix: "update"
+
+						if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleUpdateContentBlockRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "POST",
+									allowedHeaders: rn52AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				case 'u': // Prefix: "ustom_attributes"
+
+					if l := len("ustom_attributes"); len(elem) >= l && elem[0:l] == "ustom_attributes" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListCustomAttributesRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn42AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				}
+
+			case 'e': // Prefix: "events"
+
+				if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleListCustomEventsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET",
+							allowedHeaders: rn43AllowedHeaders,
+							acceptPost:     "",
+							acceptPatch:    "",
+						})
+					}
+
+					return
+				}
+
+			case 'm': // Prefix: "messages/schedule/"
+
+				if l := len("messages/schedule/"); len(elem) >= l && elem[0:l] == "messages/schedule/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "create"
+
+					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleCreateScheduledMessageRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn15AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'd': // Prefix: "delete"
+
+					if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleDeleteScheduledMessageRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn25AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'u': // Prefix: "update"
+
+					if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleUpdateScheduledMessageRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn54AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				}
+
+			case 'p': // Prefix: "p"
+
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'r': // Prefix: "reference_center/v1"
+
+					if l := len("reference_center/v1"); len(elem) >= l && elem[0:l] == "reference_center/v1" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleCreatePreferenceCenterRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn14AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'l': // Prefix: "list"
+							origElem := elem
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListPreferenceCentersRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn45AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+						// Param: "preference_center_external_id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetPreferenceCenterRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdatePreferenceCenterRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET,PUT",
+									allowedHeaders: rn35AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				case 'u': // Prefix: "urchases/product_list"
+
+					if l := len("urchases/product_list"); len(elem) >= l && elem[0:l] == "urchases/product_list" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListProductsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn47AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				}
+
+			case 's': // Prefix: "s"
+
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "cim/v2/Users"
+
+					if l := len("cim/v2/Users"); len(elem) >= l && elem[0:l] == "cim/v2/Users" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleSearchDashboardUsersRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateDashboardUserRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,POST",
+								allowedHeaders: rn12AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteDashboardUserRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetDashboardUserRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateDashboardUserRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE,GET,PUT",
+									allowedHeaders: rn23AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				case 'e': // Prefix: "e"
+
+					if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'g': // Prefix: "gments/"
+
+						if l := len("gments/"); len(elem) >= l && elem[0:l] == "gments/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "details"
+
+							if l := len("details"); len(elem) >= l && elem[0:l] == "details" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetSegmentDetailsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn37AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'l': // Prefix: "list"
+
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListSegmentsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn49AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						}
+
+					case 'n': // Prefix: "nds/id/create"
+
+						if l := len("nds/id/create"); len(elem) >= l && elem[0:l] == "nds/id/create" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleCreateSendIDRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "POST",
+									allowedHeaders: rn17AllowedHeaders,
+									acceptPost:     "application/json",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 't': // Prefix: "templates/email/"
+
+				if l := len("templates/email/"); len(elem) >= l && elem[0:l] == "templates/email/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "create"
+
+					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleCreateEmailTemplateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn13AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'i': // Prefix: "info"
+
+					if l := len("info"); len(elem) >= l && elem[0:l] == "info" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetEmailTemplateInfoRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn33AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'l': // Prefix: "list"
+
+					if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListEmailTemplatesRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET",
+								allowedHeaders: rn44AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				case 'u': // Prefix: "update"
+
+					if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleUpdateEmailTemplateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "POST",
+								allowedHeaders: rn53AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name           string
+	summary        string
+	operationID    string
+	operationGroup string
+	pathPattern    string
+	count          int
+	args           [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// OperationGroup returns the x-ogen-operation-group value.
+func (r Route) OperationGroup() string {
+	return r.operationGroup
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'c': // Prefix: "c"
+
+				if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "a"
+
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'm': // Prefix: "mpaigns/"
+
+						if l := len("mpaigns/"); len(elem) >= l && elem[0:l] == "mpaigns/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "details"
+
+							if l := len("details"); len(elem) >= l && elem[0:l] == "details" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetCampaignDetailsOperation
+									r.summary = "Export campaign details"
+									r.operationID = "getCampaignDetails"
+									r.operationGroup = ""
+									r.pathPattern = "/campaigns/details"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'l': // Prefix: "list"
+
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListCampaignsOperation
+									r.summary = "Export campaigns list"
+									r.operationID = "listCampaigns"
+									r.operationGroup = ""
+									r.pathPattern = "/campaigns/list"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 't': // Prefix: "trigger/schedule/"
+
+							if l := len("trigger/schedule/"); len(elem) >= l && elem[0:l] == "trigger/schedule/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "create"
+
+								if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = CreateCampaignTriggerScheduleOperation
+										r.summary = "Schedule API-triggered campaigns"
+										r.operationID = "createCampaignTriggerSchedule"
+										r.operationGroup = ""
+										r.pathPattern = "/campaigns/trigger/schedule/create"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'd': // Prefix: "delete"
+
+								if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DeleteCampaignTriggerScheduleOperation
+										r.summary = "Delete scheduled API-triggered campaigns"
+										r.operationID = "deleteCampaignTriggerSchedule"
+										r.operationGroup = ""
+										r.pathPattern = "/campaigns/trigger/schedule/delete"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'u': // Prefix: "update"
+
+								if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UpdateCampaignTriggerScheduleOperation
+										r.summary = "Update scheduled API-triggered campaigns"
+										r.operationID = "updateCampaignTriggerSchedule"
+										r.operationGroup = ""
+										r.pathPattern = "/campaigns/trigger/schedule/update"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'n': // Prefix: "nvas/"
+
+						if l := len("nvas/"); len(elem) >= l && elem[0:l] == "nvas/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "details"
+
+							if l := len("details"); len(elem) >= l && elem[0:l] == "details" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetCanvasDetailsOperation
+									r.summary = "Export Canvas details"
+									r.operationID = "getCanvasDetails"
+									r.operationGroup = ""
+									r.pathPattern = "/canvas/details"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'l': // Prefix: "list"
+
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListCanvasesOperation
+									r.summary = "Export Canvas list"
+									r.operationID = "listCanvases"
+									r.operationGroup = ""
+									r.pathPattern = "/canvas/list"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 't': // Prefix: "trigger/schedule/"
+
+							if l := len("trigger/schedule/"); len(elem) >= l && elem[0:l] == "trigger/schedule/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "create"
+
+								if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = CreateCanvasTriggerScheduleOperation
+										r.summary = "Schedule API-triggered Canvases"
+										r.operationID = "createCanvasTriggerSchedule"
+										r.operationGroup = ""
+										r.pathPattern = "/canvas/trigger/schedule/create"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'd': // Prefix: "delete"
+
+								if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DeleteCanvasTriggerScheduleOperation
+										r.summary = "Delete scheduled API-triggered Canvases"
+										r.operationID = "deleteCanvasTriggerSchedule"
+										r.operationGroup = ""
+										r.pathPattern = "/canvas/trigger/schedule/delete"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'u': // Prefix: "update"
+
+								if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UpdateCanvasTriggerScheduleOperation
+										r.summary = "Update scheduled API-triggered Canvases"
+										r.operationID = "updateCanvasTriggerSchedule"
+										r.operationGroup = ""
+										r.pathPattern = "/canvas/trigger/schedule/update"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 't': // Prefix: "talogs"
+
+						if l := len("talogs"); len(elem) >= l && elem[0:l] == "talogs" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListCatalogsOperation
+								r.summary = "List catalogs"
+								r.operationID = "listCatalogs"
+								r.operationGroup = ""
+								r.pathPattern = "/catalogs"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateCatalogOperation
+								r.summary = "Create catalog"
+								r.operationID = "createCatalog"
+								r.operationGroup = ""
+								r.pathPattern = "/catalogs"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "catalog_name"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = DeleteCatalogOperation
+									r.summary = "Delete catalog"
+									r.operationID = "deleteCatalog"
+									r.operationGroup = ""
+									r.pathPattern = "/catalogs/{catalog_name}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/items"
+
+								if l := len("/items"); len(elem) >= l && elem[0:l] == "/items" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListCatalogItemsOperation
+										r.summary = "List catalog items"
+										r.operationID = "listCatalogItems"
+										r.operationGroup = ""
+										r.pathPattern = "/catalogs/{catalog_name}/items"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "item_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteCatalogItemOperation
+											r.summary = "Delete catalog item"
+											r.operationID = "deleteCatalogItem"
+											r.operationGroup = ""
+											r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "GET":
+											r.name = GetCatalogItemOperation
+											r.summary = "Get catalog item"
+											r.operationID = "getCatalogItem"
+											r.operationGroup = ""
+											r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "POST":
+											r.name = CreateCatalogItemOperation
+											r.summary = "Create catalog item"
+											r.operationID = "createCatalogItem"
+											r.operationGroup = ""
+											r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "PUT":
+											r.name = ReplaceCatalogItemOperation
+											r.summary = "Replace catalog item"
+											r.operationID = "replaceCatalogItem"
+											r.operationGroup = ""
+											r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'o': // Prefix: "ontent_blocks/"
+
+					if l := len("ontent_blocks/"); len(elem) >= l && elem[0:l] == "ontent_blocks/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "create"
+
+						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = CreateContentBlockOperation
+								r.summary = "Create Content Block"
+								r.operationID = "createContentBlock"
+								r.operationGroup = ""
+								r.pathPattern = "/content_blocks/create"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'i': // Prefix: "info"
+
+						if l := len("info"); len(elem) >= l && elem[0:l] == "info" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetContentBlockInfoOperation
+								r.summary = "See Content Block information"
+								r.operationID = "getContentBlockInfo"
+								r.operationGroup = ""
+								r.pathPattern = "/content_blocks/info"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "list"
+
+						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ListContentBlocksOperation
+								r.summary = "List available Content Blocks"
+								r.operationID = "listContentBlocks"
+								r.operationGroup = ""
+								r.pathPattern = "/content_blocks/list"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
 
 					case 'u': // Prefix: "update"
 
@@ -358,40 +2037,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						if len(elem) == 0 {
 							// Leaf node.
-							switch r.Method {
+							switch method {
 							case "POST":
-								s.handleUpdateContentBlockRequest([0]string{}, elemIsEscaped, w, r)
+								r.name = UpdateContentBlockOperation
+								r.summary = "Update Content Block"
+								r.operationID = "updateContentBlock"
+								r.operationGroup = ""
+								r.pathPattern = "/content_blocks/update"
+								r.args = args
+								r.count = 0
+								return r, true
 							default:
-								s.notAllowed(w, r, notAllowedParams{
-									allowedMethods: "POST",
-									allowedHeaders: rn17AllowedHeaders,
-									acceptPost:     "application/json",
-									acceptPatch:    "",
-								})
+								return
 							}
-
-							return
 						}
 
 					}
 
-				}
-
-			case 't': // Prefix: "templates/email/"
-
-				if l := len("templates/email/"); len(elem) >= l && elem[0:l] == "templates/email/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'c': // Prefix: "create"
+				case 'u': // Prefix: "ustom_attributes"
 
-					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+					if l := len("ustom_attributes"); len(elem) >= l && elem[0:l] == "ustom_attributes" {
 						elem = elem[l:]
 					} else {
 						break
@@ -399,49 +2064,63 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					if len(elem) == 0 {
 						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleCreateEmailTemplateRequest([0]string{}, elemIsEscaped, w, r)
+						switch method {
+						case "GET":
+							r.name = ListCustomAttributesOperation
+							r.summary = "Export custom attributes"
+							r.operationID = "listCustomAttributes"
+							r.operationGroup = ""
+							r.pathPattern = "/custom_attributes"
+							r.args = args
+							r.count = 0
+							return r, true
 						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "POST",
-								allowedHeaders: rn9AllowedHeaders,
-								acceptPost:     "application/json",
-								acceptPatch:    "",
-							})
+							return
 						}
-
-						return
 					}
 
-				case 'i': // Prefix: "info"
+				}
 
-					if l := len("info"); len(elem) >= l && elem[0:l] == "info" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+			case 'e': // Prefix: "events"
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetEmailTemplateInfoRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "GET",
-								allowedHeaders: rn13AllowedHeaders,
-								acceptPost:     "",
-								acceptPatch:    "",
-							})
-						}
+				if l := len("events"); len(elem) >= l && elem[0:l] == "events" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = ListCustomEventsOperation
+						r.summary = "Export custom events"
+						r.operationID = "listCustomEvents"
+						r.operationGroup = ""
+						r.pathPattern = "/events"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
 						return
 					}
+				}
 
-				case 'l': // Prefix: "list"
+			case 'm': // Prefix: "messages/schedule/"
 
-					if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+				if l := len("messages/schedule/"); len(elem) >= l && elem[0:l] == "messages/schedule/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "create"
+
+					if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
 						elem = elem[l:]
 					} else {
 						break
@@ -449,24 +2128,24 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					if len(elem) == 0 {
 						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleListEmailTemplatesRequest([0]string{}, elemIsEscaped, w, r)
+						switch method {
+						case "POST":
+							r.name = CreateScheduledMessageOperation
+							r.summary = "Schedule API-triggered messages"
+							r.operationID = "createScheduledMessage"
+							r.operationGroup = ""
+							r.pathPattern = "/messages/schedule/create"
+							r.args = args
+							r.count = 0
+							return r, true
 						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "GET",
-								allowedHeaders: rn16AllowedHeaders,
-								acceptPost:     "",
-								acceptPatch:    "",
-							})
+							return
 						}
-
-						return
 					}
 
-				case 'u': // Prefix: "update"
+				case 'd': // Prefix: "delete"
 
-					if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+					if l := len("delete"); len(elem) >= l && elem[0:l] == "delete" {
 						elem = elem[l:]
 					} else {
 						break
@@ -474,126 +2153,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					if len(elem) == 0 {
 						// Leaf node.
-						switch r.Method {
+						switch method {
 						case "POST":
-							s.handleUpdateEmailTemplateRequest([0]string{}, elemIsEscaped, w, r)
+							r.name = DeleteScheduledMessageOperation
+							r.summary = "Delete scheduled API-triggered messages"
+							r.operationID = "deleteScheduledMessage"
+							r.operationGroup = ""
+							r.pathPattern = "/messages/schedule/delete"
+							r.args = args
+							r.count = 0
+							return r, true
 						default:
-							s.notAllowed(w, r, notAllowedParams{
-								allowedMethods: "POST",
-								allowedHeaders: rn18AllowedHeaders,
-								acceptPost:     "application/json",
-								acceptPatch:    "",
-							})
+							return
 						}
-
-						return
 					}
 
-				}
-
-			}
-
-		}
-	}
-	s.notFound(w, r)
-}
-
-// Route is route object.
-type Route struct {
-	name           string
-	summary        string
-	operationID    string
-	operationGroup string
-	pathPattern    string
-	count          int
-	args           [2]string
-}
-
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
-
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
-
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
-
-// OperationGroup returns the x-ogen-operation-group value.
-func (r Route) OperationGroup() string {
-	return r.operationGroup
-}
-
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
-
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
-
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
-
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
-
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+				case 'u': // Prefix: "update"
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+					if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = UpdateScheduledMessageOperation
+							r.summary = "Update scheduled API-triggered messages"
+							r.operationID = "updateScheduledMessage"
+							r.operationGroup = ""
+							r.pathPattern = "/messages/schedule/update"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'c': // Prefix: "c"
+				}
 
-				if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+			case 'p': // Prefix: "p"
+
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 					elem = elem[l:]
 				} else {
 					break
@@ -603,9 +2207,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "atalogs"
+				case 'r': // Prefix: "reference_center/v1"
 
-					if l := len("atalogs"); len(elem) >= l && elem[0:l] == "atalogs" {
+					if l := len("reference_center/v1"); len(elem) >= l && elem[0:l] == "reference_center/v1" {
 						elem = elem[l:]
 					} else {
 						break
@@ -613,21 +2217,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					if len(elem) == 0 {
 						switch method {
-						case "GET":
-							r.name = ListCatalogsOperation
-							r.summary = "List catalogs"
-							r.operationID = "listCatalogs"
-							r.operationGroup = ""
-							r.pathPattern = "/catalogs"
-							r.args = args
-							r.count = 0
-							return r, true
 						case "POST":
-							r.name = CreateCatalogOperation
-							r.summary = "Create catalog"
-							r.operationID = "createCatalog"
+							r.name = CreatePreferenceCenterOperation
+							r.summary = "Create Preference Center"
+							r.operationID = "createPreferenceCenter"
 							r.operationGroup = ""
-							r.pathPattern = "/catalogs"
+							r.pathPattern = "/preference_center/v1"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -644,212 +2239,279 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 
-						// Param: "catalog_name"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
-							switch method {
-							case "DELETE":
-								r.name = DeleteCatalogOperation
-								r.summary = "Delete catalog"
-								r.operationID = "deleteCatalog"
-								r.operationGroup = ""
-								r.pathPattern = "/catalogs/{catalog_name}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/items"
-
-							if l := len("/items"); len(elem) >= l && elem[0:l] == "/items" {
+						case 'l': // Prefix: "list"
+							origElem := elem
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = ListCatalogItemsOperation
-									r.summary = "List catalog items"
-									r.operationID = "listCatalogItems"
+									r.name = ListPreferenceCentersOperation
+									r.summary = "List Preference Centers"
+									r.operationID = "listPreferenceCenters"
 									r.operationGroup = ""
-									r.pathPattern = "/catalogs/{catalog_name}/items"
+									r.pathPattern = "/preference_center/v1/list"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
 									return
 								}
 							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
 
-								// Param: "item_id"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[1] = elem
-								elem = ""
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "DELETE":
-										r.name = DeleteCatalogItemOperation
-										r.summary = "Delete catalog item"
-										r.operationID = "deleteCatalogItem"
-										r.operationGroup = ""
-										r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
-										r.args = args
-										r.count = 2
-										return r, true
-									case "GET":
-										r.name = GetCatalogItemOperation
-										r.summary = "Get catalog item"
-										r.operationID = "getCatalogItem"
-										r.operationGroup = ""
-										r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
-										r.args = args
-										r.count = 2
-										return r, true
-									case "POST":
-										r.name = CreateCatalogItemOperation
-										r.summary = "Create catalog item"
-										r.operationID = "createCatalogItem"
-										r.operationGroup = ""
-										r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
-										r.args = args
-										r.count = 2
-										return r, true
-									case "PUT":
-										r.name = ReplaceCatalogItemOperation
-										r.summary = "Replace catalog item"
-										r.operationID = "replaceCatalogItem"
-										r.operationGroup = ""
-										r.pathPattern = "/catalogs/{catalog_name}/items/{item_id}"
-										r.args = args
-										r.count = 2
-										return r, true
-									default:
-										return
-									}
-								}
+							elem = origElem
+						}
+						// Param: "preference_center_external_id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
 
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetPreferenceCenterOperation
+								r.summary = "View details for Preference Center"
+								r.operationID = "getPreferenceCenter"
+								r.operationGroup = ""
+								r.pathPattern = "/preference_center/v1/{preference_center_external_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdatePreferenceCenterOperation
+								r.summary = "Update Preference Center"
+								r.operationID = "updatePreferenceCenter"
+								r.operationGroup = ""
+								r.pathPattern = "/preference_center/v1/{preference_center_external_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
 							}
-
 						}
 
 					}
 
-				case 'o': // Prefix: "ontent_blocks/"
+				case 'u': // Prefix: "urchases/product_list"
 
-					if l := len("ontent_blocks/"); len(elem) >= l && elem[0:l] == "ontent_blocks/" {
+					if l := len("urchases/product_list"); len(elem) >= l && elem[0:l] == "urchases/product_list" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListProductsOperation
+							r.summary = "Export product IDs"
+							r.operationID = "listProducts"
+							r.operationGroup = ""
+							r.pathPattern = "/purchases/product_list"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 's': // Prefix: "s"
+
+				if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "cim/v2/Users"
+
+					if l := len("cim/v2/Users"); len(elem) >= l && elem[0:l] == "cim/v2/Users" {
+						elem = elem[l:]
+					} else {
 						break
 					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = SearchDashboardUsersOperation
+							r.summary = "Search existing dashboard user by email"
+							r.operationID = "searchDashboardUsers"
+							r.operationGroup = ""
+							r.pathPattern = "/scim/v2/Users"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateDashboardUserOperation
+							r.summary = "Create new dashboard user account"
+							r.operationID = "createDashboardUser"
+							r.operationGroup = ""
+							r.pathPattern = "/scim/v2/Users"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
 					switch elem[0] {
-					case 'c': // Prefix: "create"
+					case '/': // Prefix: "/"
 
-						if l := len("create"); len(elem) >= l && elem[0:l] == "create" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = CreateContentBlockOperation
-								r.summary = "Create Content Block"
-								r.operationID = "createContentBlock"
-								r.operationGroup = ""
-								r.pathPattern = "/content_blocks/create"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-					case 'i': // Prefix: "info"
-
-						if l := len("info"); len(elem) >= l && elem[0:l] == "info" {
-							elem = elem[l:]
-						} else {
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
 							break
 						}
+						args[0] = elem
+						elem = ""
 
 						if len(elem) == 0 {
 							// Leaf node.
 							switch method {
+							case "DELETE":
+								r.name = DeleteDashboardUserOperation
+								r.summary = "Remove dashboard user account"
+								r.operationID = "deleteDashboardUser"
+								r.operationGroup = ""
+								r.pathPattern = "/scim/v2/Users/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
 							case "GET":
-								r.name = GetContentBlockInfoOperation
-								r.summary = "See Content Block information"
-								r.operationID = "getContentBlockInfo"
+								r.name = GetDashboardUserOperation
+								r.summary = "Look up an existing dashboard user account"
+								r.operationID = "getDashboardUser"
 								r.operationGroup = ""
-								r.pathPattern = "/content_blocks/info"
+								r.pathPattern = "/scim/v2/Users/{id}"
 								r.args = args
-								r.count = 0
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateDashboardUserOperation
+								r.summary = "Update dashboard user account"
+								r.operationID = "updateDashboardUser"
+								r.operationGroup = ""
+								r.pathPattern = "/scim/v2/Users/{id}"
+								r.args = args
+								r.count = 1
 								return r, true
 							default:
 								return
 							}
 						}
 
-					case 'l': // Prefix: "list"
+					}
 
-						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+				case 'e': // Prefix: "e"
+
+					if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'g': // Prefix: "gments/"
+
+						if l := len("gments/"); len(elem) >= l && elem[0:l] == "gments/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = ListContentBlocksOperation
-								r.summary = "List available Content Blocks"
-								r.operationID = "listContentBlocks"
-								r.operationGroup = ""
-								r.pathPattern = "/content_blocks/list"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "details"
+
+							if l := len("details"); len(elem) >= l && elem[0:l] == "details" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetSegmentDetailsOperation
+									r.summary = "Export segment details"
+									r.operationID = "getSegmentDetails"
+									r.operationGroup = ""
+									r.pathPattern = "/segments/details"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'l': // Prefix: "list"
+
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListSegmentsOperation
+									r.summary = "Export segment list"
+									r.operationID = "listSegments"
+									r.operationGroup = ""
+									r.pathPattern = "/segments/list"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
+
 						}
 
-					case 'u': // Prefix: "update"
+					case 'n': // Prefix: "nds/id/create"
 
-						if l := len("update"); len(elem) >= l && elem[0:l] == "update" {
+						if l := len("nds/id/create"); len(elem) >= l && elem[0:l] == "nds/id/create" {
 							elem = elem[l:]
 						} else {
 							break
@@ -859,11 +2521,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							// Leaf node.
 							switch method {
 							case "POST":
-								r.name = UpdateContentBlockOperation
-								r.summary = "Update Content Block"
-								r.operationID = "updateContentBlock"
+								r.name = CreateSendIDOperation
+								r.summary = "Create send IDs for message send tracking"
+								r.operationID = "createSendID"
 								r.operationGroup = ""
-								r.pathPattern = "/content_blocks/update"
+								r.pathPattern = "/sends/id/create"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -39,6 +39,96 @@ func (s *BrazeApiKey) SetRoles(val []string) {
 	s.Roles = val
 }
 
+type BrazeScimToken struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BrazeScimToken) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BrazeScimToken) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BrazeScimToken) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BrazeScimToken) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// Ref: #/CampaignTriggerRecipient
+type CampaignTriggerRecipient struct {
+	ExternalUserID    string               `json:"external_user_id"`
+	TriggerProperties OptTriggerProperties `json:"trigger_properties"`
+}
+
+// GetExternalUserID returns the value of ExternalUserID.
+func (s *CampaignTriggerRecipient) GetExternalUserID() string {
+	return s.ExternalUserID
+}
+
+// GetTriggerProperties returns the value of TriggerProperties.
+func (s *CampaignTriggerRecipient) GetTriggerProperties() OptTriggerProperties {
+	return s.TriggerProperties
+}
+
+// SetExternalUserID sets the value of ExternalUserID.
+func (s *CampaignTriggerRecipient) SetExternalUserID(val string) {
+	s.ExternalUserID = val
+}
+
+// SetTriggerProperties sets the value of TriggerProperties.
+func (s *CampaignTriggerRecipient) SetTriggerProperties(val OptTriggerProperties) {
+	s.TriggerProperties = val
+}
+
+// Personalization key-value pairs for the Canvas, available as canvas_entry_properties in Liquid.
+// Ref: #/CanvasEntryProperties
+type CanvasEntryProperties map[string]jx.Raw
+
+func (s *CanvasEntryProperties) init() CanvasEntryProperties {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/CanvasTriggerRecipient
+type CanvasTriggerRecipient struct {
+	ExternalUserID        string                   `json:"external_user_id"`
+	CanvasEntryProperties OptCanvasEntryProperties `json:"canvas_entry_properties"`
+}
+
+// GetExternalUserID returns the value of ExternalUserID.
+func (s *CanvasTriggerRecipient) GetExternalUserID() string {
+	return s.ExternalUserID
+}
+
+// GetCanvasEntryProperties returns the value of CanvasEntryProperties.
+func (s *CanvasTriggerRecipient) GetCanvasEntryProperties() OptCanvasEntryProperties {
+	return s.CanvasEntryProperties
+}
+
+// SetExternalUserID sets the value of ExternalUserID.
+func (s *CanvasTriggerRecipient) SetExternalUserID(val string) {
+	s.ExternalUserID = val
+}
+
+// SetCanvasEntryProperties sets the value of CanvasEntryProperties.
+func (s *CanvasTriggerRecipient) SetCanvasEntryProperties(val OptCanvasEntryProperties) {
+	s.CanvasEntryProperties = val
+}
+
 // Ref: #/Catalog
 type Catalog struct {
 	Name        string         `json:"name"`
@@ -264,6 +354,246 @@ func (s *CatalogItemWrite) init() CatalogItemWrite {
 	return m
 }
 
+// A Connected Audience filter that selects the users to send to.
+// Ref: #/ConnectedAudience
+type ConnectedAudience map[string]jx.Raw
+
+func (s *ConnectedAudience) init() ConnectedAudience {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/CreateCampaignTriggerScheduleRequest
+type CreateCampaignTriggerScheduleRequest struct {
+	CampaignID string                     `json:"campaign_id"`
+	SendID     OptString                  `json:"send_id"`
+	Recipients []CampaignTriggerRecipient `json:"recipients"`
+	Audience   OptConnectedAudience       `json:"audience"`
+	// Whether to send to the campaign's whole segment rather than to recipients.
+	Broadcast         OptBool              `json:"broadcast"`
+	TriggerProperties OptTriggerProperties `json:"trigger_properties"`
+	Schedule          MessageSchedule      `json:"schedule"`
+}
+
+// GetCampaignID returns the value of CampaignID.
+func (s *CreateCampaignTriggerScheduleRequest) GetCampaignID() string {
+	return s.CampaignID
+}
+
+// GetSendID returns the value of SendID.
+func (s *CreateCampaignTriggerScheduleRequest) GetSendID() OptString {
+	return s.SendID
+}
+
+// GetRecipients returns the value of Recipients.
+func (s *CreateCampaignTriggerScheduleRequest) GetRecipients() []CampaignTriggerRecipient {
+	return s.Recipients
+}
+
+// GetAudience returns the value of Audience.
+func (s *CreateCampaignTriggerScheduleRequest) GetAudience() OptConnectedAudience {
+	return s.Audience
+}
+
+// GetBroadcast returns the value of Broadcast.
+func (s *CreateCampaignTriggerScheduleRequest) GetBroadcast() OptBool {
+	return s.Broadcast
+}
+
+// GetTriggerProperties returns the value of TriggerProperties.
+func (s *CreateCampaignTriggerScheduleRequest) GetTriggerProperties() OptTriggerProperties {
+	return s.TriggerProperties
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *CreateCampaignTriggerScheduleRequest) GetSchedule() MessageSchedule {
+	return s.Schedule
+}
+
+// SetCampaignID sets the value of CampaignID.
+func (s *CreateCampaignTriggerScheduleRequest) SetCampaignID(val string) {
+	s.CampaignID = val
+}
+
+// SetSendID sets the value of SendID.
+func (s *CreateCampaignTriggerScheduleRequest) SetSendID(val OptString) {
+	s.SendID = val
+}
+
+// SetRecipients sets the value of Recipients.
+func (s *CreateCampaignTriggerScheduleRequest) SetRecipients(val []CampaignTriggerRecipient) {
+	s.Recipients = val
+}
+
+// SetAudience sets the value of Audience.
+func (s *CreateCampaignTriggerScheduleRequest) SetAudience(val OptConnectedAudience) {
+	s.Audience = val
+}
+
+// SetBroadcast sets the value of Broadcast.
+func (s *CreateCampaignTriggerScheduleRequest) SetBroadcast(val OptBool) {
+	s.Broadcast = val
+}
+
+// SetTriggerProperties sets the value of TriggerProperties.
+func (s *CreateCampaignTriggerScheduleRequest) SetTriggerProperties(val OptTriggerProperties) {
+	s.TriggerProperties = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *CreateCampaignTriggerScheduleRequest) SetSchedule(val MessageSchedule) {
+	s.Schedule = val
+}
+
+// Ref: #/CreateCampaignTriggerScheduleResponse
+type CreateCampaignTriggerScheduleResponse struct {
+	DispatchID OptString `json:"dispatch_id"`
+	ScheduleID string    `json:"schedule_id"`
+	Message    OptString `json:"message"`
+}
+
+// GetDispatchID returns the value of DispatchID.
+func (s *CreateCampaignTriggerScheduleResponse) GetDispatchID() OptString {
+	return s.DispatchID
+}
+
+// GetScheduleID returns the value of ScheduleID.
+func (s *CreateCampaignTriggerScheduleResponse) GetScheduleID() string {
+	return s.ScheduleID
+}
+
+// GetMessage returns the value of Message.
+func (s *CreateCampaignTriggerScheduleResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetDispatchID sets the value of DispatchID.
+func (s *CreateCampaignTriggerScheduleResponse) SetDispatchID(val OptString) {
+	s.DispatchID = val
+}
+
+// SetScheduleID sets the value of ScheduleID.
+func (s *CreateCampaignTriggerScheduleResponse) SetScheduleID(val string) {
+	s.ScheduleID = val
+}
+
+// SetMessage sets the value of Message.
+func (s *CreateCampaignTriggerScheduleResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/CreateCanvasTriggerScheduleRequest
+type CreateCanvasTriggerScheduleRequest struct {
+	CanvasID   string                   `json:"canvas_id"`
+	Recipients []CanvasTriggerRecipient `json:"recipients"`
+	Audience   OptConnectedAudience     `json:"audience"`
+	// Whether to send to the Canvas's whole segment rather than to recipients.
+	Broadcast             OptBool                  `json:"broadcast"`
+	CanvasEntryProperties OptCanvasEntryProperties `json:"canvas_entry_properties"`
+	Schedule              MessageSchedule          `json:"schedule"`
+}
+
+// GetCanvasID returns the value of CanvasID.
+func (s *CreateCanvasTriggerScheduleRequest) GetCanvasID() string {
+	return s.CanvasID
+}
+
+// GetRecipients returns the value of Recipients.
+func (s *CreateCanvasTriggerScheduleRequest) GetRecipients() []CanvasTriggerRecipient {
+	return s.Recipients
+}
+
+// GetAudience returns the value of Audience.
+func (s *CreateCanvasTriggerScheduleRequest) GetAudience() OptConnectedAudience {
+	return s.Audience
+}
+
+// GetBroadcast returns the value of Broadcast.
+func (s *CreateCanvasTriggerScheduleRequest) GetBroadcast() OptBool {
+	return s.Broadcast
+}
+
+// GetCanvasEntryProperties returns the value of CanvasEntryProperties.
+func (s *CreateCanvasTriggerScheduleRequest) GetCanvasEntryProperties() OptCanvasEntryProperties {
+	return s.CanvasEntryProperties
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *CreateCanvasTriggerScheduleRequest) GetSchedule() MessageSchedule {
+	return s.Schedule
+}
+
+// SetCanvasID sets the value of CanvasID.
+func (s *CreateCanvasTriggerScheduleRequest) SetCanvasID(val string) {
+	s.CanvasID = val
+}
+
+// SetRecipients sets the value of Recipients.
+func (s *CreateCanvasTriggerScheduleRequest) SetRecipients(val []CanvasTriggerRecipient) {
+	s.Recipients = val
+}
+
+// SetAudience sets the value of Audience.
+func (s *CreateCanvasTriggerScheduleRequest) SetAudience(val OptConnectedAudience) {
+	s.Audience = val
+}
+
+// SetBroadcast sets the value of Broadcast.
+func (s *CreateCanvasTriggerScheduleRequest) SetBroadcast(val OptBool) {
+	s.Broadcast = val
+}
+
+// SetCanvasEntryProperties sets the value of CanvasEntryProperties.
+func (s *CreateCanvasTriggerScheduleRequest) SetCanvasEntryProperties(val OptCanvasEntryProperties) {
+	s.CanvasEntryProperties = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *CreateCanvasTriggerScheduleRequest) SetSchedule(val MessageSchedule) {
+	s.Schedule = val
+}
+
+// Ref: #/CreateCanvasTriggerScheduleResponse
+type CreateCanvasTriggerScheduleResponse struct {
+	DispatchID OptString `json:"dispatch_id"`
+	ScheduleID string    `json:"schedule_id"`
+	Message    OptString `json:"message"`
+}
+
+// GetDispatchID returns the value of DispatchID.
+func (s *CreateCanvasTriggerScheduleResponse) GetDispatchID() OptString {
+	return s.DispatchID
+}
+
+// GetScheduleID returns the value of ScheduleID.
+func (s *CreateCanvasTriggerScheduleResponse) GetScheduleID() string {
+	return s.ScheduleID
+}
+
+// GetMessage returns the value of Message.
+func (s *CreateCanvasTriggerScheduleResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetDispatchID sets the value of DispatchID.
+func (s *CreateCanvasTriggerScheduleResponse) SetDispatchID(val OptString) {
+	s.DispatchID = val
+}
+
+// SetScheduleID sets the value of ScheduleID.
+func (s *CreateCanvasTriggerScheduleResponse) SetScheduleID(val string) {
+	s.ScheduleID = val
+}
+
+// SetMessage sets the value of Message.
+func (s *CreateCanvasTriggerScheduleResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
 // Ref: #/CreateCatalogItemRequest
 type CreateCatalogItemRequest struct {
 	Items []CatalogItemWrite `json:"items"`
@@ -454,6 +784,68 @@ func (s *CreateContentBlockResponse) SetMessage(val string) {
 	s.Message = val
 }
 
+// Ref: #/CreateDashboardUserRequest
+type CreateDashboardUserRequest struct {
+	// The SCIM schemas of the request, urn:ietf:params:scim:schemas:core:2.0:User.
+	Schemas []string `json:"schemas"`
+	// The email address of the dashboard user.
+	UserName string            `json:"userName"`
+	Name     DashboardUserName `json:"name"`
+	// The department of the dashboard user.
+	Department  string                   `json:"department"`
+	Permissions DashboardUserPermissions `json:"permissions"`
+}
+
+// GetSchemas returns the value of Schemas.
+func (s *CreateDashboardUserRequest) GetSchemas() []string {
+	return s.Schemas
+}
+
+// GetUserName returns the value of UserName.
+func (s *CreateDashboardUserRequest) GetUserName() string {
+	return s.UserName
+}
+
+// GetName returns the value of Name.
+func (s *CreateDashboardUserRequest) GetName() DashboardUserName {
+	return s.Name
+}
+
+// GetDepartment returns the value of Department.
+func (s *CreateDashboardUserRequest) GetDepartment() string {
+	return s.Department
+}
+
+// GetPermissions returns the value of Permissions.
+func (s *CreateDashboardUserRequest) GetPermissions() DashboardUserPermissions {
+	return s.Permissions
+}
+
+// SetSchemas sets the value of Schemas.
+func (s *CreateDashboardUserRequest) SetSchemas(val []string) {
+	s.Schemas = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *CreateDashboardUserRequest) SetUserName(val string) {
+	s.UserName = val
+}
+
+// SetName sets the value of Name.
+func (s *CreateDashboardUserRequest) SetName(val DashboardUserName) {
+	s.Name = val
+}
+
+// SetDepartment sets the value of Department.
+func (s *CreateDashboardUserRequest) SetDepartment(val string) {
+	s.Department = val
+}
+
+// SetPermissions sets the value of Permissions.
+func (s *CreateDashboardUserRequest) SetPermissions(val DashboardUserPermissions) {
+	s.Permissions = val
+}
+
 // Ref: #/CreateEmailTemplateRequest
 type CreateEmailTemplateRequest struct {
 	// The name of your email template.
@@ -569,465 +961,2205 @@ func (s *CreateEmailTemplateResponse) SetMessage(val OptString) {
 	s.Message = val
 }
 
-// Ref: #/DeleteCatalogItemResponse
-type DeleteCatalogItemResponse struct {
-	Message string `json:"message"`
-}
-
-// GetMessage returns the value of Message.
-func (s *DeleteCatalogItemResponse) GetMessage() string {
-	return s.Message
+// Ref: #/CreatePreferenceCenterRequest
+type CreatePreferenceCenterRequest struct {
+	// The name of the preference center.
+	Name string `json:"name"`
+	// The title of the preference center and confirmation pages.
+	PreferenceCenterTitle OptString `json:"preference_center_title"`
+	// The HTML of the preference center page.
+	PreferenceCenterPageHTML string `json:"preference_center_page_html"`
+	// The HTML of the confirmation page.
+	ConfirmationPageHTML string                     `json:"confirmation_page_html"`
+	State                OptPreferenceCenterState   `json:"state"`
+	Options              OptPreferenceCenterOptions `json:"options"`
 }
 
-// SetMessage sets the value of Message.
-func (s *DeleteCatalogItemResponse) SetMessage(val string) {
-	s.Message = val
+// GetName returns the value of Name.
+func (s *CreatePreferenceCenterRequest) GetName() string {
+	return s.Name
 }
 
-// Ref: #/DeleteCatalogResponse
-type DeleteCatalogResponse struct {
-	Message string `json:"message"`
+// GetPreferenceCenterTitle returns the value of PreferenceCenterTitle.
+func (s *CreatePreferenceCenterRequest) GetPreferenceCenterTitle() OptString {
+	return s.PreferenceCenterTitle
 }
 
-// GetMessage returns the value of Message.
-func (s *DeleteCatalogResponse) GetMessage() string {
-	return s.Message
+// GetPreferenceCenterPageHTML returns the value of PreferenceCenterPageHTML.
+func (s *CreatePreferenceCenterRequest) GetPreferenceCenterPageHTML() string {
+	return s.PreferenceCenterPageHTML
 }
 
-// SetMessage sets the value of Message.
-func (s *DeleteCatalogResponse) SetMessage(val string) {
-	s.Message = val
+// GetConfirmationPageHTML returns the value of ConfirmationPageHTML.
+func (s *CreatePreferenceCenterRequest) GetConfirmationPageHTML() string {
+	return s.ConfirmationPageHTML
 }
 
-type ErrorResponse struct {
-	// Error message describing what went wrong.
-	Message string `json:"message"`
-	// Array of minor error messages.
-	Errors []string `json:"errors"`
+// GetState returns the value of State.
+func (s *CreatePreferenceCenterRequest) GetState() OptPreferenceCenterState {
+	return s.State
 }
 
-// GetMessage returns the value of Message.
-func (s *ErrorResponse) GetMessage() string {
-	return s.Message
+// GetOptions returns the value of Options.
+func (s *CreatePreferenceCenterRequest) GetOptions() OptPreferenceCenterOptions {
+	return s.Options
 }
 
-// GetErrors returns the value of Errors.
-func (s *ErrorResponse) GetErrors() []string {
-	return s.Errors
+// SetName sets the value of Name.
+func (s *CreatePreferenceCenterRequest) SetName(val string) {
+	s.Name = val
 }
 
-// SetMessage sets the value of Message.
-func (s *ErrorResponse) SetMessage(val string) {
-	s.Message = val
+// SetPreferenceCenterTitle sets the value of PreferenceCenterTitle.
+func (s *CreatePreferenceCenterRequest) SetPreferenceCenterTitle(val OptString) {
+	s.PreferenceCenterTitle = val
 }
 
-// SetErrors sets the value of Errors.
-func (s *ErrorResponse) SetErrors(val []string) {
-	s.Errors = val
+// SetPreferenceCenterPageHTML sets the value of PreferenceCenterPageHTML.
+func (s *CreatePreferenceCenterRequest) SetPreferenceCenterPageHTML(val string) {
+	s.PreferenceCenterPageHTML = val
 }
 
-// ErrorResponseStatusCode wraps ErrorResponse with StatusCode.
-type ErrorResponseStatusCode struct {
-	StatusCode int
-	Response   ErrorResponse
+// SetConfirmationPageHTML sets the value of ConfirmationPageHTML.
+func (s *CreatePreferenceCenterRequest) SetConfirmationPageHTML(val string) {
+	s.ConfirmationPageHTML = val
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorResponseStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// SetState sets the value of State.
+func (s *CreatePreferenceCenterRequest) SetState(val OptPreferenceCenterState) {
+	s.State = val
 }
 
-// GetResponse returns the value of Response.
-func (s *ErrorResponseStatusCode) GetResponse() ErrorResponse {
-	return s.Response
+// SetOptions sets the value of Options.
+func (s *CreatePreferenceCenterRequest) SetOptions(val OptPreferenceCenterOptions) {
+	s.Options = val
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorResponseStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// Ref: #/CreatePreferenceCenterResponse
+type CreatePreferenceCenterResponse struct {
+	// The preference center identifier.
+	PreferenceCenterAPIID string `json:"preference_center_api_id"`
+	// The Liquid tag that renders a link to the preference center.
+	LiquidTag OptString   `json:"liquid_tag"`
+	CreatedAt OptDateTime `json:"created_at"`
+	Message   OptString   `json:"message"`
 }
 
-// SetResponse sets the value of Response.
-func (s *ErrorResponseStatusCode) SetResponse(val ErrorResponse) {
-	s.Response = val
+// GetPreferenceCenterAPIID returns the value of PreferenceCenterAPIID.
+func (s *CreatePreferenceCenterResponse) GetPreferenceCenterAPIID() string {
+	return s.PreferenceCenterAPIID
 }
 
-// Ref: #/GetCatalogItemResponse
-type GetCatalogItemResponse struct {
-	Items   []CatalogItem `json:"items"`
-	Message string        `json:"message"`
+// GetLiquidTag returns the value of LiquidTag.
+func (s *CreatePreferenceCenterResponse) GetLiquidTag() OptString {
+	return s.LiquidTag
 }
 
-// GetItems returns the value of Items.
-func (s *GetCatalogItemResponse) GetItems() []CatalogItem {
-	return s.Items
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CreatePreferenceCenterResponse) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
 // GetMessage returns the value of Message.
-func (s *GetCatalogItemResponse) GetMessage() string {
+func (s *CreatePreferenceCenterResponse) GetMessage() OptString {
 	return s.Message
 }
 
-// SetItems sets the value of Items.
-func (s *GetCatalogItemResponse) SetItems(val []CatalogItem) {
-	s.Items = val
+// SetPreferenceCenterAPIID sets the value of PreferenceCenterAPIID.
+func (s *CreatePreferenceCenterResponse) SetPreferenceCenterAPIID(val string) {
+	s.PreferenceCenterAPIID = val
+}
+
+// SetLiquidTag sets the value of LiquidTag.
+func (s *CreatePreferenceCenterResponse) SetLiquidTag(val OptString) {
+	s.LiquidTag = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CreatePreferenceCenterResponse) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
 // SetMessage sets the value of Message.
-func (s *GetCatalogItemResponse) SetMessage(val string) {
+func (s *CreatePreferenceCenterResponse) SetMessage(val OptString) {
 	s.Message = val
 }
 
-// Ref: #/GetContentBlockInfoResponse
-type GetContentBlockInfoResponse struct {
-	// The Content Block identifier.
-	ContentBlockID string `json:"content_block_id"`
-	// The name of the Content Block.
-	Name string `json:"name"`
-	// The content in the Content Block.
-	Content string `json:"content"`
-	// The Content Block description.
-	Description OptNilString `json:"description"`
-	// An array of tags formatted as strings.
-	Tags OptNilStringArray `json:"tags"`
+// Ref: #/CreateScheduledMessageRequest
+type CreateScheduledMessageRequest struct {
+	// Whether to send to every user in the segment or Connected Audience rather than to
+	// external_user_ids.
+	Broadcast       OptBool              `json:"broadcast"`
+	ExternalUserIds []string             `json:"external_user_ids"`
+	SegmentID       OptString            `json:"segment_id"`
+	Audience        OptConnectedAudience `json:"audience"`
+	// An API campaign to attribute message analytics to.
+	CampaignID OptString         `json:"campaign_id"`
+	Schedule   MessageSchedule   `json:"schedule"`
+	Messages   ScheduledMessages `json:"messages"`
 }
 
-// GetContentBlockID returns the value of ContentBlockID.
-func (s *GetContentBlockInfoResponse) GetContentBlockID() string {
-	return s.ContentBlockID
+// GetBroadcast returns the value of Broadcast.
+func (s *CreateScheduledMessageRequest) GetBroadcast() OptBool {
+	return s.Broadcast
 }
 
-// GetName returns the value of Name.
-func (s *GetContentBlockInfoResponse) GetName() string {
-	return s.Name
+// GetExternalUserIds returns the value of ExternalUserIds.
+func (s *CreateScheduledMessageRequest) GetExternalUserIds() []string {
+	return s.ExternalUserIds
 }
 
-// GetContent returns the value of Content.
-func (s *GetContentBlockInfoResponse) GetContent() string {
-	return s.Content
+// GetSegmentID returns the value of SegmentID.
+func (s *CreateScheduledMessageRequest) GetSegmentID() OptString {
+	return s.SegmentID
 }
 
-// GetDescription returns the value of Description.
-func (s *GetContentBlockInfoResponse) GetDescription() OptNilString {
-	return s.Description
+// GetAudience returns the value of Audience.
+func (s *CreateScheduledMessageRequest) GetAudience() OptConnectedAudience {
+	return s.Audience
 }
 
-// GetTags returns the value of Tags.
-func (s *GetContentBlockInfoResponse) GetTags() OptNilStringArray {
-	return s.Tags
+// GetCampaignID returns the value of CampaignID.
+func (s *CreateScheduledMessageRequest) GetCampaignID() OptString {
+	return s.CampaignID
 }
 
-// SetContentBlockID sets the value of ContentBlockID.
-func (s *GetContentBlockInfoResponse) SetContentBlockID(val string) {
-	s.ContentBlockID = val
+// GetSchedule returns the value of Schedule.
+func (s *CreateScheduledMessageRequest) GetSchedule() MessageSchedule {
+	return s.Schedule
 }
 
-// SetName sets the value of Name.
-func (s *GetContentBlockInfoResponse) SetName(val string) {
-	s.Name = val
+// GetMessages returns the value of Messages.
+func (s *CreateScheduledMessageRequest) GetMessages() ScheduledMessages {
+	return s.Messages
 }
 
-// SetContent sets the value of Content.
-func (s *GetContentBlockInfoResponse) SetContent(val string) {
-	s.Content = val
+// SetBroadcast sets the value of Broadcast.
+func (s *CreateScheduledMessageRequest) SetBroadcast(val OptBool) {
+	s.Broadcast = val
 }
 
-// SetDescription sets the value of Description.
-func (s *GetContentBlockInfoResponse) SetDescription(val OptNilString) {
-	s.Description = val
+// SetExternalUserIds sets the value of ExternalUserIds.
+func (s *CreateScheduledMessageRequest) SetExternalUserIds(val []string) {
+	s.ExternalUserIds = val
 }
 
-// SetTags sets the value of Tags.
-func (s *GetContentBlockInfoResponse) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// SetSegmentID sets the value of SegmentID.
+func (s *CreateScheduledMessageRequest) SetSegmentID(val OptString) {
+	s.SegmentID = val
 }
 
-// Ref: #/GetEmailTemplateInfoResponse
-type GetEmailTemplateInfoResponse struct {
-	// The Email Template identifier.
-	EmailTemplateID string `json:"email_template_id"`
-	// The name of your email template.
-	TemplateName string `json:"template_name"`
-	// The email template subject line.
-	Subject OptNilString `json:"subject"`
-	// The email template body that may include HTML.
-	Body OptNilString `json:"body"`
-	// A plaintext version of the email template body.
-	PlaintextBody OptNilString `json:"plaintext_body"`
-	// The email preheader used to generate previews in some clients.
-	Preheader OptNilString `json:"preheader"`
-	// Tags assigned to the email template.
-	Tags OptNilStringArray `json:"tags"`
-	// Whether the inline CSS feature is enabled for the template.
-	ShouldInlineCSS OptNilBool     `json:"should_inline_css"`
-	CreatedAt       OptNilDateTime `json:"created_at"`
-	UpdatedAt       OptNilDateTime `json:"updated_at"`
+// SetAudience sets the value of Audience.
+func (s *CreateScheduledMessageRequest) SetAudience(val OptConnectedAudience) {
+	s.Audience = val
 }
 
-// GetEmailTemplateID returns the value of EmailTemplateID.
-func (s *GetEmailTemplateInfoResponse) GetEmailTemplateID() string {
-	return s.EmailTemplateID
+// SetCampaignID sets the value of CampaignID.
+func (s *CreateScheduledMessageRequest) SetCampaignID(val OptString) {
+	s.CampaignID = val
 }
 
-// GetTemplateName returns the value of TemplateName.
-func (s *GetEmailTemplateInfoResponse) GetTemplateName() string {
-	return s.TemplateName
+// SetSchedule sets the value of Schedule.
+func (s *CreateScheduledMessageRequest) SetSchedule(val MessageSchedule) {
+	s.Schedule = val
 }
 
-// GetSubject returns the value of Subject.
-func (s *GetEmailTemplateInfoResponse) GetSubject() OptNilString {
-	return s.Subject
+// SetMessages sets the value of Messages.
+func (s *CreateScheduledMessageRequest) SetMessages(val ScheduledMessages) {
+	s.Messages = val
 }
 
-// GetBody returns the value of Body.
-func (s *GetEmailTemplateInfoResponse) GetBody() OptNilString {
-	return s.Body
+// Ref: #/CreateScheduledMessageResponse
+type CreateScheduledMessageResponse struct {
+	DispatchID OptString `json:"dispatch_id"`
+	ScheduleID string    `json:"schedule_id"`
+	Message    OptString `json:"message"`
 }
 
-// GetPlaintextBody returns the value of PlaintextBody.
-func (s *GetEmailTemplateInfoResponse) GetPlaintextBody() OptNilString {
-	return s.PlaintextBody
+// GetDispatchID returns the value of DispatchID.
+func (s *CreateScheduledMessageResponse) GetDispatchID() OptString {
+	return s.DispatchID
 }
 
-// GetPreheader returns the value of Preheader.
-func (s *GetEmailTemplateInfoResponse) GetPreheader() OptNilString {
-	return s.Preheader
+// GetScheduleID returns the value of ScheduleID.
+func (s *CreateScheduledMessageResponse) GetScheduleID() string {
+	return s.ScheduleID
 }
 
-// GetTags returns the value of Tags.
-func (s *GetEmailTemplateInfoResponse) GetTags() OptNilStringArray {
-	return s.Tags
+// GetMessage returns the value of Message.
+func (s *CreateScheduledMessageResponse) GetMessage() OptString {
+	return s.Message
 }
 
-// GetShouldInlineCSS returns the value of ShouldInlineCSS.
-func (s *GetEmailTemplateInfoResponse) GetShouldInlineCSS() OptNilBool {
-	return s.ShouldInlineCSS
+// SetDispatchID sets the value of DispatchID.
+func (s *CreateScheduledMessageResponse) SetDispatchID(val OptString) {
+	s.DispatchID = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *GetEmailTemplateInfoResponse) GetCreatedAt() OptNilDateTime {
-	return s.CreatedAt
+// SetScheduleID sets the value of ScheduleID.
+func (s *CreateScheduledMessageResponse) SetScheduleID(val string) {
+	s.ScheduleID = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *GetEmailTemplateInfoResponse) GetUpdatedAt() OptNilDateTime {
-	return s.UpdatedAt
+// SetMessage sets the value of Message.
+func (s *CreateScheduledMessageResponse) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// SetEmailTemplateID sets the value of EmailTemplateID.
-func (s *GetEmailTemplateInfoResponse) SetEmailTemplateID(val string) {
-	s.EmailTemplateID = val
+// Ref: #/CreateSendIDRequest
+type CreateSendIDRequest struct {
+	CampaignID string `json:"campaign_id"`
+	SendID     string `json:"send_id"`
 }
 
-// SetTemplateName sets the value of TemplateName.
-func (s *GetEmailTemplateInfoResponse) SetTemplateName(val string) {
-	s.TemplateName = val
+// GetCampaignID returns the value of CampaignID.
+func (s *CreateSendIDRequest) GetCampaignID() string {
+	return s.CampaignID
 }
 
-// SetSubject sets the value of Subject.
-func (s *GetEmailTemplateInfoResponse) SetSubject(val OptNilString) {
-	s.Subject = val
+// GetSendID returns the value of SendID.
+func (s *CreateSendIDRequest) GetSendID() string {
+	return s.SendID
 }
 
-// SetBody sets the value of Body.
-func (s *GetEmailTemplateInfoResponse) SetBody(val OptNilString) {
-	s.Body = val
+// SetCampaignID sets the value of CampaignID.
+func (s *CreateSendIDRequest) SetCampaignID(val string) {
+	s.CampaignID = val
 }
 
-// SetPlaintextBody sets the value of PlaintextBody.
-func (s *GetEmailTemplateInfoResponse) SetPlaintextBody(val OptNilString) {
-	s.PlaintextBody = val
+// SetSendID sets the value of SendID.
+func (s *CreateSendIDRequest) SetSendID(val string) {
+	s.SendID = val
 }
 
-// SetPreheader sets the value of Preheader.
-func (s *GetEmailTemplateInfoResponse) SetPreheader(val OptNilString) {
-	s.Preheader = val
+// Ref: #/CreateSendIDResponse
+type CreateSendIDResponse struct {
+	SendID  OptString `json:"send_id"`
+	Message OptString `json:"message"`
 }
 
-// SetTags sets the value of Tags.
-func (s *GetEmailTemplateInfoResponse) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// GetSendID returns the value of SendID.
+func (s *CreateSendIDResponse) GetSendID() OptString {
+	return s.SendID
 }
 
-// SetShouldInlineCSS sets the value of ShouldInlineCSS.
-func (s *GetEmailTemplateInfoResponse) SetShouldInlineCSS(val OptNilBool) {
-	s.ShouldInlineCSS = val
+// GetMessage returns the value of Message.
+func (s *CreateSendIDResponse) GetMessage() OptString {
+	return s.Message
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *GetEmailTemplateInfoResponse) SetCreatedAt(val OptNilDateTime) {
-	s.CreatedAt = val
+// SetSendID sets the value of SendID.
+func (s *CreateSendIDResponse) SetSendID(val OptString) {
+	s.SendID = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *GetEmailTemplateInfoResponse) SetUpdatedAt(val OptNilDateTime) {
-	s.UpdatedAt = val
+// SetMessage sets the value of Message.
+func (s *CreateSendIDResponse) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// Ref: #/ListCatalogItemsResponse
-type ListCatalogItemsResponse struct {
-	Items   []CatalogItem `json:"items"`
-	Message string        `json:"message"`
+// Ref: #/CustomAttribute
+type CustomAttribute struct {
+	// The name of the custom attribute.
+	Name string `json:"name"`
+	// The description of the custom attribute.
+	Description OptNilString `json:"description"`
+	// The data type of the custom attribute, for example String, Number or Array.
+	DataType OptString `json:"data_type"`
+	// The maximum length of an array custom attribute.
+	ArrayLength OptNilInt `json:"array_length"`
+	// Whether the custom attribute is Active or Blocklisted.
+	Status OptString `json:"status"`
+	// The tags of the custom attribute.
+	TagNames []string `json:"tag_names"`
 }
 
-// GetItems returns the value of Items.
-func (s *ListCatalogItemsResponse) GetItems() []CatalogItem {
-	return s.Items
+// GetName returns the value of Name.
+func (s *CustomAttribute) GetName() string {
+	return s.Name
 }
 
-// GetMessage returns the value of Message.
-func (s *ListCatalogItemsResponse) GetMessage() string {
-	return s.Message
+// GetDescription returns the value of Description.
+func (s *CustomAttribute) GetDescription() OptNilString {
+	return s.Description
 }
 
-// SetItems sets the value of Items.
-func (s *ListCatalogItemsResponse) SetItems(val []CatalogItem) {
-	s.Items = val
+// GetDataType returns the value of DataType.
+func (s *CustomAttribute) GetDataType() OptString {
+	return s.DataType
 }
 
-// SetMessage sets the value of Message.
-func (s *ListCatalogItemsResponse) SetMessage(val string) {
-	s.Message = val
+// GetArrayLength returns the value of ArrayLength.
+func (s *CustomAttribute) GetArrayLength() OptNilInt {
+	return s.ArrayLength
 }
 
-// ListCatalogItemsResponseHeaders wraps ListCatalogItemsResponse with response headers.
-type ListCatalogItemsResponseHeaders struct {
-	Link     OptString
-	Response ListCatalogItemsResponse
+// GetStatus returns the value of Status.
+func (s *CustomAttribute) GetStatus() OptString {
+	return s.Status
 }
 
-// GetLink returns the value of Link.
-func (s *ListCatalogItemsResponseHeaders) GetLink() OptString {
-	return s.Link
+// GetTagNames returns the value of TagNames.
+func (s *CustomAttribute) GetTagNames() []string {
+	return s.TagNames
 }
 
-// GetResponse returns the value of Response.
-func (s *ListCatalogItemsResponseHeaders) GetResponse() ListCatalogItemsResponse {
-	return s.Response
+// SetName sets the value of Name.
+func (s *CustomAttribute) SetName(val string) {
+	s.Name = val
 }
 
-// SetLink sets the value of Link.
-func (s *ListCatalogItemsResponseHeaders) SetLink(val OptString) {
-	s.Link = val
+// SetDescription sets the value of Description.
+func (s *CustomAttribute) SetDescription(val OptNilString) {
+	s.Description = val
 }
 
-// SetResponse sets the value of Response.
-func (s *ListCatalogItemsResponseHeaders) SetResponse(val ListCatalogItemsResponse) {
-	s.Response = val
+// SetDataType sets the value of DataType.
+func (s *CustomAttribute) SetDataType(val OptString) {
+	s.DataType = val
 }
 
-// Ref: #/ListCatalogsResponse
-type ListCatalogsResponse struct {
-	Catalogs []Catalog `json:"catalogs"`
-	Message  string    `json:"message"`
+// SetArrayLength sets the value of ArrayLength.
+func (s *CustomAttribute) SetArrayLength(val OptNilInt) {
+	s.ArrayLength = val
 }
 
-// GetCatalogs returns the value of Catalogs.
-func (s *ListCatalogsResponse) GetCatalogs() []Catalog {
-	return s.Catalogs
+// SetStatus sets the value of Status.
+func (s *CustomAttribute) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// GetMessage returns the value of Message.
-func (s *ListCatalogsResponse) GetMessage() string {
-	return s.Message
+// SetTagNames sets the value of TagNames.
+func (s *CustomAttribute) SetTagNames(val []string) {
+	s.TagNames = val
 }
 
-// SetCatalogs sets the value of Catalogs.
-func (s *ListCatalogsResponse) SetCatalogs(val []Catalog) {
-	s.Catalogs = val
+// Ref: #/CustomEvent
+type CustomEvent struct {
+	// The name of the custom event.
+	Name string `json:"name"`
+	// The description of the custom event.
+	Description OptNilString `json:"description"`
+	// Whether the custom event is included in the analytics report.
+	IncludedInAnalyticsReport OptBool `json:"included_in_analytics_report"`
+	// Whether the custom event is Active or Blocklisted.
+	Status OptString `json:"status"`
+	// The tags of the custom event.
+	TagNames []string `json:"tag_names"`
 }
 
-// SetMessage sets the value of Message.
-func (s *ListCatalogsResponse) SetMessage(val string) {
-	s.Message = val
+// GetName returns the value of Name.
+func (s *CustomEvent) GetName() string {
+	return s.Name
 }
 
-// Ref: #/ListContentBlocksResponse
-type ListContentBlocksResponse struct {
-	// The number of Content Blocks returned.
-	Count         int                                     `json:"count"`
-	ContentBlocks []ListContentBlocksResponseContentBlock `json:"content_blocks"`
+// GetDescription returns the value of Description.
+func (s *CustomEvent) GetDescription() OptNilString {
+	return s.Description
 }
 
-// GetCount returns the value of Count.
-func (s *ListContentBlocksResponse) GetCount() int {
-	return s.Count
+// GetIncludedInAnalyticsReport returns the value of IncludedInAnalyticsReport.
+func (s *CustomEvent) GetIncludedInAnalyticsReport() OptBool {
+	return s.IncludedInAnalyticsReport
 }
 
-// GetContentBlocks returns the value of ContentBlocks.
-func (s *ListContentBlocksResponse) GetContentBlocks() []ListContentBlocksResponseContentBlock {
-	return s.ContentBlocks
+// GetStatus returns the value of Status.
+func (s *CustomEvent) GetStatus() OptString {
+	return s.Status
 }
 
-// SetCount sets the value of Count.
-func (s *ListContentBlocksResponse) SetCount(val int) {
-	s.Count = val
+// GetTagNames returns the value of TagNames.
+func (s *CustomEvent) GetTagNames() []string {
+	return s.TagNames
 }
 
-// SetContentBlocks sets the value of ContentBlocks.
-func (s *ListContentBlocksResponse) SetContentBlocks(val []ListContentBlocksResponseContentBlock) {
-	s.ContentBlocks = val
+// SetName sets the value of Name.
+func (s *CustomEvent) SetName(val string) {
+	s.Name = val
 }
 
-// Ref: #/ListContentBlocksResponseContentBlock
-type ListContentBlocksResponseContentBlock struct {
-	// The Content Block identifier.
-	ContentBlockID string `json:"content_block_id"`
-	// The name of the Content Block.
-	Name string `json:"name"`
-	// Tags formatted as strings.
-	Tags OptNilStringArray `json:"tags"`
+// SetDescription sets the value of Description.
+func (s *CustomEvent) SetDescription(val OptNilString) {
+	s.Description = val
 }
 
-// GetContentBlockID returns the value of ContentBlockID.
-func (s *ListContentBlocksResponseContentBlock) GetContentBlockID() string {
-	return s.ContentBlockID
+// SetIncludedInAnalyticsReport sets the value of IncludedInAnalyticsReport.
+func (s *CustomEvent) SetIncludedInAnalyticsReport(val OptBool) {
+	s.IncludedInAnalyticsReport = val
 }
 
-// GetName returns the value of Name.
-func (s *ListContentBlocksResponseContentBlock) GetName() string {
-	return s.Name
+// SetStatus sets the value of Status.
+func (s *CustomEvent) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// GetTags returns the value of Tags.
-func (s *ListContentBlocksResponseContentBlock) GetTags() OptNilStringArray {
-	return s.Tags
+// SetTagNames sets the value of TagNames.
+func (s *CustomEvent) SetTagNames(val []string) {
+	s.TagNames = val
 }
 
-// SetContentBlockID sets the value of ContentBlockID.
-func (s *ListContentBlocksResponseContentBlock) SetContentBlockID(val string) {
-	s.ContentBlockID = val
+// Ref: #/DashboardUser
+type DashboardUser struct {
+	Schemas []string `json:"schemas"`
+	// The SCIM identifier of the dashboard user.
+	ID string `json:"id"`
+	// The email address of the dashboard user.
+	UserName string               `json:"userName"`
+	Name     OptDashboardUserName `json:"name"`
+	// The department of the dashboard user.
+	Department OptString `json:"department"`
+	// When the dashboard user last signed in, as formatted by Braze.
+	LastSignInAt OptString                   `json:"lastSignInAt"`
+	Permissions  OptDashboardUserPermissions `json:"permissions"`
 }
 
-// SetName sets the value of Name.
-func (s *ListContentBlocksResponseContentBlock) SetName(val string) {
-	s.Name = val
+// GetSchemas returns the value of Schemas.
+func (s *DashboardUser) GetSchemas() []string {
+	return s.Schemas
 }
 
-// SetTags sets the value of Tags.
-func (s *ListContentBlocksResponseContentBlock) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// GetID returns the value of ID.
+func (s *DashboardUser) GetID() string {
+	return s.ID
 }
 
-// Ref: #/ListEmailTemplatesResponse
-type ListEmailTemplatesResponse struct {
-	Count     int                                       `json:"count"`
-	Templates []ListEmailTemplatesResponseTemplatesItem `json:"templates"`
+// GetUserName returns the value of UserName.
+func (s *DashboardUser) GetUserName() string {
+	return s.UserName
 }
 
-// GetCount returns the value of Count.
-func (s *ListEmailTemplatesResponse) GetCount() int {
-	return s.Count
+// GetName returns the value of Name.
+func (s *DashboardUser) GetName() OptDashboardUserName {
+	return s.Name
 }
 
-// GetTemplates returns the value of Templates.
-func (s *ListEmailTemplatesResponse) GetTemplates() []ListEmailTemplatesResponseTemplatesItem {
-	return s.Templates
+// GetDepartment returns the value of Department.
+func (s *DashboardUser) GetDepartment() OptString {
+	return s.Department
 }
 
-// SetCount sets the value of Count.
-func (s *ListEmailTemplatesResponse) SetCount(val int) {
-	s.Count = val
+// GetLastSignInAt returns the value of LastSignInAt.
+func (s *DashboardUser) GetLastSignInAt() OptString {
+	return s.LastSignInAt
+}
+
+// GetPermissions returns the value of Permissions.
+func (s *DashboardUser) GetPermissions() OptDashboardUserPermissions {
+	return s.Permissions
+}
+
+// SetSchemas sets the value of Schemas.
+func (s *DashboardUser) SetSchemas(val []string) {
+	s.Schemas = val
+}
+
+// SetID sets the value of ID.
+func (s *DashboardUser) SetID(val string) {
+	s.ID = val
+}
+
+// SetUserName sets the value of UserName.
+func (s *DashboardUser) SetUserName(val string) {
+	s.UserName = val
+}
+
+// SetName sets the value of Name.
+func (s *DashboardUser) SetName(val OptDashboardUserName) {
+	s.Name = val
+}
+
+// SetDepartment sets the value of Department.
+func (s *DashboardUser) SetDepartment(val OptString) {
+	s.Department = val
+}
+
+// SetLastSignInAt sets the value of LastSignInAt.
+func (s *DashboardUser) SetLastSignInAt(val OptString) {
+	s.LastSignInAt = val
+}
+
+// SetPermissions sets the value of Permissions.
+func (s *DashboardUser) SetPermissions(val OptDashboardUserPermissions) {
+	s.Permissions = val
+}
+
+// Ref: #/DashboardUserAppGroupPermissions
+type DashboardUserAppGroupPermissions struct {
+	// The identifier of the workspace.
+	AppGroupId OptString `json:"appGroupId"`
+	// The name of the workspace.
+	AppGroupName string `json:"appGroupName"`
+	// Permissions in the workspace, for example basic_access.
+	AppGroupPermissions []string `json:"appGroupPermissions"`
+	// Permissions in teams of the workspace.
+	Team []DashboardUserTeamPermissions `json:"team"`
+}
+
+// GetAppGroupId returns the value of AppGroupId.
+func (s *DashboardUserAppGroupPermissions) GetAppGroupId() OptString {
+	return s.AppGroupId
+}
+
+// GetAppGroupName returns the value of AppGroupName.
+func (s *DashboardUserAppGroupPermissions) GetAppGroupName() string {
+	return s.AppGroupName
+}
+
+// GetAppGroupPermissions returns the value of AppGroupPermissions.
+func (s *DashboardUserAppGroupPermissions) GetAppGroupPermissions() []string {
+	return s.AppGroupPermissions
+}
+
+// GetTeam returns the value of Team.
+func (s *DashboardUserAppGroupPermissions) GetTeam() []DashboardUserTeamPermissions {
+	return s.Team
+}
+
+// SetAppGroupId sets the value of AppGroupId.
+func (s *DashboardUserAppGroupPermissions) SetAppGroupId(val OptString) {
+	s.AppGroupId = val
+}
+
+// SetAppGroupName sets the value of AppGroupName.
+func (s *DashboardUserAppGroupPermissions) SetAppGroupName(val string) {
+	s.AppGroupName = val
+}
+
+// SetAppGroupPermissions sets the value of AppGroupPermissions.
+func (s *DashboardUserAppGroupPermissions) SetAppGroupPermissions(val []string) {
+	s.AppGroupPermissions = val
+}
+
+// SetTeam sets the value of Team.
+func (s *DashboardUserAppGroupPermissions) SetTeam(val []DashboardUserTeamPermissions) {
+	s.Team = val
+}
+
+// Ref: #/DashboardUserName
+type DashboardUserName struct {
+	// The first name of the dashboard user.
+	GivenName string `json:"givenName"`
+	// The last name of the dashboard user.
+	FamilyName string `json:"familyName"`
+}
+
+// GetGivenName returns the value of GivenName.
+func (s *DashboardUserName) GetGivenName() string {
+	return s.GivenName
+}
+
+// GetFamilyName returns the value of FamilyName.
+func (s *DashboardUserName) GetFamilyName() string {
+	return s.FamilyName
+}
+
+// SetGivenName sets the value of GivenName.
+func (s *DashboardUserName) SetGivenName(val string) {
+	s.GivenName = val
+}
+
+// SetFamilyName sets the value of FamilyName.
+func (s *DashboardUserName) SetFamilyName(val string) {
+	s.FamilyName = val
+}
+
+// Ref: #/DashboardUserPermissions
+type DashboardUserPermissions struct {
+	// Company-wide permissions, for example manage_company_settings.
+	CompanyPermissions []string `json:"companyPermissions"`
+	// Permissions in each workspace.
+	AppGroup []DashboardUserAppGroupPermissions `json:"appGroup"`
+}
+
+// GetCompanyPermissions returns the value of CompanyPermissions.
+func (s *DashboardUserPermissions) GetCompanyPermissions() []string {
+	return s.CompanyPermissions
+}
+
+// GetAppGroup returns the value of AppGroup.
+func (s *DashboardUserPermissions) GetAppGroup() []DashboardUserAppGroupPermissions {
+	return s.AppGroup
+}
+
+// SetCompanyPermissions sets the value of CompanyPermissions.
+func (s *DashboardUserPermissions) SetCompanyPermissions(val []string) {
+	s.CompanyPermissions = val
+}
+
+// SetAppGroup sets the value of AppGroup.
+func (s *DashboardUserPermissions) SetAppGroup(val []DashboardUserAppGroupPermissions) {
+	s.AppGroup = val
+}
+
+// Ref: #/DashboardUserTeamPermissions
+type DashboardUserTeamPermissions struct {
+	// The identifier of the team.
+	TeamId OptString `json:"teamId"`
+	// The name of the team.
+	TeamName string `json:"teamName"`
+	// Permissions in the team, for example admin.
+	TeamPermissions []string `json:"teamPermissions"`
+}
+
+// GetTeamId returns the value of TeamId.
+func (s *DashboardUserTeamPermissions) GetTeamId() OptString {
+	return s.TeamId
+}
+
+// GetTeamName returns the value of TeamName.
+func (s *DashboardUserTeamPermissions) GetTeamName() string {
+	return s.TeamName
+}
+
+// GetTeamPermissions returns the value of TeamPermissions.
+func (s *DashboardUserTeamPermissions) GetTeamPermissions() []string {
+	return s.TeamPermissions
+}
+
+// SetTeamId sets the value of TeamId.
+func (s *DashboardUserTeamPermissions) SetTeamId(val OptString) {
+	s.TeamId = val
+}
+
+// SetTeamName sets the value of TeamName.
+func (s *DashboardUserTeamPermissions) SetTeamName(val string) {
+	s.TeamName = val
+}
+
+// SetTeamPermissions sets the value of TeamPermissions.
+func (s *DashboardUserTeamPermissions) SetTeamPermissions(val []string) {
+	s.TeamPermissions = val
+}
+
+// Ref: #/DeleteCampaignTriggerScheduleRequest
+type DeleteCampaignTriggerScheduleRequest struct {
+	CampaignID string `json:"campaign_id"`
+	ScheduleID string `json:"schedule_id"`
+}
+
+// GetCampaignID returns the value of CampaignID.
+func (s *DeleteCampaignTriggerScheduleRequest) GetCampaignID() string {
+	return s.CampaignID
+}
+
+// GetScheduleID returns the value of ScheduleID.
+func (s *DeleteCampaignTriggerScheduleRequest) GetScheduleID() string {
+	return s.ScheduleID
+}
+
+// SetCampaignID sets the value of CampaignID.
+func (s *DeleteCampaignTriggerScheduleRequest) SetCampaignID(val string) {
+	s.CampaignID = val
+}
+
+// SetScheduleID sets the value of ScheduleID.
+func (s *DeleteCampaignTriggerScheduleRequest) SetScheduleID(val string) {
+	s.ScheduleID = val
+}
+
+// Ref: #/DeleteCampaignTriggerScheduleResponse
+type DeleteCampaignTriggerScheduleResponse struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DeleteCampaignTriggerScheduleResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DeleteCampaignTriggerScheduleResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/DeleteCanvasTriggerScheduleRequest
+type DeleteCanvasTriggerScheduleRequest struct {
+	CanvasID   string `json:"canvas_id"`
+	ScheduleID string `json:"schedule_id"`
+}
+
+// GetCanvasID returns the value of CanvasID.
+func (s *DeleteCanvasTriggerScheduleRequest) GetCanvasID() string {
+	return s.CanvasID
+}
+
+// GetScheduleID returns the value of ScheduleID.
+func (s *DeleteCanvasTriggerScheduleRequest) GetScheduleID() string {
+	return s.ScheduleID
+}
+
+// SetCanvasID sets the value of CanvasID.
+func (s *DeleteCanvasTriggerScheduleRequest) SetCanvasID(val string) {
+	s.CanvasID = val
+}
+
+// SetScheduleID sets the value of ScheduleID.
+func (s *DeleteCanvasTriggerScheduleRequest) SetScheduleID(val string) {
+	s.ScheduleID = val
+}
+
+// Ref: #/DeleteCanvasTriggerScheduleResponse
+type DeleteCanvasTriggerScheduleResponse struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DeleteCanvasTriggerScheduleResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DeleteCanvasTriggerScheduleResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/DeleteCatalogItemResponse
+type DeleteCatalogItemResponse struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DeleteCatalogItemResponse) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DeleteCatalogItemResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// Ref: #/DeleteCatalogResponse
+type DeleteCatalogResponse struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DeleteCatalogResponse) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DeleteCatalogResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// DeleteDashboardUserNoContent is response for DeleteDashboardUser operation.
+type DeleteDashboardUserNoContent struct{}
+
+// Ref: #/DeleteScheduledMessageRequest
+type DeleteScheduledMessageRequest struct {
+	ScheduleID string `json:"schedule_id"`
+}
+
+// GetScheduleID returns the value of ScheduleID.
+func (s *DeleteScheduledMessageRequest) GetScheduleID() string {
+	return s.ScheduleID
+}
+
+// SetScheduleID sets the value of ScheduleID.
+func (s *DeleteScheduledMessageRequest) SetScheduleID(val string) {
+	s.ScheduleID = val
+}
+
+// Ref: #/DeleteScheduledMessageResponse
+type DeleteScheduledMessageResponse struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DeleteScheduledMessageResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DeleteScheduledMessageResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/ErrorDetail
+type ErrorDetail struct {
+	// Identifier of the error, for example catalog-name-already-exists.
+	ID string `json:"id"`
+	// Description of the error.
+	Message string `json:"message"`
+	// Names of the request parameters the error relates to.
+	Parameters []string `json:"parameters"`
+	// Values of the request parameters the error relates to.
+	ParameterValues []jx.Raw `json:"parameter_values"`
+}
+
+// GetID returns the value of ID.
+func (s *ErrorDetail) GetID() string {
+	return s.ID
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorDetail) GetMessage() string {
+	return s.Message
+}
+
+// GetParameters returns the value of Parameters.
+func (s *ErrorDetail) GetParameters() []string {
+	return s.Parameters
+}
+
+// GetParameterValues returns the value of ParameterValues.
+func (s *ErrorDetail) GetParameterValues() []jx.Raw {
+	return s.ParameterValues
+}
+
+// SetID sets the value of ID.
+func (s *ErrorDetail) SetID(val string) {
+	s.ID = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorDetail) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetParameters sets the value of Parameters.
+func (s *ErrorDetail) SetParameters(val []string) {
+	s.Parameters = val
+}
+
+// SetParameterValues sets the value of ParameterValues.
+func (s *ErrorDetail) SetParameterValues(val []jx.Raw) {
+	s.ParameterValues = val
+}
+
+type ErrorResponse struct {
+	// Error message describing what went wrong.
+	Message string `json:"message"`
+	// Array of minor error messages, or of error details for catalog endpoints.
+	Errors []ErrorResponseErrorsItem `json:"errors"`
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorResponse) GetMessage() string {
+	return s.Message
+}
+
+// GetErrors returns the value of Errors.
+func (s *ErrorResponse) GetErrors() []ErrorResponseErrorsItem {
+	return s.Errors
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *ErrorResponse) SetErrors(val []ErrorResponseErrorsItem) {
+	s.Errors = val
+}
+
+// ErrorResponseErrorsItem represents sum type.
+type ErrorResponseErrorsItem struct {
+	Type        ErrorResponseErrorsItemType // switch on this field
+	String      string
+	ErrorDetail ErrorDetail
+}
+
+// ErrorResponseErrorsItemType is oneOf type of ErrorResponseErrorsItem.
+type ErrorResponseErrorsItemType string
+
+// Possible values for ErrorResponseErrorsItemType.
+const (
+	StringErrorResponseErrorsItem      ErrorResponseErrorsItemType = "string"
+	ErrorDetailErrorResponseErrorsItem ErrorResponseErrorsItemType = "ErrorDetail"
+)
+
+// IsString reports whether ErrorResponseErrorsItem is string.
+func (s ErrorResponseErrorsItem) IsString() bool { return s.Type == StringErrorResponseErrorsItem }
+
+// IsErrorDetail reports whether ErrorResponseErrorsItem is ErrorDetail.
+func (s ErrorResponseErrorsItem) IsErrorDetail() bool {
+	return s.Type == ErrorDetailErrorResponseErrorsItem
+}
+
+// SetString sets ErrorResponseErrorsItem to string.
+func (s *ErrorResponseErrorsItem) SetString(v string) {
+	s.Type = StringErrorResponseErrorsItem
+	s.String = v
+}
+
+// GetString returns string and true boolean if ErrorResponseErrorsItem is string.
+func (s ErrorResponseErrorsItem) GetString() (v string, ok bool) {
+	if !s.IsString() {
+		return v, false
+	}
+	return s.String, true
+}
+
+// NewStringErrorResponseErrorsItem returns new ErrorResponseErrorsItem from string.
+func NewStringErrorResponseErrorsItem(v string) ErrorResponseErrorsItem {
+	var s ErrorResponseErrorsItem
+	s.SetString(v)
+	return s
+}
+
+// SetErrorDetail sets ErrorResponseErrorsItem to ErrorDetail.
+func (s *ErrorResponseErrorsItem) SetErrorDetail(v ErrorDetail) {
+	s.Type = ErrorDetailErrorResponseErrorsItem
+	s.ErrorDetail = v
+}
+
+// GetErrorDetail returns ErrorDetail and true boolean if ErrorResponseErrorsItem is ErrorDetail.
+func (s ErrorResponseErrorsItem) GetErrorDetail() (v ErrorDetail, ok bool) {
+	if !s.IsErrorDetail() {
+		return v, false
+	}
+	return s.ErrorDetail, true
+}
+
+// NewErrorDetailErrorResponseErrorsItem returns new ErrorResponseErrorsItem from ErrorDetail.
+func NewErrorDetailErrorResponseErrorsItem(v ErrorDetail) ErrorResponseErrorsItem {
+	var s ErrorResponseErrorsItem
+	s.SetErrorDetail(v)
+	return s
+}
+
+// ErrorResponseStatusCode wraps ErrorResponse with StatusCode.
+type ErrorResponseStatusCode struct {
+	StatusCode int
+	Response   ErrorResponse
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorResponseStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorResponseStatusCode) GetResponse() ErrorResponse {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorResponseStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorResponseStatusCode) SetResponse(val ErrorResponse) {
+	s.Response = val
+}
+
+// Ref: #/GetCampaignDetailsResponse
+type GetCampaignDetailsResponse struct {
+	// The name of the campaign.
+	Name string `json:"name"`
+	// The description of the campaign.
+	Description OptNilString `json:"description"`
+	// Whether the campaign is archived.
+	Archived OptBool `json:"archived"`
+	// Whether the campaign is a draft.
+	Draft OptBool `json:"draft"`
+	// Whether the campaign is active.
+	Enabled OptBool `json:"enabled"`
+	// How the campaign is scheduled, for example time_based, action_based or api_triggered.
+	ScheduleType OptString `json:"schedule_type"`
+	// The tags of the campaign.
+	Tags      []string    `json:"tags"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+	Message   OptString   `json:"message"`
+}
+
+// GetName returns the value of Name.
+func (s *GetCampaignDetailsResponse) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *GetCampaignDetailsResponse) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetArchived returns the value of Archived.
+func (s *GetCampaignDetailsResponse) GetArchived() OptBool {
+	return s.Archived
+}
+
+// GetDraft returns the value of Draft.
+func (s *GetCampaignDetailsResponse) GetDraft() OptBool {
+	return s.Draft
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *GetCampaignDetailsResponse) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetScheduleType returns the value of ScheduleType.
+func (s *GetCampaignDetailsResponse) GetScheduleType() OptString {
+	return s.ScheduleType
+}
+
+// GetTags returns the value of Tags.
+func (s *GetCampaignDetailsResponse) GetTags() []string {
+	return s.Tags
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *GetCampaignDetailsResponse) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GetCampaignDetailsResponse) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetMessage returns the value of Message.
+func (s *GetCampaignDetailsResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetName sets the value of Name.
+func (s *GetCampaignDetailsResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *GetCampaignDetailsResponse) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetArchived sets the value of Archived.
+func (s *GetCampaignDetailsResponse) SetArchived(val OptBool) {
+	s.Archived = val
+}
+
+// SetDraft sets the value of Draft.
+func (s *GetCampaignDetailsResponse) SetDraft(val OptBool) {
+	s.Draft = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *GetCampaignDetailsResponse) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetScheduleType sets the value of ScheduleType.
+func (s *GetCampaignDetailsResponse) SetScheduleType(val OptString) {
+	s.ScheduleType = val
+}
+
+// SetTags sets the value of Tags.
+func (s *GetCampaignDetailsResponse) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *GetCampaignDetailsResponse) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GetCampaignDetailsResponse) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GetCampaignDetailsResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/GetCanvasDetailsResponse
+type GetCanvasDetailsResponse struct {
+	// The name of the Canvas.
+	Name string `json:"name"`
+	// The description of the Canvas.
+	Description OptNilString `json:"description"`
+	// Whether the Canvas is archived.
+	Archived OptBool `json:"archived"`
+	// Whether the Canvas is a draft.
+	Draft OptBool `json:"draft"`
+	// Whether the Canvas is active.
+	Enabled OptBool `json:"enabled"`
+	// How the Canvas is scheduled, for example time_based, action_based or api_triggered.
+	ScheduleType OptString `json:"schedule_type"`
+	// The tags of the Canvas.
+	Tags      []string    `json:"tags"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+	Message   OptString   `json:"message"`
+}
+
+// GetName returns the value of Name.
+func (s *GetCanvasDetailsResponse) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *GetCanvasDetailsResponse) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetArchived returns the value of Archived.
+func (s *GetCanvasDetailsResponse) GetArchived() OptBool {
+	return s.Archived
+}
+
+// GetDraft returns the value of Draft.
+func (s *GetCanvasDetailsResponse) GetDraft() OptBool {
+	return s.Draft
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *GetCanvasDetailsResponse) GetEnabled() OptBool {
+	return s.Enabled
+}
+
+// GetScheduleType returns the value of ScheduleType.
+func (s *GetCanvasDetailsResponse) GetScheduleType() OptString {
+	return s.ScheduleType
+}
+
+// GetTags returns the value of Tags.
+func (s *GetCanvasDetailsResponse) GetTags() []string {
+	return s.Tags
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *GetCanvasDetailsResponse) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GetCanvasDetailsResponse) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetMessage returns the value of Message.
+func (s *GetCanvasDetailsResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetName sets the value of Name.
+func (s *GetCanvasDetailsResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *GetCanvasDetailsResponse) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetArchived sets the value of Archived.
+func (s *GetCanvasDetailsResponse) SetArchived(val OptBool) {
+	s.Archived = val
+}
+
+// SetDraft sets the value of Draft.
+func (s *GetCanvasDetailsResponse) SetDraft(val OptBool) {
+	s.Draft = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *GetCanvasDetailsResponse) SetEnabled(val OptBool) {
+	s.Enabled = val
+}
+
+// SetScheduleType sets the value of ScheduleType.
+func (s *GetCanvasDetailsResponse) SetScheduleType(val OptString) {
+	s.ScheduleType = val
+}
+
+// SetTags sets the value of Tags.
+func (s *GetCanvasDetailsResponse) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *GetCanvasDetailsResponse) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GetCanvasDetailsResponse) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GetCanvasDetailsResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/GetCatalogItemResponse
+type GetCatalogItemResponse struct {
+	Items   []CatalogItem `json:"items"`
+	Message string        `json:"message"`
+}
+
+// GetItems returns the value of Items.
+func (s *GetCatalogItemResponse) GetItems() []CatalogItem {
+	return s.Items
+}
+
+// GetMessage returns the value of Message.
+func (s *GetCatalogItemResponse) GetMessage() string {
+	return s.Message
+}
+
+// SetItems sets the value of Items.
+func (s *GetCatalogItemResponse) SetItems(val []CatalogItem) {
+	s.Items = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GetCatalogItemResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// Ref: #/GetContentBlockInfoResponse
+type GetContentBlockInfoResponse struct {
+	// The Content Block identifier.
+	ContentBlockID string `json:"content_block_id"`
+	// The name of the Content Block.
+	Name string `json:"name"`
+	// The content in the Content Block.
+	Content string `json:"content"`
+	// The Content Block description.
+	Description OptNilString `json:"description"`
+	// An array of tags formatted as strings.
+	Tags OptNilStringArray `json:"tags"`
+}
+
+// GetContentBlockID returns the value of ContentBlockID.
+func (s *GetContentBlockInfoResponse) GetContentBlockID() string {
+	return s.ContentBlockID
+}
+
+// GetName returns the value of Name.
+func (s *GetContentBlockInfoResponse) GetName() string {
+	return s.Name
+}
+
+// GetContent returns the value of Content.
+func (s *GetContentBlockInfoResponse) GetContent() string {
+	return s.Content
+}
+
+// GetDescription returns the value of Description.
+func (s *GetContentBlockInfoResponse) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetTags returns the value of Tags.
+func (s *GetContentBlockInfoResponse) GetTags() OptNilStringArray {
+	return s.Tags
+}
+
+// SetContentBlockID sets the value of ContentBlockID.
+func (s *GetContentBlockInfoResponse) SetContentBlockID(val string) {
+	s.ContentBlockID = val
+}
+
+// SetName sets the value of Name.
+func (s *GetContentBlockInfoResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetContent sets the value of Content.
+func (s *GetContentBlockInfoResponse) SetContent(val string) {
+	s.Content = val
+}
+
+// SetDescription sets the value of Description.
+func (s *GetContentBlockInfoResponse) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetTags sets the value of Tags.
+func (s *GetContentBlockInfoResponse) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
+// Ref: #/GetEmailTemplateInfoResponse
+type GetEmailTemplateInfoResponse struct {
+	// The Email Template identifier.
+	EmailTemplateID string `json:"email_template_id"`
+	// The name of your email template.
+	TemplateName string `json:"template_name"`
+	// The email template subject line.
+	Subject OptNilString `json:"subject"`
+	// The email template body that may include HTML.
+	Body OptNilString `json:"body"`
+	// A plaintext version of the email template body.
+	PlaintextBody OptNilString `json:"plaintext_body"`
+	// The email preheader used to generate previews in some clients.
+	Preheader OptNilString `json:"preheader"`
+	// Tags assigned to the email template.
+	Tags OptNilStringArray `json:"tags"`
+	// Whether the inline CSS feature is enabled for the template.
+	ShouldInlineCSS OptNilBool     `json:"should_inline_css"`
+	CreatedAt       OptNilDateTime `json:"created_at"`
+	UpdatedAt       OptNilDateTime `json:"updated_at"`
+}
+
+// GetEmailTemplateID returns the value of EmailTemplateID.
+func (s *GetEmailTemplateInfoResponse) GetEmailTemplateID() string {
+	return s.EmailTemplateID
+}
+
+// GetTemplateName returns the value of TemplateName.
+func (s *GetEmailTemplateInfoResponse) GetTemplateName() string {
+	return s.TemplateName
+}
+
+// GetSubject returns the value of Subject.
+func (s *GetEmailTemplateInfoResponse) GetSubject() OptNilString {
+	return s.Subject
+}
+
+// GetBody returns the value of Body.
+func (s *GetEmailTemplateInfoResponse) GetBody() OptNilString {
+	return s.Body
+}
+
+// GetPlaintextBody returns the value of PlaintextBody.
+func (s *GetEmailTemplateInfoResponse) GetPlaintextBody() OptNilString {
+	return s.PlaintextBody
+}
+
+// GetPreheader returns the value of Preheader.
+func (s *GetEmailTemplateInfoResponse) GetPreheader() OptNilString {
+	return s.Preheader
+}
+
+// GetTags returns the value of Tags.
+func (s *GetEmailTemplateInfoResponse) GetTags() OptNilStringArray {
+	return s.Tags
+}
+
+// GetShouldInlineCSS returns the value of ShouldInlineCSS.
+func (s *GetEmailTemplateInfoResponse) GetShouldInlineCSS() OptNilBool {
+	return s.ShouldInlineCSS
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *GetEmailTemplateInfoResponse) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GetEmailTemplateInfoResponse) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetEmailTemplateID sets the value of EmailTemplateID.
+func (s *GetEmailTemplateInfoResponse) SetEmailTemplateID(val string) {
+	s.EmailTemplateID = val
+}
+
+// SetTemplateName sets the value of TemplateName.
+func (s *GetEmailTemplateInfoResponse) SetTemplateName(val string) {
+	s.TemplateName = val
+}
+
+// SetSubject sets the value of Subject.
+func (s *GetEmailTemplateInfoResponse) SetSubject(val OptNilString) {
+	s.Subject = val
+}
+
+// SetBody sets the value of Body.
+func (s *GetEmailTemplateInfoResponse) SetBody(val OptNilString) {
+	s.Body = val
+}
+
+// SetPlaintextBody sets the value of PlaintextBody.
+func (s *GetEmailTemplateInfoResponse) SetPlaintextBody(val OptNilString) {
+	s.PlaintextBody = val
+}
+
+// SetPreheader sets the value of Preheader.
+func (s *GetEmailTemplateInfoResponse) SetPreheader(val OptNilString) {
+	s.Preheader = val
+}
+
+// SetTags sets the value of Tags.
+func (s *GetEmailTemplateInfoResponse) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
+// SetShouldInlineCSS sets the value of ShouldInlineCSS.
+func (s *GetEmailTemplateInfoResponse) SetShouldInlineCSS(val OptNilBool) {
+	s.ShouldInlineCSS = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *GetEmailTemplateInfoResponse) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GetEmailTemplateInfoResponse) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/GetPreferenceCenterResponse
+type GetPreferenceCenterResponse struct {
+	// The preference center identifier.
+	PreferenceCenterAPIID string `json:"preference_center_api_id"`
+	// The name of the preference center.
+	Name string `json:"name"`
+	// The title of the preference center and confirmation pages.
+	PreferenceCenterTitle OptNilString `json:"preference_center_title"`
+	// The HTML of the preference center page.
+	PreferenceCenterPageHTML OptNilString `json:"preference_center_page_html"`
+	// The HTML of the confirmation page.
+	ConfirmationPageHTML OptNilString `json:"confirmation_page_html"`
+	// The HTML of the redirect page.
+	RedirectPageHTML        OptNilString               `json:"redirect_page_html"`
+	PreferenceCenterOptions OptPreferenceCenterOptions `json:"preference_center_options"`
+	State                   OptPreferenceCenterState   `json:"state"`
+	CreatedAt               OptNilDateTime             `json:"created_at"`
+	UpdatedAt               OptNilDateTime             `json:"updated_at"`
+}
+
+// GetPreferenceCenterAPIID returns the value of PreferenceCenterAPIID.
+func (s *GetPreferenceCenterResponse) GetPreferenceCenterAPIID() string {
+	return s.PreferenceCenterAPIID
+}
+
+// GetName returns the value of Name.
+func (s *GetPreferenceCenterResponse) GetName() string {
+	return s.Name
+}
+
+// GetPreferenceCenterTitle returns the value of PreferenceCenterTitle.
+func (s *GetPreferenceCenterResponse) GetPreferenceCenterTitle() OptNilString {
+	return s.PreferenceCenterTitle
+}
+
+// GetPreferenceCenterPageHTML returns the value of PreferenceCenterPageHTML.
+func (s *GetPreferenceCenterResponse) GetPreferenceCenterPageHTML() OptNilString {
+	return s.PreferenceCenterPageHTML
+}
+
+// GetConfirmationPageHTML returns the value of ConfirmationPageHTML.
+func (s *GetPreferenceCenterResponse) GetConfirmationPageHTML() OptNilString {
+	return s.ConfirmationPageHTML
+}
+
+// GetRedirectPageHTML returns the value of RedirectPageHTML.
+func (s *GetPreferenceCenterResponse) GetRedirectPageHTML() OptNilString {
+	return s.RedirectPageHTML
+}
+
+// GetPreferenceCenterOptions returns the value of PreferenceCenterOptions.
+func (s *GetPreferenceCenterResponse) GetPreferenceCenterOptions() OptPreferenceCenterOptions {
+	return s.PreferenceCenterOptions
+}
+
+// GetState returns the value of State.
+func (s *GetPreferenceCenterResponse) GetState() OptPreferenceCenterState {
+	return s.State
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *GetPreferenceCenterResponse) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GetPreferenceCenterResponse) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetPreferenceCenterAPIID sets the value of PreferenceCenterAPIID.
+func (s *GetPreferenceCenterResponse) SetPreferenceCenterAPIID(val string) {
+	s.PreferenceCenterAPIID = val
+}
+
+// SetName sets the value of Name.
+func (s *GetPreferenceCenterResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetPreferenceCenterTitle sets the value of PreferenceCenterTitle.
+func (s *GetPreferenceCenterResponse) SetPreferenceCenterTitle(val OptNilString) {
+	s.PreferenceCenterTitle = val
+}
+
+// SetPreferenceCenterPageHTML sets the value of PreferenceCenterPageHTML.
+func (s *GetPreferenceCenterResponse) SetPreferenceCenterPageHTML(val OptNilString) {
+	s.PreferenceCenterPageHTML = val
+}
+
+// SetConfirmationPageHTML sets the value of ConfirmationPageHTML.
+func (s *GetPreferenceCenterResponse) SetConfirmationPageHTML(val OptNilString) {
+	s.ConfirmationPageHTML = val
+}
+
+// SetRedirectPageHTML sets the value of RedirectPageHTML.
+func (s *GetPreferenceCenterResponse) SetRedirectPageHTML(val OptNilString) {
+	s.RedirectPageHTML = val
+}
+
+// SetPreferenceCenterOptions sets the value of PreferenceCenterOptions.
+func (s *GetPreferenceCenterResponse) SetPreferenceCenterOptions(val OptPreferenceCenterOptions) {
+	s.PreferenceCenterOptions = val
+}
+
+// SetState sets the value of State.
+func (s *GetPreferenceCenterResponse) SetState(val OptPreferenceCenterState) {
+	s.State = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *GetPreferenceCenterResponse) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GetPreferenceCenterResponse) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/GetSegmentDetailsResponse
+type GetSegmentDetailsResponse struct {
+	// The name of the segment.
+	Name string `json:"name"`
+	// The description of the segment.
+	Description OptNilString `json:"description"`
+	// A human-readable description of the segment's filters.
+	TextDescription OptNilString `json:"text_description"`
+	// Whether analytics tracking is enabled for the segment.
+	AnalyticsTrackingEnabled OptBool `json:"analytics_tracking_enabled"`
+	// The tags of the segment.
+	Tags      []string    `json:"tags"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+	Message   OptString   `json:"message"`
+}
+
+// GetName returns the value of Name.
+func (s *GetSegmentDetailsResponse) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *GetSegmentDetailsResponse) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetTextDescription returns the value of TextDescription.
+func (s *GetSegmentDetailsResponse) GetTextDescription() OptNilString {
+	return s.TextDescription
+}
+
+// GetAnalyticsTrackingEnabled returns the value of AnalyticsTrackingEnabled.
+func (s *GetSegmentDetailsResponse) GetAnalyticsTrackingEnabled() OptBool {
+	return s.AnalyticsTrackingEnabled
+}
+
+// GetTags returns the value of Tags.
+func (s *GetSegmentDetailsResponse) GetTags() []string {
+	return s.Tags
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *GetSegmentDetailsResponse) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *GetSegmentDetailsResponse) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetMessage returns the value of Message.
+func (s *GetSegmentDetailsResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetName sets the value of Name.
+func (s *GetSegmentDetailsResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *GetSegmentDetailsResponse) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetTextDescription sets the value of TextDescription.
+func (s *GetSegmentDetailsResponse) SetTextDescription(val OptNilString) {
+	s.TextDescription = val
+}
+
+// SetAnalyticsTrackingEnabled sets the value of AnalyticsTrackingEnabled.
+func (s *GetSegmentDetailsResponse) SetAnalyticsTrackingEnabled(val OptBool) {
+	s.AnalyticsTrackingEnabled = val
+}
+
+// SetTags sets the value of Tags.
+func (s *GetSegmentDetailsResponse) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *GetSegmentDetailsResponse) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *GetSegmentDetailsResponse) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GetSegmentDetailsResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/ListCampaignsResponse
+type ListCampaignsResponse struct {
+	Campaigns []ListCampaignsResponseCampaignsItem `json:"campaigns"`
+	Message   OptString                            `json:"message"`
+}
+
+// GetCampaigns returns the value of Campaigns.
+func (s *ListCampaignsResponse) GetCampaigns() []ListCampaignsResponseCampaignsItem {
+	return s.Campaigns
+}
+
+// GetMessage returns the value of Message.
+func (s *ListCampaignsResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetCampaigns sets the value of Campaigns.
+func (s *ListCampaignsResponse) SetCampaigns(val []ListCampaignsResponseCampaignsItem) {
+	s.Campaigns = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListCampaignsResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+type ListCampaignsResponseCampaignsItem struct {
+	// The campaign identifier.
+	ID string `json:"id"`
+	// The name of the campaign.
+	Name string `json:"name"`
+	// Whether the campaign is an API campaign.
+	IsAPICampaign OptBool `json:"is_api_campaign"`
+	// The tags of the campaign.
+	Tags []string `json:"tags"`
+	// When the campaign was last edited.
+	LastEdited OptDateTime `json:"last_edited"`
+}
+
+// GetID returns the value of ID.
+func (s *ListCampaignsResponseCampaignsItem) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ListCampaignsResponseCampaignsItem) GetName() string {
+	return s.Name
+}
+
+// GetIsAPICampaign returns the value of IsAPICampaign.
+func (s *ListCampaignsResponseCampaignsItem) GetIsAPICampaign() OptBool {
+	return s.IsAPICampaign
+}
+
+// GetTags returns the value of Tags.
+func (s *ListCampaignsResponseCampaignsItem) GetTags() []string {
+	return s.Tags
+}
+
+// GetLastEdited returns the value of LastEdited.
+func (s *ListCampaignsResponseCampaignsItem) GetLastEdited() OptDateTime {
+	return s.LastEdited
+}
+
+// SetID sets the value of ID.
+func (s *ListCampaignsResponseCampaignsItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ListCampaignsResponseCampaignsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetIsAPICampaign sets the value of IsAPICampaign.
+func (s *ListCampaignsResponseCampaignsItem) SetIsAPICampaign(val OptBool) {
+	s.IsAPICampaign = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ListCampaignsResponseCampaignsItem) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetLastEdited sets the value of LastEdited.
+func (s *ListCampaignsResponseCampaignsItem) SetLastEdited(val OptDateTime) {
+	s.LastEdited = val
+}
+
+type ListCampaignsSortDirection string
+
+const (
+	ListCampaignsSortDirectionAsc  ListCampaignsSortDirection = "asc"
+	ListCampaignsSortDirectionDesc ListCampaignsSortDirection = "desc"
+)
+
+// AllValues returns all ListCampaignsSortDirection values.
+func (ListCampaignsSortDirection) AllValues() []ListCampaignsSortDirection {
+	return []ListCampaignsSortDirection{
+		ListCampaignsSortDirectionAsc,
+		ListCampaignsSortDirectionDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListCampaignsSortDirection) MarshalText() ([]byte, error) {
+	switch s {
+	case ListCampaignsSortDirectionAsc:
+		return []byte(s), nil
+	case ListCampaignsSortDirectionDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListCampaignsSortDirection) UnmarshalText(data []byte) error {
+	switch ListCampaignsSortDirection(data) {
+	case ListCampaignsSortDirectionAsc:
+		*s = ListCampaignsSortDirectionAsc
+		return nil
+	case ListCampaignsSortDirectionDesc:
+		*s = ListCampaignsSortDirectionDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/ListCanvasesResponse
+type ListCanvasesResponse struct {
+	Canvases []ListCanvasesResponseCanvasesItem `json:"canvases"`
+	Message  OptString                          `json:"message"`
+}
+
+// GetCanvases returns the value of Canvases.
+func (s *ListCanvasesResponse) GetCanvases() []ListCanvasesResponseCanvasesItem {
+	return s.Canvases
+}
+
+// GetMessage returns the value of Message.
+func (s *ListCanvasesResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetCanvases sets the value of Canvases.
+func (s *ListCanvasesResponse) SetCanvases(val []ListCanvasesResponseCanvasesItem) {
+	s.Canvases = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListCanvasesResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+type ListCanvasesResponseCanvasesItem struct {
+	// The Canvas identifier.
+	ID string `json:"id"`
+	// The name of the Canvas.
+	Name string `json:"name"`
+	// The tags of the Canvas.
+	Tags []string `json:"tags"`
+	// When the Canvas was last edited.
+	LastEdited OptDateTime `json:"last_edited"`
+}
+
+// GetID returns the value of ID.
+func (s *ListCanvasesResponseCanvasesItem) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ListCanvasesResponseCanvasesItem) GetName() string {
+	return s.Name
+}
+
+// GetTags returns the value of Tags.
+func (s *ListCanvasesResponseCanvasesItem) GetTags() []string {
+	return s.Tags
+}
+
+// GetLastEdited returns the value of LastEdited.
+func (s *ListCanvasesResponseCanvasesItem) GetLastEdited() OptDateTime {
+	return s.LastEdited
+}
+
+// SetID sets the value of ID.
+func (s *ListCanvasesResponseCanvasesItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ListCanvasesResponseCanvasesItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ListCanvasesResponseCanvasesItem) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetLastEdited sets the value of LastEdited.
+func (s *ListCanvasesResponseCanvasesItem) SetLastEdited(val OptDateTime) {
+	s.LastEdited = val
+}
+
+type ListCanvasesSortDirection string
+
+const (
+	ListCanvasesSortDirectionAsc  ListCanvasesSortDirection = "asc"
+	ListCanvasesSortDirectionDesc ListCanvasesSortDirection = "desc"
+)
+
+// AllValues returns all ListCanvasesSortDirection values.
+func (ListCanvasesSortDirection) AllValues() []ListCanvasesSortDirection {
+	return []ListCanvasesSortDirection{
+		ListCanvasesSortDirectionAsc,
+		ListCanvasesSortDirectionDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListCanvasesSortDirection) MarshalText() ([]byte, error) {
+	switch s {
+	case ListCanvasesSortDirectionAsc:
+		return []byte(s), nil
+	case ListCanvasesSortDirectionDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListCanvasesSortDirection) UnmarshalText(data []byte) error {
+	switch ListCanvasesSortDirection(data) {
+	case ListCanvasesSortDirectionAsc:
+		*s = ListCanvasesSortDirectionAsc
+		return nil
+	case ListCanvasesSortDirectionDesc:
+		*s = ListCanvasesSortDirectionDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/ListCatalogItemsResponse
+type ListCatalogItemsResponse struct {
+	Items   []CatalogItem `json:"items"`
+	Message string        `json:"message"`
+}
+
+// GetItems returns the value of Items.
+func (s *ListCatalogItemsResponse) GetItems() []CatalogItem {
+	return s.Items
+}
+
+// GetMessage returns the value of Message.
+func (s *ListCatalogItemsResponse) GetMessage() string {
+	return s.Message
+}
+
+// SetItems sets the value of Items.
+func (s *ListCatalogItemsResponse) SetItems(val []CatalogItem) {
+	s.Items = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListCatalogItemsResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// ListCatalogItemsResponseHeaders wraps ListCatalogItemsResponse with response headers.
+type ListCatalogItemsResponseHeaders struct {
+	Link     OptString
+	Response ListCatalogItemsResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListCatalogItemsResponseHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *ListCatalogItemsResponseHeaders) GetResponse() ListCatalogItemsResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListCatalogItemsResponseHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListCatalogItemsResponseHeaders) SetResponse(val ListCatalogItemsResponse) {
+	s.Response = val
+}
+
+// Ref: #/ListCatalogsResponse
+type ListCatalogsResponse struct {
+	Catalogs []Catalog `json:"catalogs"`
+	Message  string    `json:"message"`
+}
+
+// GetCatalogs returns the value of Catalogs.
+func (s *ListCatalogsResponse) GetCatalogs() []Catalog {
+	return s.Catalogs
+}
+
+// GetMessage returns the value of Message.
+func (s *ListCatalogsResponse) GetMessage() string {
+	return s.Message
+}
+
+// SetCatalogs sets the value of Catalogs.
+func (s *ListCatalogsResponse) SetCatalogs(val []Catalog) {
+	s.Catalogs = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListCatalogsResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// ListCatalogsResponseHeaders wraps ListCatalogsResponse with response headers.
+type ListCatalogsResponseHeaders struct {
+	Link     OptString
+	Response ListCatalogsResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListCatalogsResponseHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *ListCatalogsResponseHeaders) GetResponse() ListCatalogsResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListCatalogsResponseHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListCatalogsResponseHeaders) SetResponse(val ListCatalogsResponse) {
+	s.Response = val
+}
+
+// Ref: #/ListContentBlocksResponse
+type ListContentBlocksResponse struct {
+	// The number of Content Blocks returned.
+	Count         int                                     `json:"count"`
+	ContentBlocks []ListContentBlocksResponseContentBlock `json:"content_blocks"`
+}
+
+// GetCount returns the value of Count.
+func (s *ListContentBlocksResponse) GetCount() int {
+	return s.Count
+}
+
+// GetContentBlocks returns the value of ContentBlocks.
+func (s *ListContentBlocksResponse) GetContentBlocks() []ListContentBlocksResponseContentBlock {
+	return s.ContentBlocks
+}
+
+// SetCount sets the value of Count.
+func (s *ListContentBlocksResponse) SetCount(val int) {
+	s.Count = val
+}
+
+// SetContentBlocks sets the value of ContentBlocks.
+func (s *ListContentBlocksResponse) SetContentBlocks(val []ListContentBlocksResponseContentBlock) {
+	s.ContentBlocks = val
+}
+
+// Ref: #/ListContentBlocksResponseContentBlock
+type ListContentBlocksResponseContentBlock struct {
+	// The Content Block identifier.
+	ContentBlockID string `json:"content_block_id"`
+	// The name of the Content Block.
+	Name string `json:"name"`
+	// Tags formatted as strings.
+	Tags OptNilStringArray `json:"tags"`
+}
+
+// GetContentBlockID returns the value of ContentBlockID.
+func (s *ListContentBlocksResponseContentBlock) GetContentBlockID() string {
+	return s.ContentBlockID
+}
+
+// GetName returns the value of Name.
+func (s *ListContentBlocksResponseContentBlock) GetName() string {
+	return s.Name
+}
+
+// GetTags returns the value of Tags.
+func (s *ListContentBlocksResponseContentBlock) GetTags() OptNilStringArray {
+	return s.Tags
+}
+
+// SetContentBlockID sets the value of ContentBlockID.
+func (s *ListContentBlocksResponseContentBlock) SetContentBlockID(val string) {
+	s.ContentBlockID = val
+}
+
+// SetName sets the value of Name.
+func (s *ListContentBlocksResponseContentBlock) SetName(val string) {
+	s.Name = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ListContentBlocksResponseContentBlock) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
+// Ref: #/ListCustomAttributesResponse
+type ListCustomAttributesResponse struct {
+	Attributes []CustomAttribute `json:"attributes"`
+	Message    OptString         `json:"message"`
+}
+
+// GetAttributes returns the value of Attributes.
+func (s *ListCustomAttributesResponse) GetAttributes() []CustomAttribute {
+	return s.Attributes
+}
+
+// GetMessage returns the value of Message.
+func (s *ListCustomAttributesResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetAttributes sets the value of Attributes.
+func (s *ListCustomAttributesResponse) SetAttributes(val []CustomAttribute) {
+	s.Attributes = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListCustomAttributesResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// ListCustomAttributesResponseHeaders wraps ListCustomAttributesResponse with response headers.
+type ListCustomAttributesResponseHeaders struct {
+	Link     OptString
+	Response ListCustomAttributesResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListCustomAttributesResponseHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *ListCustomAttributesResponseHeaders) GetResponse() ListCustomAttributesResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListCustomAttributesResponseHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListCustomAttributesResponseHeaders) SetResponse(val ListCustomAttributesResponse) {
+	s.Response = val
+}
+
+// Ref: #/ListCustomEventsResponse
+type ListCustomEventsResponse struct {
+	Events  []CustomEvent `json:"events"`
+	Message OptString     `json:"message"`
+}
+
+// GetEvents returns the value of Events.
+func (s *ListCustomEventsResponse) GetEvents() []CustomEvent {
+	return s.Events
+}
+
+// GetMessage returns the value of Message.
+func (s *ListCustomEventsResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetEvents sets the value of Events.
+func (s *ListCustomEventsResponse) SetEvents(val []CustomEvent) {
+	s.Events = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListCustomEventsResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// ListCustomEventsResponseHeaders wraps ListCustomEventsResponse with response headers.
+type ListCustomEventsResponseHeaders struct {
+	Link     OptString
+	Response ListCustomEventsResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListCustomEventsResponseHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *ListCustomEventsResponseHeaders) GetResponse() ListCustomEventsResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListCustomEventsResponseHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListCustomEventsResponseHeaders) SetResponse(val ListCustomEventsResponse) {
+	s.Response = val
+}
+
+// Ref: #/ListEmailTemplatesResponse
+type ListEmailTemplatesResponse struct {
+	Count     int                                       `json:"count"`
+	Templates []ListEmailTemplatesResponseTemplatesItem `json:"templates"`
+}
+
+// GetCount returns the value of Count.
+func (s *ListEmailTemplatesResponse) GetCount() int {
+	return s.Count
+}
+
+// GetTemplates returns the value of Templates.
+func (s *ListEmailTemplatesResponse) GetTemplates() []ListEmailTemplatesResponseTemplatesItem {
+	return s.Templates
+}
+
+// SetCount sets the value of Count.
+func (s *ListEmailTemplatesResponse) SetCount(val int) {
+	s.Count = val
 }
 
 // SetTemplates sets the value of Templates.
@@ -1035,132 +3167,1294 @@ func (s *ListEmailTemplatesResponse) SetTemplates(val []ListEmailTemplatesRespon
 	s.Templates = val
 }
 
-type ListEmailTemplatesResponseTemplatesItem struct {
-	// The Email Template identifier.
-	EmailTemplateID string `json:"email_template_id"`
-	// The name of your email template.
-	TemplateName string         `json:"template_name"`
-	CreatedAt    OptNilDateTime `json:"created_at"`
-	UpdatedAt    OptNilDateTime `json:"updated_at"`
+type ListEmailTemplatesResponseTemplatesItem struct {
+	// The Email Template identifier.
+	EmailTemplateID string `json:"email_template_id"`
+	// The name of your email template.
+	TemplateName string         `json:"template_name"`
+	CreatedAt    OptNilDateTime `json:"created_at"`
+	UpdatedAt    OptNilDateTime `json:"updated_at"`
+}
+
+// GetEmailTemplateID returns the value of EmailTemplateID.
+func (s *ListEmailTemplatesResponseTemplatesItem) GetEmailTemplateID() string {
+	return s.EmailTemplateID
+}
+
+// GetTemplateName returns the value of TemplateName.
+func (s *ListEmailTemplatesResponseTemplatesItem) GetTemplateName() string {
+	return s.TemplateName
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ListEmailTemplatesResponseTemplatesItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ListEmailTemplatesResponseTemplatesItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetEmailTemplateID sets the value of EmailTemplateID.
+func (s *ListEmailTemplatesResponseTemplatesItem) SetEmailTemplateID(val string) {
+	s.EmailTemplateID = val
+}
+
+// SetTemplateName sets the value of TemplateName.
+func (s *ListEmailTemplatesResponseTemplatesItem) SetTemplateName(val string) {
+	s.TemplateName = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ListEmailTemplatesResponseTemplatesItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ListEmailTemplatesResponseTemplatesItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/ListPreferenceCentersResponse
+type ListPreferenceCentersResponse struct {
+	PreferenceCenters []ListPreferenceCentersResponsePreferenceCentersItem `json:"preference_centers"`
+}
+
+// GetPreferenceCenters returns the value of PreferenceCenters.
+func (s *ListPreferenceCentersResponse) GetPreferenceCenters() []ListPreferenceCentersResponsePreferenceCentersItem {
+	return s.PreferenceCenters
+}
+
+// SetPreferenceCenters sets the value of PreferenceCenters.
+func (s *ListPreferenceCentersResponse) SetPreferenceCenters(val []ListPreferenceCentersResponsePreferenceCentersItem) {
+	s.PreferenceCenters = val
+}
+
+type ListPreferenceCentersResponsePreferenceCentersItem struct {
+	// The preference center identifier.
+	PreferenceCenterAPIID string `json:"preference_center_api_id"`
+	// The name of the preference center.
+	Name      string         `json:"name"`
+	CreatedAt OptNilDateTime `json:"created_at"`
+	UpdatedAt OptNilDateTime `json:"updated_at"`
+}
+
+// GetPreferenceCenterAPIID returns the value of PreferenceCenterAPIID.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) GetPreferenceCenterAPIID() string {
+	return s.PreferenceCenterAPIID
+}
+
+// GetName returns the value of Name.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) GetName() string {
+	return s.Name
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) GetCreatedAt() OptNilDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) GetUpdatedAt() OptNilDateTime {
+	return s.UpdatedAt
+}
+
+// SetPreferenceCenterAPIID sets the value of PreferenceCenterAPIID.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) SetPreferenceCenterAPIID(val string) {
+	s.PreferenceCenterAPIID = val
+}
+
+// SetName sets the value of Name.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) SetCreatedAt(val OptNilDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ListPreferenceCentersResponsePreferenceCentersItem) SetUpdatedAt(val OptNilDateTime) {
+	s.UpdatedAt = val
+}
+
+// Ref: #/ListProductsResponse
+type ListProductsResponse struct {
+	// The product identifiers of purchases logged in the workspace.
+	Products []string  `json:"products"`
+	Message  OptString `json:"message"`
+}
+
+// GetProducts returns the value of Products.
+func (s *ListProductsResponse) GetProducts() []string {
+	return s.Products
+}
+
+// GetMessage returns the value of Message.
+func (s *ListProductsResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetProducts sets the value of Products.
+func (s *ListProductsResponse) SetProducts(val []string) {
+	s.Products = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListProductsResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/ListSegmentsResponse
+type ListSegmentsResponse struct {
+	Segments []ListSegmentsResponseSegmentsItem `json:"segments"`
+	Message  OptString                          `json:"message"`
+}
+
+// GetSegments returns the value of Segments.
+func (s *ListSegmentsResponse) GetSegments() []ListSegmentsResponseSegmentsItem {
+	return s.Segments
+}
+
+// GetMessage returns the value of Message.
+func (s *ListSegmentsResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetSegments sets the value of Segments.
+func (s *ListSegmentsResponse) SetSegments(val []ListSegmentsResponseSegmentsItem) {
+	s.Segments = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ListSegmentsResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+type ListSegmentsResponseSegmentsItem struct {
+	// The segment identifier.
+	ID string `json:"id"`
+	// The name of the segment.
+	Name string `json:"name"`
+	// Whether analytics tracking is enabled for the segment.
+	AnalyticsTrackingEnabled OptBool `json:"analytics_tracking_enabled"`
+	// The tags of the segment.
+	Tags []string `json:"tags"`
+}
+
+// GetID returns the value of ID.
+func (s *ListSegmentsResponseSegmentsItem) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *ListSegmentsResponseSegmentsItem) GetName() string {
+	return s.Name
+}
+
+// GetAnalyticsTrackingEnabled returns the value of AnalyticsTrackingEnabled.
+func (s *ListSegmentsResponseSegmentsItem) GetAnalyticsTrackingEnabled() OptBool {
+	return s.AnalyticsTrackingEnabled
+}
+
+// GetTags returns the value of Tags.
+func (s *ListSegmentsResponseSegmentsItem) GetTags() []string {
+	return s.Tags
+}
+
+// SetID sets the value of ID.
+func (s *ListSegmentsResponseSegmentsItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *ListSegmentsResponseSegmentsItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetAnalyticsTrackingEnabled sets the value of AnalyticsTrackingEnabled.
+func (s *ListSegmentsResponseSegmentsItem) SetAnalyticsTrackingEnabled(val OptBool) {
+	s.AnalyticsTrackingEnabled = val
+}
+
+// SetTags sets the value of Tags.
+func (s *ListSegmentsResponseSegmentsItem) SetTags(val []string) {
+	s.Tags = val
+}
+
+type ListSegmentsSortDirection string
+
+const (
+	ListSegmentsSortDirectionAsc  ListSegmentsSortDirection = "asc"
+	ListSegmentsSortDirectionDesc ListSegmentsSortDirection = "desc"
+)
+
+// AllValues returns all ListSegmentsSortDirection values.
+func (ListSegmentsSortDirection) AllValues() []ListSegmentsSortDirection {
+	return []ListSegmentsSortDirection{
+		ListSegmentsSortDirectionAsc,
+		ListSegmentsSortDirectionDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListSegmentsSortDirection) MarshalText() ([]byte, error) {
+	switch s {
+	case ListSegmentsSortDirectionAsc:
+		return []byte(s), nil
+	case ListSegmentsSortDirectionDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListSegmentsSortDirection) UnmarshalText(data []byte) error {
+	switch ListSegmentsSortDirection(data) {
+	case ListSegmentsSortDirectionAsc:
+		*s = ListSegmentsSortDirectionAsc
+		return nil
+	case ListSegmentsSortDirectionDesc:
+		*s = ListSegmentsSortDirectionDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/MessageSchedule
+type MessageSchedule struct {
+	// When to send the message, or the local time to send it at when in_local_time is true.
+	Time time.Time `json:"time"`
+	// Whether to send the message at time in each user's time zone.
+	InLocalTime OptBool `json:"in_local_time"`
+	// Whether to send the message on the day of time at each user's optimal time.
+	AtOptimalTime OptBool `json:"at_optimal_time"`
+}
+
+// GetTime returns the value of Time.
+func (s *MessageSchedule) GetTime() time.Time {
+	return s.Time
+}
+
+// GetInLocalTime returns the value of InLocalTime.
+func (s *MessageSchedule) GetInLocalTime() OptBool {
+	return s.InLocalTime
+}
+
+// GetAtOptimalTime returns the value of AtOptimalTime.
+func (s *MessageSchedule) GetAtOptimalTime() OptBool {
+	return s.AtOptimalTime
+}
+
+// SetTime sets the value of Time.
+func (s *MessageSchedule) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetInLocalTime sets the value of InLocalTime.
+func (s *MessageSchedule) SetInLocalTime(val OptBool) {
+	s.InLocalTime = val
+}
+
+// SetAtOptimalTime sets the value of AtOptimalTime.
+func (s *MessageSchedule) SetAtOptimalTime(val OptBool) {
+	s.AtOptimalTime = val
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCanvasEntryProperties returns new OptCanvasEntryProperties with value set to v.
+func NewOptCanvasEntryProperties(v CanvasEntryProperties) OptCanvasEntryProperties {
+	return OptCanvasEntryProperties{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCanvasEntryProperties is optional CanvasEntryProperties.
+type OptCanvasEntryProperties struct {
+	Value CanvasEntryProperties
+	Set   bool
+}
+
+// IsSet returns true if OptCanvasEntryProperties was set.
+func (o OptCanvasEntryProperties) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCanvasEntryProperties) Reset() {
+	var v CanvasEntryProperties
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCanvasEntryProperties) SetTo(v CanvasEntryProperties) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCanvasEntryProperties) Get() (v CanvasEntryProperties, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCanvasEntryProperties) Or(d CanvasEntryProperties) CanvasEntryProperties {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConnectedAudience returns new OptConnectedAudience with value set to v.
+func NewOptConnectedAudience(v ConnectedAudience) OptConnectedAudience {
+	return OptConnectedAudience{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConnectedAudience is optional ConnectedAudience.
+type OptConnectedAudience struct {
+	Value ConnectedAudience
+	Set   bool
+}
+
+// IsSet returns true if OptConnectedAudience was set.
+func (o OptConnectedAudience) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConnectedAudience) Reset() {
+	var v ConnectedAudience
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConnectedAudience) SetTo(v ConnectedAudience) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConnectedAudience) Get() (v ConnectedAudience, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConnectedAudience) Or(d ConnectedAudience) ConnectedAudience {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateContentBlockRequestState returns new OptCreateContentBlockRequestState with value set to v.
+func NewOptCreateContentBlockRequestState(v CreateContentBlockRequestState) OptCreateContentBlockRequestState {
+	return OptCreateContentBlockRequestState{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateContentBlockRequestState is optional CreateContentBlockRequestState.
+type OptCreateContentBlockRequestState struct {
+	Value CreateContentBlockRequestState
+	Set   bool
+}
+
+// IsSet returns true if OptCreateContentBlockRequestState was set.
+func (o OptCreateContentBlockRequestState) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateContentBlockRequestState) Reset() {
+	var v CreateContentBlockRequestState
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateContentBlockRequestState) SetTo(v CreateContentBlockRequestState) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateContentBlockRequestState) Get() (v CreateContentBlockRequestState, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateContentBlockRequestState) Or(d CreateContentBlockRequestState) CreateContentBlockRequestState {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDashboardUserName returns new OptDashboardUserName with value set to v.
+func NewOptDashboardUserName(v DashboardUserName) OptDashboardUserName {
+	return OptDashboardUserName{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDashboardUserName is optional DashboardUserName.
+type OptDashboardUserName struct {
+	Value DashboardUserName
+	Set   bool
+}
+
+// IsSet returns true if OptDashboardUserName was set.
+func (o OptDashboardUserName) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDashboardUserName) Reset() {
+	var v DashboardUserName
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDashboardUserName) SetTo(v DashboardUserName) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDashboardUserName) Get() (v DashboardUserName, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDashboardUserName) Or(d DashboardUserName) DashboardUserName {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDashboardUserPermissions returns new OptDashboardUserPermissions with value set to v.
+func NewOptDashboardUserPermissions(v DashboardUserPermissions) OptDashboardUserPermissions {
+	return OptDashboardUserPermissions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDashboardUserPermissions is optional DashboardUserPermissions.
+type OptDashboardUserPermissions struct {
+	Value DashboardUserPermissions
+	Set   bool
+}
+
+// IsSet returns true if OptDashboardUserPermissions was set.
+func (o OptDashboardUserPermissions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDashboardUserPermissions) Reset() {
+	var v DashboardUserPermissions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDashboardUserPermissions) SetTo(v DashboardUserPermissions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDashboardUserPermissions) Get() (v DashboardUserPermissions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDashboardUserPermissions) Or(d DashboardUserPermissions) DashboardUserPermissions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListCampaignsSortDirection returns new OptListCampaignsSortDirection with value set to v.
+func NewOptListCampaignsSortDirection(v ListCampaignsSortDirection) OptListCampaignsSortDirection {
+	return OptListCampaignsSortDirection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListCampaignsSortDirection is optional ListCampaignsSortDirection.
+type OptListCampaignsSortDirection struct {
+	Value ListCampaignsSortDirection
+	Set   bool
+}
+
+// IsSet returns true if OptListCampaignsSortDirection was set.
+func (o OptListCampaignsSortDirection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListCampaignsSortDirection) Reset() {
+	var v ListCampaignsSortDirection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListCampaignsSortDirection) SetTo(v ListCampaignsSortDirection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListCampaignsSortDirection) Get() (v ListCampaignsSortDirection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListCampaignsSortDirection) Or(d ListCampaignsSortDirection) ListCampaignsSortDirection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListCanvasesSortDirection returns new OptListCanvasesSortDirection with value set to v.
+func NewOptListCanvasesSortDirection(v ListCanvasesSortDirection) OptListCanvasesSortDirection {
+	return OptListCanvasesSortDirection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListCanvasesSortDirection is optional ListCanvasesSortDirection.
+type OptListCanvasesSortDirection struct {
+	Value ListCanvasesSortDirection
+	Set   bool
+}
+
+// IsSet returns true if OptListCanvasesSortDirection was set.
+func (o OptListCanvasesSortDirection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListCanvasesSortDirection) Reset() {
+	var v ListCanvasesSortDirection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListCanvasesSortDirection) SetTo(v ListCanvasesSortDirection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListCanvasesSortDirection) Get() (v ListCanvasesSortDirection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListCanvasesSortDirection) Or(d ListCanvasesSortDirection) ListCanvasesSortDirection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListSegmentsSortDirection returns new OptListSegmentsSortDirection with value set to v.
+func NewOptListSegmentsSortDirection(v ListSegmentsSortDirection) OptListSegmentsSortDirection {
+	return OptListSegmentsSortDirection{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListSegmentsSortDirection is optional ListSegmentsSortDirection.
+type OptListSegmentsSortDirection struct {
+	Value ListSegmentsSortDirection
+	Set   bool
+}
+
+// IsSet returns true if OptListSegmentsSortDirection was set.
+func (o OptListSegmentsSortDirection) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListSegmentsSortDirection) Reset() {
+	var v ListSegmentsSortDirection
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListSegmentsSortDirection) SetTo(v ListSegmentsSortDirection) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListSegmentsSortDirection) Get() (v ListSegmentsSortDirection, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListSegmentsSortDirection) Or(d ListSegmentsSortDirection) ListSegmentsSortDirection {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMessageSchedule returns new OptMessageSchedule with value set to v.
+func NewOptMessageSchedule(v MessageSchedule) OptMessageSchedule {
+	return OptMessageSchedule{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMessageSchedule is optional MessageSchedule.
+type OptMessageSchedule struct {
+	Value MessageSchedule
+	Set   bool
+}
+
+// IsSet returns true if OptMessageSchedule was set.
+func (o OptMessageSchedule) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMessageSchedule) Reset() {
+	var v MessageSchedule
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMessageSchedule) SetTo(v MessageSchedule) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMessageSchedule) Get() (v MessageSchedule, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMessageSchedule) Or(d MessageSchedule) MessageSchedule {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBool returns new OptNilBool with value set to v.
+func NewOptNilBool(v bool) OptNilBool {
+	return OptNilBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBool is optional nullable bool.
+type OptNilBool struct {
+	Value bool
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBool was set.
+func (o OptNilBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBool) SetTo(v bool) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilBool) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilBool) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v bool
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBool) Get() (v bool, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
 }
 
-// GetEmailTemplateID returns the value of EmailTemplateID.
-func (s *ListEmailTemplatesResponseTemplatesItem) GetEmailTemplateID() string {
-	return s.EmailTemplateID
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
 }
 
-// GetTemplateName returns the value of TemplateName.
-func (s *ListEmailTemplatesResponseTemplatesItem) GetTemplateName() string {
-	return s.TemplateName
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *ListEmailTemplatesResponseTemplatesItem) GetCreatedAt() OptNilDateTime {
-	return s.CreatedAt
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *ListEmailTemplatesResponseTemplatesItem) GetUpdatedAt() OptNilDateTime {
-	return s.UpdatedAt
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// SetEmailTemplateID sets the value of EmailTemplateID.
-func (s *ListEmailTemplatesResponseTemplatesItem) SetEmailTemplateID(val string) {
-	s.EmailTemplateID = val
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetTemplateName sets the value of TemplateName.
-func (s *ListEmailTemplatesResponseTemplatesItem) SetTemplateName(val string) {
-	s.TemplateName = val
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *ListEmailTemplatesResponseTemplatesItem) SetCreatedAt(val OptNilDateTime) {
-	s.CreatedAt = val
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *ListEmailTemplatesResponseTemplatesItem) SetUpdatedAt(val OptNilDateTime) {
-	s.UpdatedAt = val
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
 }
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
 		Value: v,
+		Set:   true,
 	}
 }
 
-// NilString is nullable string.
-type NilString struct {
+// OptNilString is optional nullable string.
+type OptNilString struct {
 	Value string
+	Set   bool
 	Null  bool
 }
 
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
 // SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
 	o.Null = false
 	o.Value = v
 }
 
 // IsNull returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
+func (o OptNilString) IsNull() bool { return o.Null }
 
 // SetToNull sets value to null.
-func (o *NilString) SetToNull() {
+func (o *OptNilString) SetToNull() {
+	o.Set = true
 	o.Null = true
 	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
+func (o OptNilString) Get() (v string, ok bool) {
 	if o.Null {
 		return v, false
 	}
+	if !o.Set {
+		return v, false
+	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
+func (o OptNilString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
+// NewOptNilStringArray returns new OptNilStringArray with value set to v.
+func NewOptNilStringArray(v []string) OptNilStringArray {
+	return OptNilStringArray{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
+// OptNilStringArray is optional nullable []string.
+type OptNilStringArray struct {
+	Value []string
 	Set   bool
+	Null  bool
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// IsSet returns true if OptNilStringArray was set.
+func (o OptNilStringArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilStringArray) Reset() {
+	var v []string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilStringArray) SetTo(v []string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilStringArray) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilStringArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilStringArray) Get() (v []string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilStringArray) Or(d []string) []string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPreferenceCenterOptions returns new OptPreferenceCenterOptions with value set to v.
+func NewOptPreferenceCenterOptions(v PreferenceCenterOptions) OptPreferenceCenterOptions {
+	return OptPreferenceCenterOptions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPreferenceCenterOptions is optional PreferenceCenterOptions.
+type OptPreferenceCenterOptions struct {
+	Value PreferenceCenterOptions
+	Set   bool
+}
+
+// IsSet returns true if OptPreferenceCenterOptions was set.
+func (o OptPreferenceCenterOptions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPreferenceCenterOptions) Reset() {
+	var v PreferenceCenterOptions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPreferenceCenterOptions) SetTo(v PreferenceCenterOptions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPreferenceCenterOptions) Get() (v PreferenceCenterOptions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPreferenceCenterOptions) Or(d PreferenceCenterOptions) PreferenceCenterOptions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPreferenceCenterState returns new OptPreferenceCenterState with value set to v.
+func NewOptPreferenceCenterState(v PreferenceCenterState) OptPreferenceCenterState {
+	return OptPreferenceCenterState{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPreferenceCenterState is optional PreferenceCenterState.
+type OptPreferenceCenterState struct {
+	Value PreferenceCenterState
+	Set   bool
+}
+
+// IsSet returns true if OptPreferenceCenterState was set.
+func (o OptPreferenceCenterState) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
+func (o *OptPreferenceCenterState) Reset() {
+	var v PreferenceCenterState
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
+func (o *OptPreferenceCenterState) SetTo(v PreferenceCenterState) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
+func (o OptPreferenceCenterState) Get() (v PreferenceCenterState, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1168,45 +4462,45 @@ func (o OptBool) Get() (v bool, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
+func (o OptPreferenceCenterState) Or(d PreferenceCenterState) PreferenceCenterState {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptCreateContentBlockRequestState returns new OptCreateContentBlockRequestState with value set to v.
-func NewOptCreateContentBlockRequestState(v CreateContentBlockRequestState) OptCreateContentBlockRequestState {
-	return OptCreateContentBlockRequestState{
+// NewOptScheduledMessagePayload returns new OptScheduledMessagePayload with value set to v.
+func NewOptScheduledMessagePayload(v ScheduledMessagePayload) OptScheduledMessagePayload {
+	return OptScheduledMessagePayload{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptCreateContentBlockRequestState is optional CreateContentBlockRequestState.
-type OptCreateContentBlockRequestState struct {
-	Value CreateContentBlockRequestState
+// OptScheduledMessagePayload is optional ScheduledMessagePayload.
+type OptScheduledMessagePayload struct {
+	Value ScheduledMessagePayload
 	Set   bool
 }
 
-// IsSet returns true if OptCreateContentBlockRequestState was set.
-func (o OptCreateContentBlockRequestState) IsSet() bool { return o.Set }
+// IsSet returns true if OptScheduledMessagePayload was set.
+func (o OptScheduledMessagePayload) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptCreateContentBlockRequestState) Reset() {
-	var v CreateContentBlockRequestState
+func (o *OptScheduledMessagePayload) Reset() {
+	var v ScheduledMessagePayload
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptCreateContentBlockRequestState) SetTo(v CreateContentBlockRequestState) {
+func (o *OptScheduledMessagePayload) SetTo(v ScheduledMessagePayload) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptCreateContentBlockRequestState) Get() (v CreateContentBlockRequestState, ok bool) {
+func (o OptScheduledMessagePayload) Get() (v ScheduledMessagePayload, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1214,45 +4508,45 @@ func (o OptCreateContentBlockRequestState) Get() (v CreateContentBlockRequestSta
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptCreateContentBlockRequestState) Or(d CreateContentBlockRequestState) CreateContentBlockRequestState {
+func (o OptScheduledMessagePayload) Or(d ScheduledMessagePayload) ScheduledMessagePayload {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
+// NewOptScheduledMessages returns new OptScheduledMessages with value set to v.
+func NewOptScheduledMessages(v ScheduledMessages) OptScheduledMessages {
+	return OptScheduledMessages{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
+// OptScheduledMessages is optional ScheduledMessages.
+type OptScheduledMessages struct {
+	Value ScheduledMessages
 	Set   bool
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// IsSet returns true if OptScheduledMessages was set.
+func (o OptScheduledMessages) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
+func (o *OptScheduledMessages) Reset() {
+	var v ScheduledMessages
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
+func (o *OptScheduledMessages) SetTo(v ScheduledMessages) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
+func (o OptScheduledMessages) Get() (v ScheduledMessages, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1260,45 +4554,45 @@ func (o OptDateTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
+func (o OptScheduledMessages) Or(d ScheduledMessages) ScheduledMessages {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptString is optional string.
+type OptString struct {
+	Value string
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptString) Reset() {
+	var v string
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptString) SetTo(v string) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptString) Get() (v string, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1306,62 +4600,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilBool returns new OptNilBool with value set to v.
-func NewOptNilBool(v bool) OptNilBool {
-	return OptNilBool{
+// NewOptTriggerProperties returns new OptTriggerProperties with value set to v.
+func NewOptTriggerProperties(v TriggerProperties) OptTriggerProperties {
+	return OptTriggerProperties{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilBool is optional nullable bool.
-type OptNilBool struct {
-	Value bool
+// OptTriggerProperties is optional TriggerProperties.
+type OptTriggerProperties struct {
+	Value TriggerProperties
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilBool was set.
-func (o OptNilBool) IsSet() bool { return o.Set }
+// IsSet returns true if OptTriggerProperties was set.
+func (o OptTriggerProperties) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilBool) Reset() {
-	var v bool
+func (o *OptTriggerProperties) Reset() {
+	var v TriggerProperties
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilBool) SetTo(v bool) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilBool) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilBool) SetToNull() {
+func (o *OptTriggerProperties) SetTo(v TriggerProperties) {
 	o.Set = true
-	o.Null = true
-	var v bool
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilBool) Get() (v bool, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptTriggerProperties) Get() (v TriggerProperties, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1369,62 +4646,45 @@ func (o OptNilBool) Get() (v bool, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilBool) Or(d bool) bool {
+func (o OptTriggerProperties) Or(d TriggerProperties) TriggerProperties {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilDateTime returns new OptNilDateTime with value set to v.
-func NewOptNilDateTime(v time.Time) OptNilDateTime {
-	return OptNilDateTime{
+// NewOptUpdateContentBlockRequestState returns new OptUpdateContentBlockRequestState with value set to v.
+func NewOptUpdateContentBlockRequestState(v UpdateContentBlockRequestState) OptUpdateContentBlockRequestState {
+	return OptUpdateContentBlockRequestState{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilDateTime is optional nullable time.Time.
-type OptNilDateTime struct {
-	Value time.Time
+// OptUpdateContentBlockRequestState is optional UpdateContentBlockRequestState.
+type OptUpdateContentBlockRequestState struct {
+	Value UpdateContentBlockRequestState
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilDateTime was set.
-func (o OptNilDateTime) IsSet() bool { return o.Set }
+// IsSet returns true if OptUpdateContentBlockRequestState was set.
+func (o OptUpdateContentBlockRequestState) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilDateTime) Reset() {
-	var v time.Time
+func (o *OptUpdateContentBlockRequestState) Reset() {
+	var v UpdateContentBlockRequestState
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilDateTime) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilDateTime) SetToNull() {
+func (o *OptUpdateContentBlockRequestState) SetTo(v UpdateContentBlockRequestState) {
 	o.Set = true
-	o.Null = true
-	var v time.Time
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilDateTime) Get() (v time.Time, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptUpdateContentBlockRequestState) Get() (v UpdateContentBlockRequestState, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1432,244 +4692,386 @@ func (o OptNilDateTime) Get() (v time.Time, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilDateTime) Or(d time.Time) time.Time {
+func (o OptUpdateContentBlockRequestState) Or(d UpdateContentBlockRequestState) UpdateContentBlockRequestState {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/PreferenceCenterLinkTag
+type PreferenceCenterLinkTag struct {
+	// The rel attribute of the link tag.
+	Rel string `json:"rel"`
+	// The type attribute of the link tag.
+	Type OptString `json:"type"`
+	// The href attribute of the link tag.
+	Href string `json:"href"`
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// GetRel returns the value of Rel.
+func (s *PreferenceCenterLinkTag) GetRel() string {
+	return s.Rel
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// GetType returns the value of Type.
+func (s *PreferenceCenterLinkTag) GetType() OptString {
+	return s.Type
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetHref returns the value of Href.
+func (s *PreferenceCenterLinkTag) GetHref() string {
+	return s.Href
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetRel sets the value of Rel.
+func (s *PreferenceCenterLinkTag) SetRel(val string) {
+	s.Rel = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// SetType sets the value of Type.
+func (s *PreferenceCenterLinkTag) SetType(val OptString) {
+	s.Type = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+// SetHref sets the value of Href.
+func (s *PreferenceCenterLinkTag) SetHref(val string) {
+	s.Href = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
+// Ref: #/PreferenceCenterOptions
+type PreferenceCenterOptions struct {
+	// The content of the viewport meta tag of the preference center page.
+	MetaViewportContent OptString `json:"meta-viewport-content"`
+	// Link tags to add to the preference center page, for example to load fonts.
+	LinksTags []PreferenceCenterLinkTag `json:"links-tags"`
+}
+
+// GetMetaViewportContent returns the value of MetaViewportContent.
+func (s *PreferenceCenterOptions) GetMetaViewportContent() OptString {
+	return s.MetaViewportContent
+}
+
+// GetLinksTags returns the value of LinksTags.
+func (s *PreferenceCenterOptions) GetLinksTags() []PreferenceCenterLinkTag {
+	return s.LinksTags
+}
+
+// SetMetaViewportContent sets the value of MetaViewportContent.
+func (s *PreferenceCenterOptions) SetMetaViewportContent(val OptString) {
+	s.MetaViewportContent = val
+}
+
+// SetLinksTags sets the value of LinksTags.
+func (s *PreferenceCenterOptions) SetLinksTags(val []PreferenceCenterLinkTag) {
+	s.LinksTags = val
+}
+
+// Whether the preference center is published.
+// Ref: #/PreferenceCenterState
+type PreferenceCenterState string
+
+const (
+	PreferenceCenterStateActive PreferenceCenterState = "active"
+	PreferenceCenterStateDraft  PreferenceCenterState = "draft"
+)
+
+// AllValues returns all PreferenceCenterState values.
+func (PreferenceCenterState) AllValues() []PreferenceCenterState {
+	return []PreferenceCenterState{
+		PreferenceCenterStateActive,
+		PreferenceCenterStateDraft,
 	}
-	if !o.Set {
-		return v, false
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PreferenceCenterState) MarshalText() ([]byte, error) {
+	switch s {
+	case PreferenceCenterStateActive:
+		return []byte(s), nil
+	case PreferenceCenterStateDraft:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PreferenceCenterState) UnmarshalText(data []byte) error {
+	switch PreferenceCenterState(data) {
+	case PreferenceCenterStateActive:
+		*s = PreferenceCenterStateActive
+		return nil
+	case PreferenceCenterStateDraft:
+		*s = PreferenceCenterStateDraft
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/ReplaceCatalogItemRequest
+type ReplaceCatalogItemRequest struct {
+	Items []CatalogItemWrite `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *ReplaceCatalogItemRequest) GetItems() []CatalogItemWrite {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *ReplaceCatalogItemRequest) SetItems(val []CatalogItemWrite) {
+	s.Items = val
+}
+
+// Ref: #/ScheduledMessagePayload
+type ScheduledMessagePayload map[string]jx.Raw
+
+func (s *ScheduledMessagePayload) init() ScheduledMessagePayload {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// The message payload for each channel to send through.
+// Ref: #/ScheduledMessages
+type ScheduledMessages struct {
+	AndroidPush OptScheduledMessagePayload `json:"android_push"`
+	ApplePush   OptScheduledMessagePayload `json:"apple_push"`
+	ContentCard OptScheduledMessagePayload `json:"content_card"`
+	Email       OptScheduledMessagePayload `json:"email"`
+	SMS         OptScheduledMessagePayload `json:"sms"`
+	WebPush     OptScheduledMessagePayload `json:"web_push"`
+	Webhook     OptScheduledMessagePayload `json:"webhook"`
+}
+
+// GetAndroidPush returns the value of AndroidPush.
+func (s *ScheduledMessages) GetAndroidPush() OptScheduledMessagePayload {
+	return s.AndroidPush
+}
+
+// GetApplePush returns the value of ApplePush.
+func (s *ScheduledMessages) GetApplePush() OptScheduledMessagePayload {
+	return s.ApplePush
+}
+
+// GetContentCard returns the value of ContentCard.
+func (s *ScheduledMessages) GetContentCard() OptScheduledMessagePayload {
+	return s.ContentCard
+}
+
+// GetEmail returns the value of Email.
+func (s *ScheduledMessages) GetEmail() OptScheduledMessagePayload {
+	return s.Email
+}
+
+// GetSMS returns the value of SMS.
+func (s *ScheduledMessages) GetSMS() OptScheduledMessagePayload {
+	return s.SMS
+}
+
+// GetWebPush returns the value of WebPush.
+func (s *ScheduledMessages) GetWebPush() OptScheduledMessagePayload {
+	return s.WebPush
+}
+
+// GetWebhook returns the value of Webhook.
+func (s *ScheduledMessages) GetWebhook() OptScheduledMessagePayload {
+	return s.Webhook
+}
+
+// SetAndroidPush sets the value of AndroidPush.
+func (s *ScheduledMessages) SetAndroidPush(val OptScheduledMessagePayload) {
+	s.AndroidPush = val
+}
+
+// SetApplePush sets the value of ApplePush.
+func (s *ScheduledMessages) SetApplePush(val OptScheduledMessagePayload) {
+	s.ApplePush = val
+}
+
+// SetContentCard sets the value of ContentCard.
+func (s *ScheduledMessages) SetContentCard(val OptScheduledMessagePayload) {
+	s.ContentCard = val
+}
+
+// SetEmail sets the value of Email.
+func (s *ScheduledMessages) SetEmail(val OptScheduledMessagePayload) {
+	s.Email = val
+}
+
+// SetSMS sets the value of SMS.
+func (s *ScheduledMessages) SetSMS(val OptScheduledMessagePayload) {
+	s.SMS = val
+}
+
+// SetWebPush sets the value of WebPush.
+func (s *ScheduledMessages) SetWebPush(val OptScheduledMessagePayload) {
+	s.WebPush = val
+}
+
+// SetWebhook sets the value of Webhook.
+func (s *ScheduledMessages) SetWebhook(val OptScheduledMessagePayload) {
+	s.Webhook = val
 }
 
-// NewOptNilStringArray returns new OptNilStringArray with value set to v.
-func NewOptNilStringArray(v []string) OptNilStringArray {
-	return OptNilStringArray{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/SearchDashboardUsersResponse
+type SearchDashboardUsersResponse struct {
+	Schemas []string `json:"schemas"`
+	// The number of dashboard users found.
+	TotalResults int             `json:"totalResults"`
+	Resources    []DashboardUser `json:"Resources"`
 }
 
-// OptNilStringArray is optional nullable []string.
-type OptNilStringArray struct {
-	Value []string
-	Set   bool
-	Null  bool
+// GetSchemas returns the value of Schemas.
+func (s *SearchDashboardUsersResponse) GetSchemas() []string {
+	return s.Schemas
 }
 
-// IsSet returns true if OptNilStringArray was set.
-func (o OptNilStringArray) IsSet() bool { return o.Set }
+// GetTotalResults returns the value of TotalResults.
+func (s *SearchDashboardUsersResponse) GetTotalResults() int {
+	return s.TotalResults
+}
 
-// Reset unsets value.
-func (o *OptNilStringArray) Reset() {
-	var v []string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetResources returns the value of Resources.
+func (s *SearchDashboardUsersResponse) GetResources() []DashboardUser {
+	return s.Resources
 }
 
-// SetTo sets value to v.
-func (o *OptNilStringArray) SetTo(v []string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetSchemas sets the value of Schemas.
+func (s *SearchDashboardUsersResponse) SetSchemas(val []string) {
+	s.Schemas = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilStringArray) IsNull() bool { return o.Null }
+// SetTotalResults sets the value of TotalResults.
+func (s *SearchDashboardUsersResponse) SetTotalResults(val int) {
+	s.TotalResults = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilStringArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []string
-	o.Value = v
+// SetResources sets the value of Resources.
+func (s *SearchDashboardUsersResponse) SetResources(val []DashboardUser) {
+	s.Resources = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilStringArray) Get() (v []string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+// Personalization key-value pairs for the message, available as api_trigger_properties in Liquid.
+// Ref: #/TriggerProperties
+type TriggerProperties map[string]jx.Raw
+
+func (s *TriggerProperties) init() TriggerProperties {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
-	return o.Value, true
+	return m
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilStringArray) Or(d []string) []string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/UpdateCampaignTriggerScheduleRequest
+type UpdateCampaignTriggerScheduleRequest struct {
+	CampaignID string          `json:"campaign_id"`
+	ScheduleID string          `json:"schedule_id"`
+	Schedule   MessageSchedule `json:"schedule"`
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetCampaignID returns the value of CampaignID.
+func (s *UpdateCampaignTriggerScheduleRequest) GetCampaignID() string {
+	return s.CampaignID
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetScheduleID returns the value of ScheduleID.
+func (s *UpdateCampaignTriggerScheduleRequest) GetScheduleID() string {
+	return s.ScheduleID
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetSchedule returns the value of Schedule.
+func (s *UpdateCampaignTriggerScheduleRequest) GetSchedule() MessageSchedule {
+	return s.Schedule
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetCampaignID sets the value of CampaignID.
+func (s *UpdateCampaignTriggerScheduleRequest) SetCampaignID(val string) {
+	s.CampaignID = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetScheduleID sets the value of ScheduleID.
+func (s *UpdateCampaignTriggerScheduleRequest) SetScheduleID(val string) {
+	s.ScheduleID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetSchedule sets the value of Schedule.
+func (s *UpdateCampaignTriggerScheduleRequest) SetSchedule(val MessageSchedule) {
+	s.Schedule = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/UpdateCampaignTriggerScheduleResponse
+type UpdateCampaignTriggerScheduleResponse struct {
+	Message OptString `json:"message"`
 }
 
-// NewOptUpdateContentBlockRequestState returns new OptUpdateContentBlockRequestState with value set to v.
-func NewOptUpdateContentBlockRequestState(v UpdateContentBlockRequestState) OptUpdateContentBlockRequestState {
-	return OptUpdateContentBlockRequestState{
-		Value: v,
-		Set:   true,
-	}
+// GetMessage returns the value of Message.
+func (s *UpdateCampaignTriggerScheduleResponse) GetMessage() OptString {
+	return s.Message
 }
 
-// OptUpdateContentBlockRequestState is optional UpdateContentBlockRequestState.
-type OptUpdateContentBlockRequestState struct {
-	Value UpdateContentBlockRequestState
-	Set   bool
+// SetMessage sets the value of Message.
+func (s *UpdateCampaignTriggerScheduleResponse) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// IsSet returns true if OptUpdateContentBlockRequestState was set.
-func (o OptUpdateContentBlockRequestState) IsSet() bool { return o.Set }
+// Ref: #/UpdateCanvasTriggerScheduleRequest
+type UpdateCanvasTriggerScheduleRequest struct {
+	CanvasID   string          `json:"canvas_id"`
+	ScheduleID string          `json:"schedule_id"`
+	Schedule   MessageSchedule `json:"schedule"`
+}
 
-// Reset unsets value.
-func (o *OptUpdateContentBlockRequestState) Reset() {
-	var v UpdateContentBlockRequestState
-	o.Value = v
-	o.Set = false
+// GetCanvasID returns the value of CanvasID.
+func (s *UpdateCanvasTriggerScheduleRequest) GetCanvasID() string {
+	return s.CanvasID
 }
 
-// SetTo sets value to v.
-func (o *OptUpdateContentBlockRequestState) SetTo(v UpdateContentBlockRequestState) {
-	o.Set = true
-	o.Value = v
+// GetScheduleID returns the value of ScheduleID.
+func (s *UpdateCanvasTriggerScheduleRequest) GetScheduleID() string {
+	return s.ScheduleID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUpdateContentBlockRequestState) Get() (v UpdateContentBlockRequestState, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetSchedule returns the value of Schedule.
+func (s *UpdateCanvasTriggerScheduleRequest) GetSchedule() MessageSchedule {
+	return s.Schedule
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUpdateContentBlockRequestState) Or(d UpdateContentBlockRequestState) UpdateContentBlockRequestState {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCanvasID sets the value of CanvasID.
+func (s *UpdateCanvasTriggerScheduleRequest) SetCanvasID(val string) {
+	s.CanvasID = val
 }
 
-// Ref: #/ReplaceCatalogItemRequest
-type ReplaceCatalogItemRequest struct {
-	Items []CatalogItemWrite `json:"items"`
+// SetScheduleID sets the value of ScheduleID.
+func (s *UpdateCanvasTriggerScheduleRequest) SetScheduleID(val string) {
+	s.ScheduleID = val
 }
 
-// GetItems returns the value of Items.
-func (s *ReplaceCatalogItemRequest) GetItems() []CatalogItemWrite {
-	return s.Items
+// SetSchedule sets the value of Schedule.
+func (s *UpdateCanvasTriggerScheduleRequest) SetSchedule(val MessageSchedule) {
+	s.Schedule = val
 }
 
-// SetItems sets the value of Items.
-func (s *ReplaceCatalogItemRequest) SetItems(val []CatalogItemWrite) {
-	s.Items = val
+// Ref: #/UpdateCanvasTriggerScheduleResponse
+type UpdateCanvasTriggerScheduleResponse struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UpdateCanvasTriggerScheduleResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UpdateCanvasTriggerScheduleResponse) SetMessage(val OptString) {
+	s.Message = val
 }
 
 type UpdateContentBlockCreated UpdateContentBlockResponse
@@ -1826,6 +5228,56 @@ func (s *UpdateContentBlockResponse) SetMessage(val string) {
 	s.Message = val
 }
 
+// Ref: #/UpdateDashboardUserRequest
+type UpdateDashboardUserRequest struct {
+	// The SCIM schemas of the request, urn:ietf:params:scim:schemas:core:2.0:User.
+	Schemas []string          `json:"schemas"`
+	Name    DashboardUserName `json:"name"`
+	// The department of the dashboard user.
+	Department  string                   `json:"department"`
+	Permissions DashboardUserPermissions `json:"permissions"`
+}
+
+// GetSchemas returns the value of Schemas.
+func (s *UpdateDashboardUserRequest) GetSchemas() []string {
+	return s.Schemas
+}
+
+// GetName returns the value of Name.
+func (s *UpdateDashboardUserRequest) GetName() DashboardUserName {
+	return s.Name
+}
+
+// GetDepartment returns the value of Department.
+func (s *UpdateDashboardUserRequest) GetDepartment() string {
+	return s.Department
+}
+
+// GetPermissions returns the value of Permissions.
+func (s *UpdateDashboardUserRequest) GetPermissions() DashboardUserPermissions {
+	return s.Permissions
+}
+
+// SetSchemas sets the value of Schemas.
+func (s *UpdateDashboardUserRequest) SetSchemas(val []string) {
+	s.Schemas = val
+}
+
+// SetName sets the value of Name.
+func (s *UpdateDashboardUserRequest) SetName(val DashboardUserName) {
+	s.Name = val
+}
+
+// SetDepartment sets the value of Department.
+func (s *UpdateDashboardUserRequest) SetDepartment(val string) {
+	s.Department = val
+}
+
+// SetPermissions sets the value of Permissions.
+func (s *UpdateDashboardUserRequest) SetPermissions(val DashboardUserPermissions) {
+	s.Permissions = val
+}
+
 // Ref: #/UpdateEmailTemplateRequest
 type UpdateEmailTemplateRequest struct {
 	// The Email Template identifier.
@@ -1952,3 +5404,178 @@ func (s *UpdateEmailTemplateResponse) SetEmailTemplateID(val string) {
 func (s *UpdateEmailTemplateResponse) SetMessage(val OptString) {
 	s.Message = val
 }
+
+// Ref: #/UpdatePreferenceCenterRequest
+type UpdatePreferenceCenterRequest struct {
+	// The title of the preference center and confirmation pages.
+	PreferenceCenterTitle OptString `json:"preference_center_title"`
+	// The HTML of the preference center page.
+	PreferenceCenterPageHTML string `json:"preference_center_page_html"`
+	// The HTML of the confirmation page.
+	ConfirmationPageHTML string                     `json:"confirmation_page_html"`
+	State                OptPreferenceCenterState   `json:"state"`
+	Options              OptPreferenceCenterOptions `json:"options"`
+}
+
+// GetPreferenceCenterTitle returns the value of PreferenceCenterTitle.
+func (s *UpdatePreferenceCenterRequest) GetPreferenceCenterTitle() OptString {
+	return s.PreferenceCenterTitle
+}
+
+// GetPreferenceCenterPageHTML returns the value of PreferenceCenterPageHTML.
+func (s *UpdatePreferenceCenterRequest) GetPreferenceCenterPageHTML() string {
+	return s.PreferenceCenterPageHTML
+}
+
+// GetConfirmationPageHTML returns the value of ConfirmationPageHTML.
+func (s *UpdatePreferenceCenterRequest) GetConfirmationPageHTML() string {
+	return s.ConfirmationPageHTML
+}
+
+// GetState returns the value of State.
+func (s *UpdatePreferenceCenterRequest) GetState() OptPreferenceCenterState {
+	return s.State
+}
+
+// GetOptions returns the value of Options.
+func (s *UpdatePreferenceCenterRequest) GetOptions() OptPreferenceCenterOptions {
+	return s.Options
+}
+
+// SetPreferenceCenterTitle sets the value of PreferenceCenterTitle.
+func (s *UpdatePreferenceCenterRequest) SetPreferenceCenterTitle(val OptString) {
+	s.PreferenceCenterTitle = val
+}
+
+// SetPreferenceCenterPageHTML sets the value of PreferenceCenterPageHTML.
+func (s *UpdatePreferenceCenterRequest) SetPreferenceCenterPageHTML(val string) {
+	s.PreferenceCenterPageHTML = val
+}
+
+// SetConfirmationPageHTML sets the value of ConfirmationPageHTML.
+func (s *UpdatePreferenceCenterRequest) SetConfirmationPageHTML(val string) {
+	s.ConfirmationPageHTML = val
+}
+
+// SetState sets the value of State.
+func (s *UpdatePreferenceCenterRequest) SetState(val OptPreferenceCenterState) {
+	s.State = val
+}
+
+// SetOptions sets the value of Options.
+func (s *UpdatePreferenceCenterRequest) SetOptions(val OptPreferenceCenterOptions) {
+	s.Options = val
+}
+
+// Ref: #/UpdatePreferenceCenterResponse
+type UpdatePreferenceCenterResponse struct {
+	// The preference center identifier.
+	PreferenceCenterAPIID string `json:"preference_center_api_id"`
+	// The Liquid tag that renders a link to the preference center.
+	LiquidTag OptString   `json:"liquid_tag"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+	Message   OptString   `json:"message"`
+}
+
+// GetPreferenceCenterAPIID returns the value of PreferenceCenterAPIID.
+func (s *UpdatePreferenceCenterResponse) GetPreferenceCenterAPIID() string {
+	return s.PreferenceCenterAPIID
+}
+
+// GetLiquidTag returns the value of LiquidTag.
+func (s *UpdatePreferenceCenterResponse) GetLiquidTag() OptString {
+	return s.LiquidTag
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UpdatePreferenceCenterResponse) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *UpdatePreferenceCenterResponse) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetMessage returns the value of Message.
+func (s *UpdatePreferenceCenterResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetPreferenceCenterAPIID sets the value of PreferenceCenterAPIID.
+func (s *UpdatePreferenceCenterResponse) SetPreferenceCenterAPIID(val string) {
+	s.PreferenceCenterAPIID = val
+}
+
+// SetLiquidTag sets the value of LiquidTag.
+func (s *UpdatePreferenceCenterResponse) SetLiquidTag(val OptString) {
+	s.LiquidTag = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *UpdatePreferenceCenterResponse) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *UpdatePreferenceCenterResponse) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetMessage sets the value of Message.
+func (s *UpdatePreferenceCenterResponse) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// Ref: #/UpdateScheduledMessageRequest
+type UpdateScheduledMessageRequest struct {
+	ScheduleID string               `json:"schedule_id"`
+	Schedule   OptMessageSchedule   `json:"schedule"`
+	Messages   OptScheduledMessages `json:"messages"`
+}
+
+// GetScheduleID returns the value of ScheduleID.
+func (s *UpdateScheduledMessageRequest) GetScheduleID() string {
+	return s.ScheduleID
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *UpdateScheduledMessageRequest) GetSchedule() OptMessageSchedule {
+	return s.Schedule
+}
+
+// GetMessages returns the value of Messages.
+func (s *UpdateScheduledMessageRequest) GetMessages() OptScheduledMessages {
+	return s.Messages
+}
+
+// SetScheduleID sets the value of ScheduleID.
+func (s *UpdateScheduledMessageRequest) SetScheduleID(val string) {
+	s.ScheduleID = val
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *UpdateScheduledMessageRequest) SetSchedule(val OptMessageSchedule) {
+	s.Schedule = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *UpdateScheduledMessageRequest) SetMessages(val OptScheduledMessages) {
+	s.Messages = val
+}
+
+// Ref: #/UpdateScheduledMessageResponse
+type UpdateScheduledMessageResponse struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UpdateScheduledMessageResponse) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UpdateScheduledMessageResponse) SetMessage(val OptString) {
+	s.Message = val
+}
@@ -15,6 +15,8 @@ import (
 type SecurityHandler interface {
 	// HandleBrazeApiKey handles brazeApiKey security.
 	HandleBrazeApiKey(ctx context.Context, operationName OperationName, t BrazeApiKey) (context.Context, error)
+	// HandleBrazeScimToken handles brazeScimToken security.
+	HandleBrazeScimToken(ctx context.Context, operationName OperationName, t BrazeScimToken) (context.Context, error)
 }
 
 func findAuthorization(h http.Header, prefix string) (string, bool) {
@@ -34,22 +36,123 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 
 // operationRolesBrazeApiKey is a private map storing roles per operation.
 var operationRolesBrazeApiKey = map[string][]string{
-	CreateCatalogOperation:        []string{},
-	CreateCatalogItemOperation:    []string{},
-	CreateContentBlockOperation:   []string{},
-	CreateEmailTemplateOperation:  []string{},
-	DeleteCatalogOperation:        []string{},
-	DeleteCatalogItemOperation:    []string{},
-	GetCatalogItemOperation:       []string{},
-	GetContentBlockInfoOperation:  []string{},
-	GetEmailTemplateInfoOperation: []string{},
-	ListCatalogItemsOperation:     []string{},
-	ListCatalogsOperation:         []string{},
-	ListContentBlocksOperation:    []string{},
-	ListEmailTemplatesOperation:   []string{},
-	ReplaceCatalogItemOperation:   []string{},
-	UpdateContentBlockOperation:   []string{},
-	UpdateEmailTemplateOperation:  []string{},
+	CreateCampaignTriggerScheduleOperation: []string{
+		"campaigns.trigger.schedule.create",
+	},
+	CreateCanvasTriggerScheduleOperation: []string{
+		"canvas.trigger.schedule.create",
+	},
+	CreateCatalogOperation: []string{
+		"catalogs.create",
+	},
+	CreateCatalogItemOperation: []string{
+		"catalogs.create_item",
+	},
+	CreateContentBlockOperation: []string{
+		"content_blocks.create",
+	},
+	CreateEmailTemplateOperation: []string{
+		"templates.email.create",
+	},
+	CreatePreferenceCenterOperation: []string{
+		"preference_center.update",
+	},
+	CreateScheduledMessageOperation: []string{
+		"messages.schedule.create",
+	},
+	CreateSendIDOperation: []string{
+		"sends.id.create",
+	},
+	DeleteCampaignTriggerScheduleOperation: []string{
+		"campaigns.trigger.schedule.delete",
+	},
+	DeleteCanvasTriggerScheduleOperation: []string{
+		"canvas.trigger.schedule.delete",
+	},
+	DeleteCatalogOperation: []string{
+		"catalogs.delete",
+	},
+	DeleteCatalogItemOperation: []string{
+		"catalogs.delete_item",
+	},
+	DeleteScheduledMessageOperation: []string{
+		"messages.schedule.delete",
+	},
+	GetCampaignDetailsOperation: []string{
+		"campaigns.details",
+	},
+	GetCanvasDetailsOperation: []string{
+		"canvas.details",
+	},
+	GetCatalogItemOperation: []string{
+		"catalogs.get_item",
+	},
+	GetContentBlockInfoOperation: []string{
+		"content_blocks.info",
+	},
+	GetEmailTemplateInfoOperation: []string{
+		"templates.email.info",
+	},
+	GetPreferenceCenterOperation: []string{
+		"preference_center.get",
+	},
+	GetSegmentDetailsOperation: []string{
+		"segments.details",
+	},
+	ListCampaignsOperation: []string{
+		"campaigns.list",
+	},
+	ListCanvasesOperation: []string{
+		"canvas.list",
+	},
+	ListCatalogItemsOperation: []string{
+		"catalogs.get_items",
+	},
+	ListCatalogsOperation: []string{
+		"catalogs.get",
+	},
+	ListContentBlocksOperation: []string{
+		"content_blocks.list",
+	},
+	ListCustomAttributesOperation: []string{
+		"custom_attributes.get",
+	},
+	ListCustomEventsOperation: []string{
+		"events.get",
+	},
+	ListEmailTemplatesOperation: []string{
+		"templates.email.list",
+	},
+	ListPreferenceCentersOperation: []string{
+		"preference_center.list",
+	},
+	ListProductsOperation: []string{
+		"purchases.product_list",
+	},
+	ListSegmentsOperation: []string{
+		"segments.list",
+	},
+	ReplaceCatalogItemOperation: []string{
+		"catalogs.replace_item",
+	},
+	UpdateCampaignTriggerScheduleOperation: []string{
+		"campaigns.trigger.schedule.update",
+	},
+	UpdateCanvasTriggerScheduleOperation: []string{
+		"canvas.trigger.schedule.update",
+	},
+	UpdateContentBlockOperation: []string{
+		"content_blocks.update",
+	},
+	UpdateEmailTemplateOperation: []string{
+		"templates.email.update",
+	},
+	UpdatePreferenceCenterOperation: []string{
+		"preference_center.update",
+	},
+	UpdateScheduledMessageOperation: []string{
+		"messages.schedule.update",
+	},
 }
 
 // GetRolesForBrazeApiKey returns the required roles for the given operation.
@@ -73,6 +176,36 @@ func GetRolesForBrazeApiKey(operation string) []string {
 	return result
 }
 
+// operationRolesBrazeScimToken is a private map storing roles per operation.
+var operationRolesBrazeScimToken = map[string][]string{
+	CreateDashboardUserOperation:  []string{},
+	DeleteDashboardUserOperation:  []string{},
+	GetDashboardUserOperation:     []string{},
+	SearchDashboardUsersOperation: []string{},
+	UpdateDashboardUserOperation:  []string{},
+}
+
+// GetRolesForBrazeScimToken returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForBrazeScimToken(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForBrazeScimToken(operation string) []string {
+	roles, ok := operationRolesBrazeScimToken[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
 func (s *Server) securityBrazeApiKey(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
 	var t BrazeApiKey
 	token, ok := findAuthorization(req.Header, "Bearer")
@@ -90,10 +223,29 @@ func (s *Server) securityBrazeApiKey(ctx context.Context, operationName Operatio
 	return rctx, true, err
 }
 
+func (s *Server) securityBrazeScimToken(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BrazeScimToken
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBrazeScimToken[operationName]
+	rctx, err := s.sec.HandleBrazeScimToken(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
 // SecuritySource is provider of security values (tokens, passwords, etc.).
 type SecuritySource interface {
 	// BrazeApiKey provides brazeApiKey security value.
 	BrazeApiKey(ctx context.Context, operationName OperationName, client *Client) (BrazeApiKey, error)
+	// BrazeScimToken provides brazeScimToken security value.
+	BrazeScimToken(ctx context.Context, operationName OperationName, client *Client) (BrazeScimToken, error)
 }
 
 func (s *Client) securityBrazeApiKey(ctx context.Context, operationName OperationName, req *http.Request) error {
@@ -104,3 +256,11 @@ func (s *Client) securityBrazeApiKey(ctx context.Context, operationName Operatio
 	req.Header.Set("Authorization", "Bearer "+t.Token)
 	return nil
 }
+func (s *Client) securityBrazeScimToken(ctx context.Context, operationName OperationName, req *http.Request) error {
+	t, err := s.sec.BrazeScimToken(ctx, operationName, s)
+	if err != nil {
+		return errors.Wrap(err, "security source \"BrazeScimToken\"")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+	return nil
+}
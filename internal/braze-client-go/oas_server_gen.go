@@ -8,6 +8,18 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// CreateCampaignTriggerSchedule implements createCampaignTriggerSchedule operation.
+	//
+	// Schedule an API-triggered campaign to be sent at a designated time.
+	//
+	// POST /campaigns/trigger/schedule/create
+	CreateCampaignTriggerSchedule(ctx context.Context, req *CreateCampaignTriggerScheduleRequest) (*CreateCampaignTriggerScheduleResponse, error)
+	// CreateCanvasTriggerSchedule implements createCanvasTriggerSchedule operation.
+	//
+	// Schedule an API-triggered Canvas to be sent at a designated time.
+	//
+	// POST /canvas/trigger/schedule/create
+	CreateCanvasTriggerSchedule(ctx context.Context, req *CreateCanvasTriggerScheduleRequest) (*CreateCanvasTriggerScheduleResponse, error)
 	// CreateCatalog implements createCatalog operation.
 	//
 	// Create catalog.
@@ -26,12 +38,48 @@ type Handler interface {
 	//
 	// POST /content_blocks/create
 	CreateContentBlock(ctx context.Context, req *CreateContentBlockRequest) (*CreateContentBlockResponse, error)
+	// CreateDashboardUser implements createDashboardUser operation.
+	//
+	// Create a dashboard user account with permissions in workspaces.
+	//
+	// POST /scim/v2/Users
+	CreateDashboardUser(ctx context.Context, req *CreateDashboardUserRequest, params CreateDashboardUserParams) (*DashboardUser, error)
 	// CreateEmailTemplate implements createEmailTemplate operation.
 	//
 	// Create an Email Template on the Braze dashboard.
 	//
 	// POST /templates/email/create
 	CreateEmailTemplate(ctx context.Context, req *CreateEmailTemplateRequest) (*CreateEmailTemplateResponse, error)
+	// CreatePreferenceCenter implements createPreferenceCenter operation.
+	//
+	// Create a preference center to manage how users subscribe to your messaging.
+	//
+	// POST /preference_center/v1
+	CreatePreferenceCenter(ctx context.Context, req *CreatePreferenceCenterRequest) (*CreatePreferenceCenterResponse, error)
+	// CreateScheduledMessage implements createScheduledMessage operation.
+	//
+	// Schedule a message to be sent at a designated time.
+	//
+	// POST /messages/schedule/create
+	CreateScheduledMessage(ctx context.Context, req *CreateScheduledMessageRequest) (*CreateScheduledMessageResponse, error)
+	// CreateSendID implements createSendID operation.
+	//
+	// Create a send identifier for a campaign, to track the analytics of individual sends.
+	//
+	// POST /sends/id/create
+	CreateSendID(ctx context.Context, req *CreateSendIDRequest) (*CreateSendIDResponse, error)
+	// DeleteCampaignTriggerSchedule implements deleteCampaignTriggerSchedule operation.
+	//
+	// Cancel a scheduled API-triggered campaign before it is sent.
+	//
+	// POST /campaigns/trigger/schedule/delete
+	DeleteCampaignTriggerSchedule(ctx context.Context, req *DeleteCampaignTriggerScheduleRequest) (*DeleteCampaignTriggerScheduleResponse, error)
+	// DeleteCanvasTriggerSchedule implements deleteCanvasTriggerSchedule operation.
+	//
+	// Cancel a scheduled API-triggered Canvas before it is sent.
+	//
+	// POST /canvas/trigger/schedule/delete
+	DeleteCanvasTriggerSchedule(ctx context.Context, req *DeleteCanvasTriggerScheduleRequest) (*DeleteCanvasTriggerScheduleResponse, error)
 	// DeleteCatalog implements deleteCatalog operation.
 	//
 	// Delete catalog.
@@ -44,6 +92,30 @@ type Handler interface {
 	//
 	// DELETE /catalogs/{catalog_name}/items/{item_id}
 	DeleteCatalogItem(ctx context.Context, params DeleteCatalogItemParams) (*DeleteCatalogItemResponse, error)
+	// DeleteDashboardUser implements deleteDashboardUser operation.
+	//
+	// Permanently delete a dashboard user account.
+	//
+	// DELETE /scim/v2/Users/{id}
+	DeleteDashboardUser(ctx context.Context, params DeleteDashboardUserParams) error
+	// DeleteScheduledMessage implements deleteScheduledMessage operation.
+	//
+	// Cancel a scheduled message before it is sent.
+	//
+	// POST /messages/schedule/delete
+	DeleteScheduledMessage(ctx context.Context, req *DeleteScheduledMessageRequest) (*DeleteScheduledMessageResponse, error)
+	// GetCampaignDetails implements getCampaignDetails operation.
+	//
+	// Retrieve the details of a campaign.
+	//
+	// GET /campaigns/details
+	GetCampaignDetails(ctx context.Context, params GetCampaignDetailsParams) (*GetCampaignDetailsResponse, error)
+	// GetCanvasDetails implements getCanvasDetails operation.
+	//
+	// Retrieve the details of a Canvas.
+	//
+	// GET /canvas/details
+	GetCanvasDetails(ctx context.Context, params GetCanvasDetailsParams) (*GetCanvasDetailsResponse, error)
 	// GetCatalogItem implements getCatalogItem operation.
 	//
 	// Get catalog item.
@@ -56,12 +128,42 @@ type Handler interface {
 	//
 	// GET /content_blocks/info
 	GetContentBlockInfo(ctx context.Context, params GetContentBlockInfoParams) (*GetContentBlockInfoResponse, error)
+	// GetDashboardUser implements getDashboardUser operation.
+	//
+	// Return a dashboard user account by its SCIM identifier.
+	//
+	// GET /scim/v2/Users/{id}
+	GetDashboardUser(ctx context.Context, params GetDashboardUserParams) (*DashboardUser, error)
 	// GetEmailTemplateInfo implements getEmailTemplateInfo operation.
 	//
 	// Call information for your existing Email Templates.
 	//
 	// GET /templates/email/info
 	GetEmailTemplateInfo(ctx context.Context, params GetEmailTemplateInfoParams) (*GetEmailTemplateInfoResponse, error)
+	// GetPreferenceCenter implements getPreferenceCenter operation.
+	//
+	// View the details for a preference center, including its HTML.
+	//
+	// GET /preference_center/v1/{preference_center_external_id}
+	GetPreferenceCenter(ctx context.Context, params GetPreferenceCenterParams) (*GetPreferenceCenterResponse, error)
+	// GetSegmentDetails implements getSegmentDetails operation.
+	//
+	// Retrieve the details of a segment.
+	//
+	// GET /segments/details
+	GetSegmentDetails(ctx context.Context, params GetSegmentDetailsParams) (*GetSegmentDetailsResponse, error)
+	// ListCampaigns implements listCampaigns operation.
+	//
+	// List your campaigns, including their tags and whether they are API campaigns.
+	//
+	// GET /campaigns/list
+	ListCampaigns(ctx context.Context, params ListCampaignsParams) (*ListCampaignsResponse, error)
+	// ListCanvases implements listCanvases operation.
+	//
+	// List your Canvases, including their tags.
+	//
+	// GET /canvas/list
+	ListCanvases(ctx context.Context, params ListCanvasesParams) (*ListCanvasesResponse, error)
 	// ListCatalogItems implements listCatalogItems operation.
 	//
 	// List catalog items.
@@ -73,37 +175,104 @@ type Handler interface {
 	// List catalogs.
 	//
 	// GET /catalogs
-	ListCatalogs(ctx context.Context) (*ListCatalogsResponse, error)
+	ListCatalogs(ctx context.Context, params ListCatalogsParams) (*ListCatalogsResponseHeaders, error)
 	// ListContentBlocks implements listContentBlocks operation.
 	//
 	// List your existing Content Blocks information.
 	//
 	// GET /content_blocks/list
 	ListContentBlocks(ctx context.Context, params ListContentBlocksParams) (*ListContentBlocksResponse, error)
+	// ListCustomAttributes implements listCustomAttributes operation.
+	//
+	// List the custom attributes recorded in the workspace, with their data types, descriptions, tags
+	// and status.
+	//
+	// GET /custom_attributes
+	ListCustomAttributes(ctx context.Context, params ListCustomAttributesParams) (*ListCustomAttributesResponseHeaders, error)
+	// ListCustomEvents implements listCustomEvents operation.
+	//
+	// List the custom events recorded in the workspace, with their descriptions, tags and status.
+	//
+	// GET /events
+	ListCustomEvents(ctx context.Context, params ListCustomEventsParams) (*ListCustomEventsResponseHeaders, error)
 	// ListEmailTemplates implements listEmailTemplates operation.
 	//
 	// List your existing Email Templates information.
 	//
 	// GET /templates/email/list
 	ListEmailTemplates(ctx context.Context, params ListEmailTemplatesParams) (*ListEmailTemplatesResponse, error)
+	// ListPreferenceCenters implements listPreferenceCenters operation.
+	//
+	// List your available preference centers.
+	//
+	// GET /preference_center/v1/list
+	ListPreferenceCenters(ctx context.Context) (*ListPreferenceCentersResponse, error)
+	// ListProducts implements listProducts operation.
+	//
+	// List the product identifiers of purchases logged in the workspace.
+	//
+	// GET /purchases/product_list
+	ListProducts(ctx context.Context, params ListProductsParams) (*ListProductsResponse, error)
+	// ListSegments implements listSegments operation.
+	//
+	// List your segments, including their tags and whether analytics tracking is enabled.
+	//
+	// GET /segments/list
+	ListSegments(ctx context.Context, params ListSegmentsParams) (*ListSegmentsResponse, error)
 	// ReplaceCatalogItem implements replaceCatalogItem operation.
 	//
 	// Replace catalog item.
 	//
 	// PUT /catalogs/{catalog_name}/items/{item_id}
 	ReplaceCatalogItem(ctx context.Context, req *ReplaceCatalogItemRequest, params ReplaceCatalogItemParams) (*CatalogItemOperationResponse, error)
+	// SearchDashboardUsers implements searchDashboardUsers operation.
+	//
+	// Look up dashboard users matching a SCIM filter on userName.
+	//
+	// GET /scim/v2/Users
+	SearchDashboardUsers(ctx context.Context, params SearchDashboardUsersParams) (*SearchDashboardUsersResponse, error)
+	// UpdateCampaignTriggerSchedule implements updateCampaignTriggerSchedule operation.
+	//
+	// Update the schedule of an API-triggered campaign.
+	//
+	// POST /campaigns/trigger/schedule/update
+	UpdateCampaignTriggerSchedule(ctx context.Context, req *UpdateCampaignTriggerScheduleRequest) (*UpdateCampaignTriggerScheduleResponse, error)
+	// UpdateCanvasTriggerSchedule implements updateCanvasTriggerSchedule operation.
+	//
+	// Update the schedule of an API-triggered Canvas.
+	//
+	// POST /canvas/trigger/schedule/update
+	UpdateCanvasTriggerSchedule(ctx context.Context, req *UpdateCanvasTriggerScheduleRequest) (*UpdateCanvasTriggerScheduleResponse, error)
 	// UpdateContentBlock implements updateContentBlock operation.
 	//
 	// Update a Content Block on the Braze dashboard.
 	//
 	// POST /content_blocks/update
 	UpdateContentBlock(ctx context.Context, req *UpdateContentBlockRequest) (UpdateContentBlockRes, error)
+	// UpdateDashboardUser implements updateDashboardUser operation.
+	//
+	// Replace the name, department and permissions of a dashboard user account.
+	//
+	// PUT /scim/v2/Users/{id}
+	UpdateDashboardUser(ctx context.Context, req *UpdateDashboardUserRequest, params UpdateDashboardUserParams) (*DashboardUser, error)
 	// UpdateEmailTemplate implements updateEmailTemplate operation.
 	//
 	// Update an Email Template on the Braze dashboard.
 	//
 	// POST /templates/email/update
 	UpdateEmailTemplate(ctx context.Context, req *UpdateEmailTemplateRequest) (*UpdateEmailTemplateResponse, error)
+	// UpdatePreferenceCenter implements updatePreferenceCenter operation.
+	//
+	// Update an existing preference center.
+	//
+	// PUT /preference_center/v1/{preference_center_external_id}
+	UpdatePreferenceCenter(ctx context.Context, req *UpdatePreferenceCenterRequest, params UpdatePreferenceCenterParams) (*UpdatePreferenceCenterResponse, error)
+	// UpdateScheduledMessage implements updateScheduledMessage operation.
+	//
+	// Update the schedule or messages of a scheduled message.
+	//
+	// POST /messages/schedule/update
+	UpdateScheduledMessage(ctx context.Context, req *UpdateScheduledMessageRequest) (*UpdateScheduledMessageResponse, error)
 	// NewError creates *ErrorResponseStatusCode from error returned by handler.
 	//
 	// Used for common default response.
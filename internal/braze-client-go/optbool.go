@@ -0,0 +1,18 @@
+package brazeclient
+
+func NewOptPointerBool(v *bool) OptBool {
+	o := OptBool{}
+	if v != nil {
+		o.SetTo(*v)
+	}
+
+	return o
+}
+
+func (o OptBool) GetPointer() *bool {
+	if !o.Set {
+		return nil
+	}
+
+	return &o.Value
+}
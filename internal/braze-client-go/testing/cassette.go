@@ -0,0 +1,296 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sync"
+)
+
+const (
+	cassetteFileMode      = 0o600
+	cassetteDirectoryMode = 0o755
+	cassetteRedacted      = "REDACTED"
+)
+
+var errCassetteInteractionNotFound = errors.New("no recorded interaction matches request")
+
+// cassetteRedactedHeaders are headers whose values are replaced before an
+// interaction is written, so that cassettes never contain credentials or the
+// SCIM request origin of the workspace.
+//
+//nolint:gochecknoglobals
+var cassetteRedactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Request-Origin"}
+
+// cassetteRedactedQueryParameterPattern matches the names of query parameters
+// whose values are replaced before an interaction is written, as some
+// endpoints accept keys and tokens in the query.
+//
+//nolint:gochecknoglobals
+var cassetteRedactedQueryParameterPattern = regexp.MustCompile(`(?i)key|token|secret`)
+
+// Cassette is a recording of HTTP interactions with Braze.
+type Cassette struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest identifies a request by method, path with query, and body.
+// The host is not recorded so that a cassette can be replayed against any
+// base URL.
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// RecordingTransport passes requests through to the next transport and
+// records each request and response, with credentials redacted.
+type RecordingTransport struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+func NewRecordingTransport(next http.RoundTripper) *RecordingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &RecordingTransport{next: next}
+}
+
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readAndRestoreBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		//nolint:wrapcheck
+		return resp, err
+	}
+
+	responseBody, err := readAndRestoreBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cassette.Interactions = append(t.cassette.Interactions, CassetteInteraction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			URL:    redactCassetteURL(req.URL),
+			Header: redactCassetteHeader(req.Header),
+			Body:   string(requestBody),
+		},
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactCassetteHeader(resp.Header),
+			Body:       string(responseBody),
+		},
+	})
+
+	return resp, nil
+}
+
+// Save writes the recorded interactions to path as JSON.
+func (t *RecordingTransport) Save(path string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	data, err := json.MarshalIndent(t.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal cassette: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), cassetteDirectoryMode)
+	if err != nil {
+		return fmt.Errorf("create cassette directory: %w", err)
+	}
+
+	err = os.WriteFile(path, data, cassetteFileMode)
+	if err != nil {
+		return fmt.Errorf("write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// ReplayingTransport answers requests from a cassette without making any
+// network requests. Each recorded interaction is used once, in recorded order
+// among the interactions that match a request.
+type ReplayingTransport struct {
+	mu           sync.Mutex
+	interactions []CassetteInteraction
+	used         []bool
+}
+
+func LoadReplayingTransport(path string) (*ReplayingTransport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read cassette: %w", err)
+	}
+
+	var cassette Cassette
+
+	err = json.Unmarshal(data, &cassette)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal cassette: %w", err)
+	}
+
+	return &ReplayingTransport{
+		interactions: cassette.Interactions,
+		used:         make([]bool, len(cassette.Interactions)),
+	}, nil
+}
+
+func (t *ReplayingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readAndRestoreBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, interaction := range t.interactions {
+		if t.used[i] || !interaction.Request.matches(req, requestBody) {
+			continue
+		}
+
+		t.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewBufferString(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", errCassetteInteractionNotFound, req.Method, req.URL.RequestURI())
+}
+
+// Unused returns the number of recorded interactions that have not been
+// replayed.
+func (t *ReplayingTransport) Unused() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	unused := 0
+
+	for _, used := range t.used {
+		if !used {
+			unused++
+		}
+	}
+
+	return unused
+}
+
+func (r CassetteRequest) matches(req *http.Request, body []byte) bool {
+	if r.Method != req.Method || r.URL != redactCassetteURL(req.URL) {
+		return false
+	}
+
+	if r.Body == string(body) {
+		return true
+	}
+
+	return jsonEqual([]byte(r.Body), body)
+}
+
+func jsonEqual(a, b []byte) bool {
+	var av, bv any
+
+	if json.Unmarshal(a, &av) != nil || json.Unmarshal(b, &bv) != nil {
+		return false
+	}
+
+	ac, errA := json.Marshal(av)
+	bc, errB := json.Marshal(bv)
+
+	return errA == nil && errB == nil && bytes.Equal(ac, bc)
+}
+
+func redactCassetteHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+
+	for _, name := range cassetteRedactedHeaders {
+		if values := redacted.Values(name); len(values) > 0 {
+			redacted[http.CanonicalHeaderKey(name)] = slices.Repeat([]string{cassetteRedacted}, len(values))
+		}
+	}
+
+	return redacted
+}
+
+// redactCassetteURL returns the path and query of u as recorded, with the
+// values of key-like query parameters replaced. Requests are matched against
+// the same form, so that a redacted recording still replays.
+func redactCassetteURL(u *url.URL) string {
+	query := u.Query()
+	redacted := false
+
+	for name, values := range query {
+		if cassetteRedactedQueryParameterPattern.MatchString(name) {
+			query[name] = slices.Repeat([]string{cassetteRedacted}, len(values))
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return u.RequestURI()
+	}
+
+	return u.EscapedPath() + "?" + query.Encode()
+}
+
+func readAndRestoreBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	err = (*body).Close()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
@@ -0,0 +1,98 @@
+package testing_test
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	brazetesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+)
+
+func TestRecordingAndReplayingTransport(t *testing.T) {
+	t.Parallel()
+
+	server, ts := newFaultTestServer(t)
+	server.SetContentBlock("cb-1", "greeting", "Hello", "", nil)
+
+	recorder := brazetesting.NewRecordingTransport(nil)
+	recordingClient := &http.Client{Transport: recorder}
+
+	recorded := doCassetteRequest(t, recordingClient, ts.URL+"/content_blocks/info?content_block_id=cb-1&api_key=secret-query-key", "secret-api-key")
+
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "test.json")
+
+	err := recorder.Save(cassettePath)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	cassette, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("os.ReadFile() error = %v", err)
+	}
+
+	for _, secret := range []string{"secret-api-key", "secret-origin", "secret-query-key"} {
+		if strings.Contains(string(cassette), secret) {
+			t.Errorf("expected %s to be redacted from cassette", secret)
+		}
+	}
+
+	replayer, err := brazetesting.LoadReplayingTransport(cassettePath)
+	if err != nil {
+		t.Fatalf("LoadReplayingTransport() error = %v", err)
+	}
+
+	replayingClient := &http.Client{Transport: replayer}
+
+	replayed := doCassetteRequest(t, replayingClient, "https://braze.invalid/content_blocks/info?content_block_id=cb-1&api_key=other-query-key", "other-api-key")
+	if replayed != recorded {
+		t.Errorf("expected replayed body %q, got %q", recorded, replayed)
+	}
+
+	if unused := replayer.Unused(); unused != 0 {
+		t.Errorf("expected all interactions to be replayed, %d unused", unused)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://braze.invalid/content_blocks/info?content_block_id=cb-1&api_key=other-query-key", nil)
+	if err != nil {
+		t.Fatalf("http.NewRequestWithContext() error = %v", err)
+	}
+
+	resp, err := replayingClient.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Error("expected an error once the recorded interaction has been used")
+	}
+}
+
+func doCassetteRequest(t *testing.T, client *http.Client, url, apiKey string) string {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("http.NewRequestWithContext() error = %v", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	req.Header.Set("X-Request-Origin", "secret-origin")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("client.Do() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("io.ReadAll() error = %v", err)
+	}
+
+	return string(body)
+}
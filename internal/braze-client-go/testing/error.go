@@ -1,8 +1,12 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/go-faster/jx"
 )
 
 var errNotFound = newStatusCodeError(http.StatusNotFound)
@@ -22,3 +26,53 @@ func newStatusCodeError(statusCode int) statusCodeError {
 func (e statusCodeError) Error() string {
 	return fmt.Sprintf("error: %d", e.StatusCode)
 }
+
+// brazeError is an error returned with a response body shaped like the ones
+// Braze returns for the same failure.
+type brazeError struct {
+	StatusCode int
+	Message    string
+	Errors     []brazeclient.ErrorResponseErrorsItem
+}
+
+var _ error = (*brazeError)(nil)
+
+// newBrazeMessageError returns a 400 Bad Request with a message, as returned
+// by the content block and email template endpoints.
+func newBrazeMessageError(message string) brazeError {
+	return brazeError{
+		StatusCode: http.StatusBadRequest,
+		Message:    message,
+	}
+}
+
+// newBrazeCatalogError returns an error with the detailed body returned by
+// the catalog endpoints.
+func newBrazeCatalogError(statusCode int, id, message, parameter string, value any) brazeError {
+	detail := brazeclient.ErrorDetail{
+		ID:      id,
+		Message: message,
+	}
+
+	if parameter != "" {
+		detail.Parameters = []string{parameter}
+
+		if raw, err := json.Marshal(value); err == nil {
+			detail.ParameterValues = []jx.Raw{raw}
+		}
+	}
+
+	return brazeError{
+		StatusCode: statusCode,
+		Message:    "Invalid Request",
+		Errors:     []brazeclient.ErrorResponseErrorsItem{brazeclient.NewErrorDetailErrorResponseErrorsItem(detail)},
+	}
+}
+
+func (e brazeError) Error() string {
+	if len(e.Errors) > 0 && e.Errors[0].IsErrorDetail() {
+		return e.Errors[0].ErrorDetail.ID + ": " + e.Errors[0].ErrorDetail.Message
+	}
+
+	return e.Message
+}
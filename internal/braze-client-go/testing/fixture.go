@@ -0,0 +1,504 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/ghodss/yaml"
+)
+
+// Fixture is a serialisable description of the objects held by a Server. It
+// is used to seed a server with content and to persist its state.
+type Fixture struct {
+	ContentBlocks  []FixtureContentBlock  `json:"content_blocks,omitempty"`
+	EmailTemplates []FixtureEmailTemplate `json:"email_templates,omitempty"`
+	Catalogs       []FixtureCatalog       `json:"catalogs,omitempty"`
+
+	PreferenceCenters []FixturePreferenceCenter `json:"preference_centers,omitempty"`
+	DashboardUsers    []FixtureDashboardUser    `json:"dashboard_users,omitempty"`
+
+	Campaigns []FixtureMessagingObject `json:"campaigns,omitempty"`
+	Canvases  []FixtureMessagingObject `json:"canvases,omitempty"`
+	Segments  []FixtureSegment         `json:"segments,omitempty"`
+
+	CustomAttributes []FixtureCustomAttribute `json:"custom_attributes,omitempty"`
+	CustomEvents     []FixtureCustomEvent     `json:"custom_events,omitempty"`
+	Products         []string                 `json:"products,omitempty"`
+
+	ScheduledMessages        []FixtureScheduledMessage        `json:"scheduled_messages,omitempty"`
+	CampaignTriggerSchedules []FixtureCampaignTriggerSchedule `json:"campaign_trigger_schedules,omitempty"`
+	CanvasTriggerSchedules   []FixtureCanvasTriggerSchedule   `json:"canvas_trigger_schedules,omitempty"`
+	SendIDs                  []FixtureSendID                  `json:"send_ids,omitempty"`
+}
+
+type FixtureContentBlock struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Content     string   `json:"content"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+type FixtureEmailTemplate struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Subject         string   `json:"subject,omitempty"`
+	Body            string   `json:"body,omitempty"`
+	PlaintextBody   string   `json:"plaintext_body,omitempty"`
+	Preheader       string   `json:"preheader,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	ShouldInlineCSS *bool    `json:"should_inline_css,omitempty"`
+}
+
+type FixtureCatalog struct {
+	Name        string                     `json:"name"`
+	Description string                     `json:"description,omitempty"`
+	Fields      []brazeclient.CatalogField `json:"fields"`
+	Items       []FixtureCatalogItem       `json:"items,omitempty"`
+}
+
+type FixtureCatalogItem struct {
+	ID     string                     `json:"id"`
+	Fields map[string]json.RawMessage `json:"fields,omitempty"`
+}
+
+type FixturePreferenceCenter struct {
+	ID                   string                           `json:"id"`
+	Name                 string                           `json:"name"`
+	Title                string                           `json:"title,omitempty"`
+	PageHTML             string                           `json:"page_html"`
+	ConfirmationPageHTML string                           `json:"confirmation_page_html"`
+	State                string                           `json:"state,omitempty"`
+	MetaViewportContent  string                           `json:"meta_viewport_content,omitempty"`
+	LinksTags            []FixturePreferenceCenterLinkTag `json:"links_tags,omitempty"`
+}
+
+type FixturePreferenceCenterLinkTag struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href"`
+}
+
+type FixtureDashboardUser struct {
+	ID          string                                `json:"id"`
+	Email       string                                `json:"email"`
+	GivenName   string                                `json:"given_name"`
+	FamilyName  string                                `json:"family_name"`
+	Department  string                                `json:"department"`
+	Permissions *brazeclient.DashboardUserPermissions `json:"permissions,omitempty"`
+}
+
+// FixtureMessagingObject describes a campaign or Canvas. Campaigns and
+// Canvases are read-only through the REST API, so fixtures are the only way
+// to create them.
+type FixtureMessagingObject struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Tags         []string `json:"tags,omitempty"`
+	ScheduleType string   `json:"schedule_type,omitempty"`
+	Archived     bool     `json:"archived,omitempty"`
+}
+
+// FixtureSegment describes a segment. Like campaigns and Canvases, segments
+// are read-only through the REST API.
+type FixtureSegment struct {
+	ID                       string   `json:"id"`
+	Name                     string   `json:"name"`
+	Description              string   `json:"description,omitempty"`
+	Tags                     []string `json:"tags,omitempty"`
+	AnalyticsTrackingEnabled bool     `json:"analytics_tracking_enabled,omitempty"`
+}
+
+// FixtureCustomAttribute describes a custom attribute. Braze records custom
+// attributes as users are tracked, so fixtures are the only way to create
+// them.
+type FixtureCustomAttribute struct {
+	Name        string   `json:"name"`
+	DataType    string   `json:"data_type,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Status      string   `json:"status,omitempty"`
+}
+
+// FixtureCustomEvent describes a custom event. Like custom attributes,
+// custom events are recorded as users are tracked.
+type FixtureCustomEvent struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Status      string   `json:"status,omitempty"`
+}
+
+// FixtureScheduledMessage describes a message scheduled through the API, as
+// the request that scheduled it.
+type FixtureScheduledMessage struct {
+	ID      string                                    `json:"id"`
+	Request brazeclient.CreateScheduledMessageRequest `json:"request"`
+}
+
+// FixtureCampaignTriggerSchedule describes a schedule for an API-triggered
+// campaign, as the request that created it.
+type FixtureCampaignTriggerSchedule struct {
+	ID      string                                           `json:"id"`
+	Request brazeclient.CreateCampaignTriggerScheduleRequest `json:"request"`
+}
+
+// FixtureCanvasTriggerSchedule describes a schedule for an API-triggered
+// Canvas, as the request that created it.
+type FixtureCanvasTriggerSchedule struct {
+	ID      string                                         `json:"id"`
+	Request brazeclient.CreateCanvasTriggerScheduleRequest `json:"request"`
+}
+
+// FixtureSendID describes a send identifier of a campaign.
+type FixtureSendID struct {
+	CampaignID string `json:"campaign_id"`
+	SendID     string `json:"send_id"`
+}
+
+// ParseFixture parses a fixture from JSON or YAML.
+func ParseFixture(data []byte) (Fixture, error) {
+	var fixture Fixture
+
+	err := yaml.Unmarshal(data, &fixture)
+	if err != nil {
+		return Fixture{}, fmt.Errorf("parse fixture: %w", err)
+	}
+
+	return fixture, nil
+}
+
+// MarshalFixtureYAML renders a fixture as YAML.
+func MarshalFixtureYAML(fixture Fixture) ([]byte, error) {
+	data, err := yaml.Marshal(fixture)
+	if err != nil {
+		return nil, fmt.Errorf("marshal fixture: %w", err)
+	}
+
+	return data, nil
+}
+
+// LoadFixture adds the objects described by fixture to the server, replacing
+// any existing objects with the same identifiers.
+func (s *Server) LoadFixture(fixture Fixture) {
+	for _, block := range fixture.ContentBlocks {
+		s.SetContentBlock(block.ID, block.Name, block.Content, block.Description, block.Tags)
+	}
+
+	for _, template := range fixture.EmailTemplates {
+		s.SetEmailTemplate(template.ID, template.Name, template.Subject, template.Body, template.PlaintextBody, template.Preheader, template.Tags, template.ShouldInlineCSS)
+	}
+
+	for _, catalog := range fixture.Catalogs {
+		s.SetCatalog(catalog.Name, catalog.Description, catalog.Fields)
+
+		for _, item := range catalog.Items {
+			s.SetCatalogItem(catalog.Name, item.ID, item.Fields)
+		}
+	}
+
+	for _, preferenceCenter := range fixture.PreferenceCenters {
+		state := brazeclient.PreferenceCenterState(preferenceCenter.State)
+		if state == "" {
+			state = brazeclient.PreferenceCenterStateActive
+		}
+
+		s.SetPreferenceCenter(
+			preferenceCenter.ID,
+			preferenceCenter.Name,
+			preferenceCenter.Title,
+			preferenceCenter.PageHTML,
+			preferenceCenter.ConfirmationPageHTML,
+			state,
+			preferenceCenter.options(),
+		)
+	}
+
+	for _, user := range fixture.DashboardUsers {
+		var permissions brazeclient.DashboardUserPermissions
+		if user.Permissions != nil {
+			permissions = *user.Permissions
+		}
+
+		s.SetDashboardUser(user.ID, user.Email, user.GivenName, user.FamilyName, user.Department, permissions)
+	}
+
+	for _, campaign := range fixture.Campaigns {
+		s.SetCampaign(campaign.ID, campaign.Name, campaign.Tags, campaign.ScheduleType, campaign.Archived)
+	}
+
+	for _, canvas := range fixture.Canvases {
+		s.SetCanvas(canvas.ID, canvas.Name, canvas.Tags, canvas.ScheduleType, canvas.Archived)
+	}
+
+	for _, segment := range fixture.Segments {
+		s.SetSegment(segment.ID, segment.Name, segment.Description, segment.Tags, segment.AnalyticsTrackingEnabled)
+	}
+
+	for _, attribute := range fixture.CustomAttributes {
+		s.SetCustomAttribute(attribute.Name, attribute.DataType, attribute.Description, attribute.Tags, attribute.Status)
+	}
+
+	for _, event := range fixture.CustomEvents {
+		s.SetCustomEvent(event.Name, event.Description, event.Tags, event.Status)
+	}
+
+	for _, productID := range fixture.Products {
+		s.SetProduct(productID)
+	}
+
+	for _, message := range fixture.ScheduledMessages {
+		s.SetScheduledMessage(message.ID, message.Request)
+	}
+
+	for _, schedule := range fixture.CampaignTriggerSchedules {
+		s.SetCampaignTriggerSchedule(schedule.ID, schedule.Request)
+	}
+
+	for _, schedule := range fixture.CanvasTriggerSchedules {
+		s.SetCanvasTriggerSchedule(schedule.ID, schedule.Request)
+	}
+
+	for _, sendID := range fixture.SendIDs {
+		s.SetSendID(sendID.CampaignID, sendID.SendID)
+	}
+}
+
+// Fixture returns the objects currently held by the server, in a stable order.
+func (s *Server) Fixture() Fixture {
+	s.handler.mu.Lock()
+	defer s.handler.mu.Unlock()
+
+	fixture := Fixture{}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.contentBlocks)) {
+		block := s.handler.contentBlocks[id]
+
+		fixture.ContentBlocks = append(fixture.ContentBlocks, FixtureContentBlock{
+			ID:          block.ContentBlockID,
+			Name:        block.Name,
+			Content:     block.Content,
+			Description: block.Description.Or(""),
+			Tags:        block.Tags.Or(nil),
+		})
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.emailTemplates)) {
+		template := s.handler.emailTemplates[id]
+
+		fixtureTemplate := FixtureEmailTemplate{
+			ID:            template.EmailTemplateID,
+			Name:          template.TemplateName,
+			Subject:       template.Subject.Or(""),
+			Body:          template.Body.Or(""),
+			PlaintextBody: template.PlaintextBody.Or(""),
+			Preheader:     template.Preheader.Or(""),
+			Tags:          template.Tags.Or(nil),
+		}
+
+		if shouldInlineCSS, ok := template.ShouldInlineCSS.Get(); ok {
+			fixtureTemplate.ShouldInlineCSS = &shouldInlineCSS
+		}
+
+		fixture.EmailTemplates = append(fixture.EmailTemplates, fixtureTemplate)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(s.handler.catalogs)) {
+		catalog := s.handler.catalogs[name]
+
+		fixtureCatalog := FixtureCatalog{
+			Name:        catalog.Name,
+			Description: catalog.Description,
+			Fields:      slices.Clone(catalog.Fields),
+		}
+
+		items := s.handler.catalogItems[name]
+
+		for _, itemID := range slices.Sorted(maps.Keys(items)) {
+			fixtureCatalog.Items = append(fixtureCatalog.Items, FixtureCatalogItem{
+				ID:     itemID,
+				Fields: fixtureCatalogItemFields(items[itemID].AdditionalProps),
+			})
+		}
+
+		fixture.Catalogs = append(fixture.Catalogs, fixtureCatalog)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.preferenceCenters)) {
+		preferenceCenter := s.handler.preferenceCenters[id]
+
+		fixturePreferenceCenter := FixturePreferenceCenter{
+			ID:                   preferenceCenter.PreferenceCenterAPIID,
+			Name:                 preferenceCenter.Name,
+			Title:                preferenceCenter.PreferenceCenterTitle.Or(""),
+			PageHTML:             preferenceCenter.PreferenceCenterPageHTML.Or(""),
+			ConfirmationPageHTML: preferenceCenter.ConfirmationPageHTML.Or(""),
+			State:                string(preferenceCenter.State.Or(brazeclient.PreferenceCenterStateActive)),
+		}
+
+		if options, ok := preferenceCenter.PreferenceCenterOptions.Get(); ok {
+			fixturePreferenceCenter.MetaViewportContent = options.MetaViewportContent.Or("")
+
+			for _, tag := range options.LinksTags {
+				fixturePreferenceCenter.LinksTags = append(fixturePreferenceCenter.LinksTags, FixturePreferenceCenterLinkTag{
+					Rel:  tag.Rel,
+					Type: tag.Type.Or(""),
+					Href: tag.Href,
+				})
+			}
+		}
+
+		fixture.PreferenceCenters = append(fixture.PreferenceCenters, fixturePreferenceCenter)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.dashboardUsers)) {
+		user := s.handler.dashboardUsers[id]
+		name := user.Name.Or(brazeclient.DashboardUserName{})
+
+		fixtureUser := FixtureDashboardUser{
+			ID:         user.ID,
+			Email:      user.UserName,
+			GivenName:  name.GivenName,
+			FamilyName: name.FamilyName,
+			Department: user.Department.Or(""),
+		}
+
+		if permissions, ok := user.Permissions.Get(); ok {
+			fixtureUser.Permissions = &permissions
+		}
+
+		fixture.DashboardUsers = append(fixture.DashboardUsers, fixtureUser)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.campaigns)) {
+		campaign := s.handler.campaigns[id]
+
+		fixture.Campaigns = append(fixture.Campaigns, FixtureMessagingObject{
+			ID:           id,
+			Name:         campaign.Name,
+			Tags:         slices.Clone(campaign.Tags),
+			ScheduleType: campaign.ScheduleType.Or(""),
+			Archived:     campaign.Archived.Or(false),
+		})
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.canvases)) {
+		canvas := s.handler.canvases[id]
+
+		fixture.Canvases = append(fixture.Canvases, FixtureMessagingObject{
+			ID:           id,
+			Name:         canvas.Name,
+			Tags:         slices.Clone(canvas.Tags),
+			ScheduleType: canvas.ScheduleType.Or(""),
+			Archived:     canvas.Archived.Or(false),
+		})
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.segments)) {
+		segment := s.handler.segments[id]
+
+		fixture.Segments = append(fixture.Segments, FixtureSegment{
+			ID:                       id,
+			Name:                     segment.Name,
+			Description:              segment.Description.Or(""),
+			Tags:                     slices.Clone(segment.Tags),
+			AnalyticsTrackingEnabled: segment.AnalyticsTrackingEnabled.Or(false),
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(s.handler.customAttributes)) {
+		attribute := s.handler.customAttributes[name]
+
+		fixture.CustomAttributes = append(fixture.CustomAttributes, FixtureCustomAttribute{
+			Name:        name,
+			DataType:    attribute.DataType.Or(""),
+			Description: attribute.Description.Or(""),
+			Tags:        slices.Clone(attribute.TagNames),
+			Status:      attribute.Status.Or(""),
+		})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(s.handler.customEvents)) {
+		event := s.handler.customEvents[name]
+
+		fixture.CustomEvents = append(fixture.CustomEvents, FixtureCustomEvent{
+			Name:        name,
+			Description: event.Description.Or(""),
+			Tags:        slices.Clone(event.TagNames),
+			Status:      event.Status.Or(""),
+		})
+	}
+
+	fixture.Products = slices.Sorted(maps.Keys(s.handler.products))
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.scheduledMessages)) {
+		fixture.ScheduledMessages = append(fixture.ScheduledMessages, FixtureScheduledMessage{
+			ID:      id,
+			Request: s.handler.scheduledMessages[id].request,
+		})
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.campaignTriggerSchedules)) {
+		fixture.CampaignTriggerSchedules = append(fixture.CampaignTriggerSchedules, FixtureCampaignTriggerSchedule{
+			ID:      id,
+			Request: *s.handler.campaignTriggerSchedules[id],
+		})
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.handler.canvasTriggerSchedules)) {
+		fixture.CanvasTriggerSchedules = append(fixture.CanvasTriggerSchedules, FixtureCanvasTriggerSchedule{
+			ID:      id,
+			Request: *s.handler.canvasTriggerSchedules[id],
+		})
+	}
+
+	for _, campaignID := range slices.Sorted(maps.Keys(s.handler.sendIDs)) {
+		for _, sendID := range slices.Sorted(maps.Keys(s.handler.sendIDs[campaignID])) {
+			fixture.SendIDs = append(fixture.SendIDs, FixtureSendID{
+				CampaignID: campaignID,
+				SendID:     sendID,
+			})
+		}
+	}
+
+	return fixture
+}
+
+func (f FixturePreferenceCenter) options() brazeclient.OptPreferenceCenterOptions {
+	if f.MetaViewportContent == "" && len(f.LinksTags) == 0 {
+		return brazeclient.OptPreferenceCenterOptions{}
+	}
+
+	options := brazeclient.PreferenceCenterOptions{}
+
+	if f.MetaViewportContent != "" {
+		options.MetaViewportContent = brazeclient.NewOptString(f.MetaViewportContent)
+	}
+
+	for _, tag := range f.LinksTags {
+		linkTag := brazeclient.PreferenceCenterLinkTag{Rel: tag.Rel, Href: tag.Href}
+		if tag.Type != "" {
+			linkTag.Type = brazeclient.NewOptString(tag.Type)
+		}
+
+		options.LinksTags = append(options.LinksTags, linkTag)
+	}
+
+	return brazeclient.NewOptPreferenceCenterOptions(options)
+}
+
+func fixtureCatalogItemFields(additional brazeclient.CatalogItemAdditional) map[string]json.RawMessage {
+	if len(additional) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]json.RawMessage, len(additional))
+	for name, value := range additional {
+		fields[name] = json.RawMessage(slices.Clone(value))
+	}
+
+	return fields
+}
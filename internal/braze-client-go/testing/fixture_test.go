@@ -0,0 +1,169 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	brazetesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+)
+
+func TestServerLoadFixtureRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	fixture, err := brazetesting.ParseFixture([]byte(`
+content_blocks:
+  - id: cb-1
+    name: greeting
+    content: Hello
+    tags: [marketing]
+email_templates:
+  - id: et-1
+    name: welcome
+    subject: Welcome
+    body: <p>Welcome</p>
+    should_inline_css: true
+catalogs:
+  - name: products
+    description: Products
+    fields:
+      - name: id
+        type: string
+      - name: price
+        type: number
+    items:
+      - id: sku-1
+        fields:
+          price: 9.5
+preference_centers:
+  - id: pc-1
+    name: newsletter
+    title: Newsletter
+    page_html: <p>Preferences</p>
+    confirmation_page_html: <p>Saved</p>
+    state: draft
+    meta_viewport_content: width=device-width
+    links_tags:
+      - rel: stylesheet
+        href: https://example.com/style.css
+dashboard_users:
+  - id: du-1
+    email: jane@example.com
+    given_name: Jane
+    family_name: Doe
+    department: engineering
+    permissions:
+      companyPermissions: [manage_company_settings]
+      appGroup:
+        - appGroupName: Production
+          appGroupPermissions: [basic_access]
+campaigns:
+  - id: campaign-1
+    name: Password reset
+    tags: [transactional]
+    schedule_type: api_triggered
+canvases:
+  - id: canvas-1
+    name: Onboarding
+    schedule_type: action_based
+    archived: true
+segments:
+  - id: segment-1
+    name: Lapsed users
+    description: Users who have not opened the app in 30 days
+    tags: [retention]
+    analytics_tracking_enabled: true
+custom_attributes:
+  - name: loyalty_tier
+    data_type: String
+    description: The loyalty programme tier
+    tags: [loyalty]
+    status: Active
+custom_events:
+  - name: order_shipped
+    description: An order left the warehouse
+    status: Blocklisted
+products:
+  - sku-1
+  - sku-2
+scheduled_messages:
+  - id: schedule-1
+    request:
+      broadcast: true
+      segment_id: segment-1
+      schedule:
+        time: "2030-01-01T09:00:00Z"
+        in_local_time: true
+      messages:
+        email:
+          app_id: app-1
+          subject: Scheduled maintenance
+campaign_trigger_schedules:
+  - id: campaign-schedule-1
+    request:
+      campaign_id: campaign-1
+      recipients:
+        - external_user_id: user-1
+          trigger_properties:
+            order_id: order-1
+      schedule:
+        time: "2030-01-01T09:00:00Z"
+canvas_trigger_schedules:
+  - id: canvas-schedule-1
+    request:
+      canvas_id: canvas-1
+      broadcast: true
+      canvas_entry_properties:
+        source: terraform
+      schedule:
+        time: "2030-01-01T09:00:00Z"
+        at_optimal_time: true
+send_ids:
+  - campaign_id: campaign-1
+    send_id: spring-launch
+`))
+	if err != nil {
+		t.Fatalf("ParseFixture() error = %v", err)
+	}
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	server.LoadFixture(fixture)
+
+	got := server.Fixture()
+
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	wantJSON, err := json.Marshal(fixture)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	// Free-form objects such as message payloads are encoded in map order,
+	// so the fixtures are compared as decoded JSON rather than as bytes.
+	var gotValue, wantValue any
+
+	if json.Unmarshal(gotJSON, &gotValue) != nil || json.Unmarshal(wantJSON, &wantValue) != nil || !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("expected fixture %s, got %s", wantJSON, gotJSON)
+	}
+
+	yamlData, err := brazetesting.MarshalFixtureYAML(got)
+	if err != nil {
+		t.Fatalf("MarshalFixtureYAML() error = %v", err)
+	}
+
+	reparsed, err := brazetesting.ParseFixture(yamlData)
+	if err != nil {
+		t.Fatalf("ParseFixture() error = %v", err)
+	}
+
+	if len(reparsed.Catalogs) != 1 || len(reparsed.Catalogs[0].Items) != 1 {
+		t.Errorf("expected one catalog with one item after round trip, got %+v", reparsed.Catalogs)
+	}
+}
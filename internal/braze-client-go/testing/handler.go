@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/ogen-go/ogen/middleware"
 )
 
 type Handler struct {
@@ -15,6 +16,25 @@ type Handler struct {
 	emailTemplates map[string]*brazeclient.GetEmailTemplateInfoResponse
 	catalogs       map[string]brazeclient.Catalog
 	catalogItems   map[string]map[string]brazeclient.CatalogItem
+
+	preferenceCenters map[string]*brazeclient.GetPreferenceCenterResponse
+	dashboardUsers    map[string]*brazeclient.DashboardUser
+
+	campaigns map[string]*brazeclient.GetCampaignDetailsResponse
+	canvases  map[string]*brazeclient.GetCanvasDetailsResponse
+	segments  map[string]*brazeclient.GetSegmentDetailsResponse
+
+	customAttributes map[string]brazeclient.CustomAttribute
+	customEvents     map[string]brazeclient.CustomEvent
+	products         map[string]struct{}
+
+	scheduledMessages        map[string]*scheduledMessage
+	campaignTriggerSchedules map[string]*brazeclient.CreateCampaignTriggerScheduleRequest
+	canvasTriggerSchedules   map[string]*brazeclient.CreateCanvasTriggerScheduleRequest
+
+	sendIDs map[string]map[string]struct{}
+
+	calls map[brazeclient.OperationName]int
 }
 
 var _ brazeclient.Handler = (*Handler)(nil)
@@ -27,10 +47,56 @@ func NewBrazeHandler() *Handler {
 		emailTemplates: make(map[string]*brazeclient.GetEmailTemplateInfoResponse),
 		catalogs:       make(map[string]brazeclient.Catalog),
 		catalogItems:   make(map[string]map[string]brazeclient.CatalogItem),
+
+		preferenceCenters: make(map[string]*brazeclient.GetPreferenceCenterResponse),
+		dashboardUsers:    make(map[string]*brazeclient.DashboardUser),
+
+		campaigns: make(map[string]*brazeclient.GetCampaignDetailsResponse),
+		canvases:  make(map[string]*brazeclient.GetCanvasDetailsResponse),
+		segments:  make(map[string]*brazeclient.GetSegmentDetailsResponse),
+
+		customAttributes: make(map[string]brazeclient.CustomAttribute),
+		customEvents:     make(map[string]brazeclient.CustomEvent),
+		products:         make(map[string]struct{}),
+
+		scheduledMessages:        make(map[string]*scheduledMessage),
+		campaignTriggerSchedules: make(map[string]*brazeclient.CreateCampaignTriggerScheduleRequest),
+		canvasTriggerSchedules:   make(map[string]*brazeclient.CreateCanvasTriggerScheduleRequest),
+
+		sendIDs: make(map[string]map[string]struct{}),
+
+		calls: make(map[brazeclient.OperationName]int),
 	}
 }
 
+// CallCount returns the number of requests the handler has received for the
+// given operation.
+func (h *Handler) CallCount(operation brazeclient.OperationName) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.calls[operation]
+}
+
+func (h *Handler) recordCall(operation brazeclient.OperationName) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.calls[operation]++
+}
+
 func (h *Handler) NewError(_ context.Context, err error) *brazeclient.ErrorResponseStatusCode {
+	var be brazeError
+	if errors.As(err, &be) {
+		return &brazeclient.ErrorResponseStatusCode{
+			StatusCode: be.StatusCode,
+			Response: brazeclient.ErrorResponse{
+				Message: be.Message,
+				Errors:  be.Errors,
+			},
+		}
+	}
+
 	var statusCode int
 
 	var sce statusCodeError
@@ -45,3 +111,10 @@ func (h *Handler) NewError(_ context.Context, err error) *brazeclient.ErrorRespo
 		},
 	}
 }
+
+func (h *Handler) countCallsMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	h.recordCall(req.OperationName)
+
+	//nolint:wrapcheck
+	return next(req)
+}
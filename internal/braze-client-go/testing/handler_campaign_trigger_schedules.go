@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/google/uuid"
+)
+
+func (h *Handler) CreateCampaignTriggerSchedule(_ context.Context, req *brazeclient.CreateCampaignTriggerScheduleRequest) (*brazeclient.CreateCampaignTriggerScheduleResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	campaign, exists := h.campaigns[req.CampaignID]
+	if !exists {
+		return nil, newBrazeMessageError("Invalid campaign_id")
+	}
+
+	err := validateTriggerSchedule(campaign.ScheduleType, campaign.Archived, req.Broadcast, len(req.Recipients), req.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleID := uuid.NewString()
+	schedule := *req
+	h.campaignTriggerSchedules[scheduleID] = &schedule
+
+	return &brazeclient.CreateCampaignTriggerScheduleResponse{
+		DispatchID: brazeclient.NewOptString(uuid.NewString()),
+		ScheduleID: scheduleID,
+		Message:    brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) UpdateCampaignTriggerSchedule(_ context.Context, req *brazeclient.UpdateCampaignTriggerScheduleRequest) (*brazeclient.UpdateCampaignTriggerScheduleResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	schedule, err := h.pendingCampaignTriggerSchedule(req.CampaignID, req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateMessageSchedule(req.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.Schedule = req.Schedule
+
+	return &brazeclient.UpdateCampaignTriggerScheduleResponse{
+		Message: brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) DeleteCampaignTriggerSchedule(_ context.Context, req *brazeclient.DeleteCampaignTriggerScheduleRequest) (*brazeclient.DeleteCampaignTriggerScheduleResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	_, err := h.pendingCampaignTriggerSchedule(req.CampaignID, req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(h.campaignTriggerSchedules, req.ScheduleID)
+
+	return &brazeclient.DeleteCampaignTriggerScheduleResponse{
+		Message: brazeclient.NewOptString("success"),
+	}, nil
+}
+
+// pendingCampaignTriggerSchedule returns a schedule of the campaign that has
+// not yet been sent, as only those can be changed.
+func (h *Handler) pendingCampaignTriggerSchedule(campaignID, scheduleID string) (*brazeclient.CreateCampaignTriggerScheduleRequest, error) {
+	schedule, exists := h.campaignTriggerSchedules[scheduleID]
+	if !exists || schedule.CampaignID != campaignID {
+		return nil, fmt.Errorf("campaign trigger schedule not found: %w", errNotFound)
+	}
+
+	if scheduledMessageSent(schedule.Schedule, time.Now()) {
+		return nil, newBrazeMessageError("Scheduled message has already been sent")
+	}
+
+	return schedule, nil
+}
+
+func (h *Handler) setCampaignTriggerSchedule(scheduleID string, req brazeclient.CreateCampaignTriggerScheduleRequest) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.campaignTriggerSchedules[scheduleID] = &req
+}
+
+func (h *Handler) getCampaignTriggerSchedule(scheduleID string) (brazeclient.CreateCampaignTriggerScheduleRequest, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	schedule, exists := h.campaignTriggerSchedules[scheduleID]
+	if !exists {
+		return brazeclient.CreateCampaignTriggerScheduleRequest{}, false
+	}
+
+	return *schedule, true
+}
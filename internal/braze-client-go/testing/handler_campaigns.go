@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+// brazeExportPageSize is the number of campaigns or Canvases Braze returns on
+// each page of the export list endpoints.
+const brazeExportPageSize = 100
+
+const apiTriggeredScheduleType = "api_triggered"
+
+func (h *Handler) ListCampaigns(_ context.Context, params brazeclient.ListCampaignsParams) (*brazeclient.ListCampaignsResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ids := exportedObjectIDs(h.campaigns, params.IncludeArchived.Or(false), params.LastEditTimeGt,
+		func(campaign *brazeclient.GetCampaignDetailsResponse) (bool, time.Time) {
+			return campaign.Archived.Or(false), campaign.UpdatedAt.Or(time.Time{})
+		})
+
+	if params.SortDirection.Or(brazeclient.ListCampaignsSortDirectionAsc) == brazeclient.ListCampaignsSortDirectionDesc {
+		slices.Reverse(ids)
+	}
+
+	campaigns := []brazeclient.ListCampaignsResponseCampaignsItem{}
+
+	for _, id := range exportPage(ids, params.Page.Or(0)) {
+		campaign := h.campaigns[id]
+		campaigns = append(campaigns, brazeclient.ListCampaignsResponseCampaignsItem{
+			ID:            id,
+			Name:          campaign.Name,
+			IsAPICampaign: brazeclient.NewOptBool(campaign.ScheduleType.Or("") == apiTriggeredScheduleType),
+			Tags:          slices.Clone(campaign.Tags),
+			LastEdited:    brazeclient.NewOptDateTime(campaign.UpdatedAt.Or(time.Time{})),
+		})
+	}
+
+	return &brazeclient.ListCampaignsResponse{
+		Campaigns: campaigns,
+		Message:   brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) GetCampaignDetails(_ context.Context, params brazeclient.GetCampaignDetailsParams) (*brazeclient.GetCampaignDetailsResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	campaign, exists := h.campaigns[params.CampaignID]
+	if !exists {
+		return nil, newBrazeMessageError("Invalid campaign_id")
+	}
+
+	return campaign, nil
+}
+
+func (h *Handler) setCampaign(campaignID, name string, tags []string, scheduleType string, archived bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	h.campaigns[campaignID] = &brazeclient.GetCampaignDetailsResponse{
+		Name:         name,
+		Archived:     brazeclient.NewOptBool(archived),
+		Draft:        brazeclient.NewOptBool(false),
+		Enabled:      brazeclient.NewOptBool(!archived),
+		ScheduleType: brazeclient.NewOptString(scheduleType),
+		Tags:         slices.Clone(tags),
+		CreatedAt:    brazeclient.NewOptDateTime(now),
+		UpdatedAt:    brazeclient.NewOptDateTime(now),
+		Message:      brazeclient.NewOptString("success"),
+	}
+}
+
+// exportedObjectIDs returns the sorted identifiers of the campaigns or
+// Canvases that the export list endpoints return for the given filters.
+func exportedObjectIDs[T any](objects map[string]T, includeArchived bool, editedAfter brazeclient.OptDateTime, status func(T) (bool, time.Time)) []string {
+	ids := []string{}
+
+	for _, id := range slices.Sorted(maps.Keys(objects)) {
+		archived, lastEdited := status(objects[id])
+
+		if archived && !includeArchived {
+			continue
+		}
+
+		if after, ok := editedAfter.Get(); ok && !lastEdited.After(after) {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func exportPage(ids []string, page int) []string {
+	return paginatedItems(ids, brazeclient.NewOptInt(brazeExportPageSize), brazeclient.NewOptInt(max(page, 0)*brazeExportPageSize))
+}
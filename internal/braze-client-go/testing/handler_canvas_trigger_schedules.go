@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/google/uuid"
+)
+
+func (h *Handler) CreateCanvasTriggerSchedule(_ context.Context, req *brazeclient.CreateCanvasTriggerScheduleRequest) (*brazeclient.CreateCanvasTriggerScheduleResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	canvas, exists := h.canvases[req.CanvasID]
+	if !exists {
+		return nil, newBrazeMessageError("Invalid canvas_id")
+	}
+
+	err := validateTriggerSchedule(canvas.ScheduleType, canvas.Archived, req.Broadcast, len(req.Recipients), req.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleID := uuid.NewString()
+	schedule := *req
+	h.canvasTriggerSchedules[scheduleID] = &schedule
+
+	return &brazeclient.CreateCanvasTriggerScheduleResponse{
+		DispatchID: brazeclient.NewOptString(uuid.NewString()),
+		ScheduleID: scheduleID,
+		Message:    brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) UpdateCanvasTriggerSchedule(_ context.Context, req *brazeclient.UpdateCanvasTriggerScheduleRequest) (*brazeclient.UpdateCanvasTriggerScheduleResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	schedule, err := h.pendingCanvasTriggerSchedule(req.CanvasID, req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateMessageSchedule(req.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.Schedule = req.Schedule
+
+	return &brazeclient.UpdateCanvasTriggerScheduleResponse{
+		Message: brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) DeleteCanvasTriggerSchedule(_ context.Context, req *brazeclient.DeleteCanvasTriggerScheduleRequest) (*brazeclient.DeleteCanvasTriggerScheduleResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	_, err := h.pendingCanvasTriggerSchedule(req.CanvasID, req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(h.canvasTriggerSchedules, req.ScheduleID)
+
+	return &brazeclient.DeleteCanvasTriggerScheduleResponse{
+		Message: brazeclient.NewOptString("success"),
+	}, nil
+}
+
+// pendingCanvasTriggerSchedule returns a schedule of the Canvas that has
+// not yet been sent, as only those can be changed.
+func (h *Handler) pendingCanvasTriggerSchedule(canvasID, scheduleID string) (*brazeclient.CreateCanvasTriggerScheduleRequest, error) {
+	schedule, exists := h.canvasTriggerSchedules[scheduleID]
+	if !exists || schedule.CanvasID != canvasID {
+		return nil, fmt.Errorf("Canvas trigger schedule not found: %w", errNotFound)
+	}
+
+	if scheduledMessageSent(schedule.Schedule, time.Now()) {
+		return nil, newBrazeMessageError("Scheduled message has already been sent")
+	}
+
+	return schedule, nil
+}
+
+func (h *Handler) setCanvasTriggerSchedule(scheduleID string, req brazeclient.CreateCanvasTriggerScheduleRequest) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.canvasTriggerSchedules[scheduleID] = &req
+}
+
+func (h *Handler) getCanvasTriggerSchedule(scheduleID string) (brazeclient.CreateCanvasTriggerScheduleRequest, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	schedule, exists := h.canvasTriggerSchedules[scheduleID]
+	if !exists {
+		return brazeclient.CreateCanvasTriggerScheduleRequest{}, false
+	}
+
+	return *schedule, true
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+func (h *Handler) ListCanvases(_ context.Context, params brazeclient.ListCanvasesParams) (*brazeclient.ListCanvasesResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ids := exportedObjectIDs(h.canvases, params.IncludeArchived.Or(false), params.LastEditTimeGt,
+		func(canvas *brazeclient.GetCanvasDetailsResponse) (bool, time.Time) {
+			return canvas.Archived.Or(false), canvas.UpdatedAt.Or(time.Time{})
+		})
+
+	if params.SortDirection.Or(brazeclient.ListCanvasesSortDirectionAsc) == brazeclient.ListCanvasesSortDirectionDesc {
+		slices.Reverse(ids)
+	}
+
+	canvases := []brazeclient.ListCanvasesResponseCanvasesItem{}
+
+	for _, id := range exportPage(ids, params.Page.Or(0)) {
+		canvas := h.canvases[id]
+		canvases = append(canvases, brazeclient.ListCanvasesResponseCanvasesItem{
+			ID:         id,
+			Name:       canvas.Name,
+			Tags:       slices.Clone(canvas.Tags),
+			LastEdited: brazeclient.NewOptDateTime(canvas.UpdatedAt.Or(time.Time{})),
+		})
+	}
+
+	return &brazeclient.ListCanvasesResponse{
+		Canvases: canvases,
+		Message:  brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) GetCanvasDetails(_ context.Context, params brazeclient.GetCanvasDetailsParams) (*brazeclient.GetCanvasDetailsResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	canvas, exists := h.canvases[params.CanvasID]
+	if !exists {
+		return nil, newBrazeMessageError("Invalid canvas_id")
+	}
+
+	return canvas, nil
+}
+
+func (h *Handler) setCanvas(canvasID, name string, tags []string, scheduleType string, archived bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	h.canvases[canvasID] = &brazeclient.GetCanvasDetailsResponse{
+		Name:         name,
+		Archived:     brazeclient.NewOptBool(archived),
+		Draft:        brazeclient.NewOptBool(false),
+		Enabled:      brazeclient.NewOptBool(!archived),
+		ScheduleType: brazeclient.NewOptString(scheduleType),
+		Tags:         slices.Clone(tags),
+		CreatedAt:    brazeclient.NewOptDateTime(now),
+		UpdatedAt:    brazeclient.NewOptDateTime(now),
+		Message:      brazeclient.NewOptString("success"),
+	}
+}
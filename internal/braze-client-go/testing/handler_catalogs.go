@@ -3,28 +3,28 @@ package testing
 import (
 	"context"
 	"encoding/json"
-	"errors"
-	"fmt"
+	"net/http"
 	"sort"
-	"strconv"
 	"time"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
 	"github.com/go-faster/jx"
 )
 
-const catalogItemsPageSize = 50
-
-var (
-	errCatalogAlreadyExists          = errors.New("catalog already exists")
-	errCatalogItemAlreadyExists      = errors.New("catalog item already exists")
-	errCatalogItemIDInRequestBody    = errors.New("catalog item request body must not include id")
-	errExpectedOneCatalog            = errors.New("expected one catalog")
-	errExpectedOneCatalogItem        = errors.New("expected one catalog item")
-	errInvalidCatalogItemsPageCursor = errors.New("invalid catalog items page cursor")
+const (
+	catalogsPageSize     = 50
+	catalogItemsPageSize = 50
 )
 
-func (h *Handler) ListCatalogs(context.Context) (*brazeclient.ListCatalogsResponse, error) {
+func newCatalogNotFoundError(catalogName string) brazeError {
+	return newBrazeCatalogError(http.StatusNotFound, "catalog-not-found", "Could not find catalog", "catalog_name", catalogName)
+}
+
+func newCatalogItemNotFoundError(itemID string) brazeError {
+	return newBrazeCatalogError(http.StatusNotFound, "item-not-found", "Could not find item", "item_id", itemID)
+}
+
+func (h *Handler) ListCatalogs(_ context.Context, params brazeclient.ListCatalogsParams) (*brazeclient.ListCatalogsResponseHeaders, error) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
@@ -35,14 +35,26 @@ func (h *Handler) ListCatalogs(context.Context) (*brazeclient.ListCatalogsRespon
 
 	sort.Strings(names)
 
-	catalogs := make([]brazeclient.Catalog, 0, len(names))
-	for _, name := range names {
+	offset, end, nextCursor, err := cursorPageBounds(len(names), params.Cursor, catalogsPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogs := make([]brazeclient.Catalog, 0, end-offset)
+	for _, name := range names[offset:end] {
 		catalog := h.catalogs[name]
 		catalog.NumItems = brazeclient.NewOptInt(len(h.catalogItems[name]))
 		catalogs = append(catalogs, catalog)
 	}
 
-	return &brazeclient.ListCatalogsResponse{Catalogs: catalogs, Message: "success"}, nil
+	response := brazeclient.ListCatalogsResponseHeaders{
+		Response: brazeclient.ListCatalogsResponse{Catalogs: catalogs, Message: "success"},
+	}
+	if nextCursor != "" {
+		response.Link.SetTo(nextPageLinkHeader("/catalogs", nextCursor))
+	}
+
+	return &response, nil
 }
 
 func (s *Server) SetCatalog(name string, description string, fields []brazeclient.CatalogField) {
@@ -86,12 +98,18 @@ func (h *Handler) CreateCatalog(_ context.Context, req *brazeclient.CreateCatalo
 	defer h.mu.Unlock()
 
 	if len(req.Catalogs) != 1 {
-		return nil, errExpectedOneCatalog
+		return nil, newBrazeCatalogError(http.StatusBadRequest, "catalog-array-invalid", "catalogs must be an array containing one catalog", "catalogs", nil)
 	}
 
 	catalog := req.Catalogs[0]
+
+	err := validateCatalog(catalog)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, exists := h.catalogs[catalog.Name]; exists {
-		return nil, fmt.Errorf("%w: %s", errCatalogAlreadyExists, catalog.Name)
+		return nil, newBrazeCatalogError(http.StatusBadRequest, "catalog-name-already-exists", "A catalog with that name already exists", "name", catalog.Name)
 	}
 
 	catalog.NumItems = brazeclient.NewOptInt(0)
@@ -107,7 +125,7 @@ func (h *Handler) DeleteCatalog(_ context.Context, params brazeclient.DeleteCata
 	defer h.mu.Unlock()
 
 	if _, exists := h.catalogs[params.CatalogName]; !exists {
-		return nil, errNotFound
+		return nil, newCatalogNotFoundError(params.CatalogName)
 	}
 
 	delete(h.catalogs, params.CatalogName)
@@ -122,7 +140,7 @@ func (h *Handler) ListCatalogItems(_ context.Context, params brazeclient.ListCat
 
 	itemsByID, exists := h.catalogItems[params.CatalogName]
 	if !exists {
-		return nil, errNotFound
+		return nil, newCatalogNotFoundError(params.CatalogName)
 	}
 
 	ids := make([]string, 0, len(itemsByID))
@@ -132,33 +150,21 @@ func (h *Handler) ListCatalogItems(_ context.Context, params brazeclient.ListCat
 
 	sort.Strings(ids)
 
-	offset := 0
-
-	if cursor, ok := params.Cursor.Get(); ok {
-		parsed, err := strconv.Atoi(cursor)
-		if err != nil {
-			return nil, fmt.Errorf("%w: %s", errInvalidCatalogItemsPageCursor, cursor)
-		}
-
-		offset = parsed
-	}
-
-	if offset > len(ids) {
-		offset = len(ids)
+	offset, end, nextCursor, err := cursorPageBounds(len(ids), params.Cursor, catalogItemsPageSize)
+	if err != nil {
+		return nil, err
 	}
 
-	limit := min(offset+catalogItemsPageSize, len(ids))
-
-	items := make([]brazeclient.CatalogItem, 0, limit-offset)
-	for _, id := range ids[offset:limit] {
+	items := make([]brazeclient.CatalogItem, 0, end-offset)
+	for _, id := range ids[offset:end] {
 		items = append(items, itemsByID[id])
 	}
 
 	response := brazeclient.ListCatalogItemsResponseHeaders{
 		Response: brazeclient.ListCatalogItemsResponse{Items: items, Message: "success"},
 	}
-	if limit < len(ids) {
-		response.Link.SetTo(fmt.Sprintf(`</catalogs/%s/items?cursor=%d>; rel="next"`, params.CatalogName, limit))
+	if nextCursor != "" {
+		response.Link.SetTo(nextPageLinkHeader("/catalogs/"+params.CatalogName+"/items", nextCursor))
 	}
 
 	return &response, nil
@@ -170,12 +176,12 @@ func (h *Handler) GetCatalogItem(_ context.Context, params brazeclient.GetCatalo
 
 	itemsByID, exists := h.catalogItems[params.CatalogName]
 	if !exists {
-		return nil, errNotFound
+		return nil, newCatalogNotFoundError(params.CatalogName)
 	}
 
 	item, exists := itemsByID[params.ItemID]
 	if !exists {
-		return nil, errNotFound
+		return nil, newCatalogItemNotFoundError(params.ItemID)
 	}
 
 	return &brazeclient.GetCatalogItemResponse{Items: []brazeclient.CatalogItem{item}, Message: "success"}, nil
@@ -195,20 +201,30 @@ func (h *Handler) upsertCatalogItem(items []brazeclient.CatalogItemWrite, catalo
 
 	itemsByID, exists := h.catalogItems[catalogName]
 	if !exists {
-		return nil, errNotFound
+		return nil, newCatalogNotFoundError(catalogName)
 	}
 
 	if len(items) != 1 {
-		return nil, errExpectedOneCatalogItem
+		return nil, newBrazeCatalogError(http.StatusBadRequest, "item-array-invalid", "items must be an array containing one item", "items", nil)
 	}
 
 	writeItem := items[0]
 	if _, ok := writeItem["id"]; ok {
-		return nil, errCatalogItemIDInRequestBody
+		return nil, newBrazeCatalogError(http.StatusBadRequest, "id-in-body", "The item id must be given in the path, not the request body", "id", nil)
+	}
+
+	err := validateCatalogItemID(itemID)
+	if err != nil {
+		return nil, err
 	}
 
 	if _, exists := itemsByID[itemID]; exists && !replace {
-		return nil, fmt.Errorf("%w: %s", errCatalogItemAlreadyExists, itemID)
+		return nil, newBrazeCatalogError(http.StatusBadRequest, "item-already-exists", "The item already exists", "id", itemID)
+	}
+
+	err = validateCatalogItemFields(h.catalogs[catalogName], writeItem)
+	if err != nil {
+		return nil, err
 	}
 
 	itemsByID[itemID] = brazeclient.CatalogItem{
@@ -225,11 +241,11 @@ func (h *Handler) DeleteCatalogItem(_ context.Context, params brazeclient.Delete
 
 	itemsByID, exists := h.catalogItems[params.CatalogName]
 	if !exists {
-		return nil, errNotFound
+		return nil, newCatalogNotFoundError(params.CatalogName)
 	}
 
 	if _, exists := itemsByID[params.ItemID]; !exists {
-		return nil, errNotFound
+		return nil, newCatalogItemNotFoundError(params.ItemID)
 	}
 
 	delete(itemsByID, params.ItemID)
@@ -3,7 +3,6 @@ package testing
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"slices"
 	"sort"
 
@@ -54,8 +53,14 @@ func (h *Handler) CreateContentBlock(_ context.Context, req *brazeclient.CreateC
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	if req.Name == "" {
-		return nil, newStatusCodeError(http.StatusUnprocessableEntity)
+	err := h.validateContentBlockName(req.Name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateContentBlockDescription(req.Description)
+	if err != nil {
+		return nil, err
 	}
 
 	blockID := uuid.NewString()
@@ -97,10 +102,18 @@ func (h *Handler) UpdateContentBlock(_ context.Context, req *brazeclient.UpdateC
 
 	name, nameOk := req.Name.Get()
 	if nameOk {
-		if name == "" {
-			return nil, newStatusCodeError(http.StatusUnprocessableEntity)
+		err := h.validateContentBlockName(name, block.ContentBlockID)
+		if err != nil {
+			return nil, err
 		}
+	}
 
+	err := validateContentBlockDescription(req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	if nameOk {
 		block.Name = name
 	}
 
@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+const customAttributesPageSize = 50
+
+func (h *Handler) ListCustomAttributes(_ context.Context, params brazeclient.ListCustomAttributesParams) (*brazeclient.ListCustomAttributesResponseHeaders, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	names := slices.Sorted(maps.Keys(h.customAttributes))
+
+	offset, end, nextCursor, err := cursorPageBounds(len(names), params.Cursor, customAttributesPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]brazeclient.CustomAttribute, 0, end-offset)
+	for _, name := range names[offset:end] {
+		attribute := h.customAttributes[name]
+		attribute.TagNames = slices.Clone(attribute.TagNames)
+		attributes = append(attributes, attribute)
+	}
+
+	response := brazeclient.ListCustomAttributesResponseHeaders{
+		Response: brazeclient.ListCustomAttributesResponse{
+			Attributes: attributes,
+			Message:    brazeclient.NewOptString("success"),
+		},
+	}
+	if nextCursor != "" {
+		response.Link.SetTo(nextPageLinkHeader("/custom_attributes", nextCursor))
+	}
+
+	return &response, nil
+}
+
+func (h *Handler) setCustomAttribute(name, dataType, description string, tags []string, status string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	attribute := brazeclient.CustomAttribute{
+		Name:     name,
+		TagNames: slices.Clone(tags),
+	}
+
+	if dataType != "" {
+		attribute.DataType = brazeclient.NewOptString(dataType)
+	}
+
+	if description != "" {
+		attribute.Description = brazeclient.NewOptNilString(description)
+	}
+
+	if status != "" {
+		attribute.Status = brazeclient.NewOptString(status)
+	}
+
+	h.customAttributes[name] = attribute
+}
@@ -0,0 +1,62 @@
+package testing
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+const customEventsPageSize = 50
+
+func (h *Handler) ListCustomEvents(_ context.Context, params brazeclient.ListCustomEventsParams) (*brazeclient.ListCustomEventsResponseHeaders, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	names := slices.Sorted(maps.Keys(h.customEvents))
+
+	offset, end, nextCursor, err := cursorPageBounds(len(names), params.Cursor, customEventsPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]brazeclient.CustomEvent, 0, end-offset)
+	for _, name := range names[offset:end] {
+		event := h.customEvents[name]
+		event.TagNames = slices.Clone(event.TagNames)
+		events = append(events, event)
+	}
+
+	response := brazeclient.ListCustomEventsResponseHeaders{
+		Response: brazeclient.ListCustomEventsResponse{
+			Events:  events,
+			Message: brazeclient.NewOptString("success"),
+		},
+	}
+	if nextCursor != "" {
+		response.Link.SetTo(nextPageLinkHeader("/events", nextCursor))
+	}
+
+	return &response, nil
+}
+
+func (h *Handler) setCustomEvent(name, description string, tags []string, status string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	event := brazeclient.CustomEvent{
+		Name:     name,
+		TagNames: slices.Clone(tags),
+	}
+
+	if description != "" {
+		event.Description = brazeclient.NewOptNilString(description)
+	}
+
+	if status != "" {
+		event.Status = brazeclient.NewOptString(status)
+	}
+
+	h.customEvents[name] = event
+}
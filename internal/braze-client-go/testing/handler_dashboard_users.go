@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/google/uuid"
+)
+
+const scimUserSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+
+const scimListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+
+// dashboardUserFilterPattern matches the only SCIM filter Braze supports.
+var dashboardUserFilterPattern = regexp.MustCompile(`^userName eq "([^"]+)"$`)
+
+func (h *Handler) SearchDashboardUsers(_ context.Context, params brazeclient.SearchDashboardUsersParams) (*brazeclient.SearchDashboardUsersResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	match := dashboardUserFilterPattern.FindStringSubmatch(params.Filter)
+	if match == nil {
+		return nil, newBrazeMessageError("Unsupported filter: only userName eq is supported")
+	}
+
+	resources := []brazeclient.DashboardUser{}
+
+	for _, id := range slices.Sorted(maps.Keys(h.dashboardUsers)) {
+		user := h.dashboardUsers[id]
+		if strings.EqualFold(user.UserName, match[1]) {
+			resources = append(resources, *user)
+		}
+	}
+
+	return &brazeclient.SearchDashboardUsersResponse{
+		Schemas:      []string{scimListResponseSchema},
+		TotalResults: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+func (h *Handler) GetDashboardUser(_ context.Context, params brazeclient.GetDashboardUserParams) (*brazeclient.DashboardUser, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	user, exists := h.dashboardUsers[params.ID]
+	if !exists {
+		return nil, errNotFound
+	}
+
+	return user, nil
+}
+
+func (h *Handler) CreateDashboardUser(_ context.Context, req *brazeclient.CreateDashboardUserRequest, _ brazeclient.CreateDashboardUserParams) (*brazeclient.DashboardUser, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	err := h.validateDashboardUserEmail(req.UserName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateDashboardUser(req.Name, req.Department)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &brazeclient.DashboardUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          uuid.NewString(),
+		UserName:    req.UserName,
+		Name:        brazeclient.NewOptDashboardUserName(req.Name),
+		Department:  brazeclient.NewOptString(req.Department),
+		Permissions: brazeclient.NewOptDashboardUserPermissions(dashboardUserPermissionsWithIDs(req.Permissions)),
+	}
+
+	h.dashboardUsers[user.ID] = user
+
+	return user, nil
+}
+
+func (h *Handler) UpdateDashboardUser(_ context.Context, req *brazeclient.UpdateDashboardUserRequest, params brazeclient.UpdateDashboardUserParams) (*brazeclient.DashboardUser, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	user, exists := h.dashboardUsers[params.ID]
+	if !exists {
+		return nil, errNotFound
+	}
+
+	err := validateDashboardUser(req.Name, req.Department)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Name = brazeclient.NewOptDashboardUserName(req.Name)
+	user.Department = brazeclient.NewOptString(req.Department)
+	user.Permissions = brazeclient.NewOptDashboardUserPermissions(dashboardUserPermissionsWithIDs(req.Permissions))
+
+	return user, nil
+}
+
+func (h *Handler) DeleteDashboardUser(_ context.Context, params brazeclient.DeleteDashboardUserParams) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, exists := h.dashboardUsers[params.ID]; !exists {
+		return errNotFound
+	}
+
+	delete(h.dashboardUsers, params.ID)
+
+	return nil
+}
+
+func (h *Handler) setDashboardUser(userID, email, givenName, familyName, department string, permissions brazeclient.DashboardUserPermissions) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.dashboardUsers[userID] = &brazeclient.DashboardUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          userID,
+		UserName:    email,
+		Name:        brazeclient.NewOptDashboardUserName(brazeclient.DashboardUserName{GivenName: givenName, FamilyName: familyName}),
+		Department:  brazeclient.NewOptString(department),
+		Permissions: brazeclient.NewOptDashboardUserPermissions(permissions),
+	}
+}
+
+// dashboardUserPermissionsWithIDs fills in the workspace and team identifiers
+// Braze includes in its responses but does not require in requests. Like
+// Braze, it returns empty lists rather than omitting permissions that are not
+// set.
+func dashboardUserPermissionsWithIDs(permissions brazeclient.DashboardUserPermissions) brazeclient.DashboardUserPermissions {
+	appGroups := nonNilSlice(slices.Clone(permissions.AppGroup))
+
+	for i, appGroup := range appGroups {
+		appGroup.AppGroupId = brazeclient.NewOptString(appGroup.AppGroupId.Or(dashboardUserObjectID(appGroup.AppGroupName)))
+		appGroup.AppGroupPermissions = nonNilSlice(slices.Clone(appGroup.AppGroupPermissions))
+
+		appGroup.Team = nonNilSlice(slices.Clone(appGroup.Team))
+		for j, team := range appGroup.Team {
+			team.TeamId = brazeclient.NewOptString(team.TeamId.Or(dashboardUserObjectID(appGroup.AppGroupName + "/" + team.TeamName)))
+			team.TeamPermissions = nonNilSlice(slices.Clone(team.TeamPermissions))
+			appGroup.Team[j] = team
+		}
+
+		appGroups[i] = appGroup
+	}
+
+	return brazeclient.DashboardUserPermissions{
+		CompanyPermissions: nonNilSlice(slices.Clone(permissions.CompanyPermissions)),
+		AppGroup:           appGroups,
+	}
+}
+
+func nonNilSlice[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+
+	return s
+}
+
+// dashboardUserObjectID derives a stable identifier for a workspace or team
+// from its name, so that the same name always has the same identifier.
+func dashboardUserObjectID(name string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()
+}
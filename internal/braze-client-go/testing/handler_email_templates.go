@@ -3,7 +3,6 @@ package testing
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"slices"
 	"sort"
 
@@ -53,8 +52,13 @@ func (h *Handler) CreateEmailTemplate(_ context.Context, req *brazeclient.Create
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	if req.TemplateName == "" {
-		return nil, newStatusCodeError(http.StatusUnprocessableEntity)
+	switch {
+	case req.TemplateName == "":
+		return nil, newBrazeMessageError("Template name cannot be blank")
+	case req.Subject.Value == "":
+		return nil, newBrazeMessageError("Subject cannot be blank")
+	case req.Body.Value == "":
+		return nil, newBrazeMessageError("Body cannot be blank")
 	}
 
 	templateID := uuid.NewString()
@@ -97,7 +101,7 @@ func (h *Handler) UpdateEmailTemplate(_ context.Context, req *brazeclient.Update
 	templateName, templateNameOk := req.TemplateName.Get()
 	if templateNameOk {
 		if templateName == "" {
-			return nil, newStatusCodeError(http.StatusUnprocessableEntity)
+			return nil, newBrazeMessageError("Template name cannot be blank")
 		}
 
 		template.TemplateName = templateName
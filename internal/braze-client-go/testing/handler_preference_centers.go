@@ -0,0 +1,147 @@
+package testing
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/google/uuid"
+)
+
+func (h *Handler) ListPreferenceCenters(_ context.Context) (*brazeclient.ListPreferenceCentersResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	preferenceCenters := make([]brazeclient.ListPreferenceCentersResponsePreferenceCentersItem, 0, len(h.preferenceCenters))
+	for _, id := range slices.Sorted(maps.Keys(h.preferenceCenters)) {
+		preferenceCenter := h.preferenceCenters[id]
+		preferenceCenters = append(preferenceCenters, brazeclient.ListPreferenceCentersResponsePreferenceCentersItem{
+			PreferenceCenterAPIID: preferenceCenter.PreferenceCenterAPIID,
+			Name:                  preferenceCenter.Name,
+			CreatedAt:             preferenceCenter.CreatedAt,
+			UpdatedAt:             preferenceCenter.UpdatedAt,
+		})
+	}
+
+	return &brazeclient.ListPreferenceCentersResponse{
+		PreferenceCenters: preferenceCenters,
+	}, nil
+}
+
+func (h *Handler) GetPreferenceCenter(_ context.Context, params brazeclient.GetPreferenceCenterParams) (*brazeclient.GetPreferenceCenterResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	preferenceCenter, exists := h.preferenceCenters[params.PreferenceCenterExternalID]
+	if !exists {
+		return nil, errNotFound
+	}
+
+	return preferenceCenter, nil
+}
+
+func (h *Handler) CreatePreferenceCenter(_ context.Context, req *brazeclient.CreatePreferenceCenterRequest) (*brazeclient.CreatePreferenceCenterResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	err := h.validatePreferenceCenterName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validatePreferenceCenterHTML(req.PreferenceCenterPageHTML, req.ConfirmationPageHTML)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	preferenceCenterID := uuid.NewString()
+
+	h.preferenceCenters[preferenceCenterID] = &brazeclient.GetPreferenceCenterResponse{
+		PreferenceCenterAPIID:    preferenceCenterID,
+		Name:                     req.Name,
+		PreferenceCenterTitle:    optNilStringFromOptString(req.PreferenceCenterTitle),
+		PreferenceCenterPageHTML: brazeclient.NewOptNilString(req.PreferenceCenterPageHTML),
+		ConfirmationPageHTML:     brazeclient.NewOptNilString(req.ConfirmationPageHTML),
+		PreferenceCenterOptions:  req.Options,
+		State:                    brazeclient.NewOptPreferenceCenterState(req.State.Or(brazeclient.PreferenceCenterStateActive)),
+		CreatedAt:                brazeclient.NewOptNilDateTime(now),
+		UpdatedAt:                brazeclient.NewOptNilDateTime(now),
+	}
+
+	return &brazeclient.CreatePreferenceCenterResponse{
+		PreferenceCenterAPIID: preferenceCenterID,
+		LiquidTag:             brazeclient.NewOptString(preferenceCenterLiquidTag(req.Name)),
+		CreatedAt:             brazeclient.NewOptDateTime(now),
+		Message:               brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) UpdatePreferenceCenter(_ context.Context, req *brazeclient.UpdatePreferenceCenterRequest, params brazeclient.UpdatePreferenceCenterParams) (*brazeclient.UpdatePreferenceCenterResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	preferenceCenter, exists := h.preferenceCenters[params.PreferenceCenterExternalID]
+	if !exists {
+		return nil, errNotFound
+	}
+
+	err := validatePreferenceCenterHTML(req.PreferenceCenterPageHTML, req.ConfirmationPageHTML)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	preferenceCenter.PreferenceCenterTitle = optNilStringFromOptString(req.PreferenceCenterTitle)
+	preferenceCenter.PreferenceCenterPageHTML = brazeclient.NewOptNilString(req.PreferenceCenterPageHTML)
+	preferenceCenter.ConfirmationPageHTML = brazeclient.NewOptNilString(req.ConfirmationPageHTML)
+	preferenceCenter.PreferenceCenterOptions = req.Options
+	preferenceCenter.UpdatedAt = brazeclient.NewOptNilDateTime(now)
+
+	if state, ok := req.State.Get(); ok {
+		preferenceCenter.State = brazeclient.NewOptPreferenceCenterState(state)
+	}
+
+	return &brazeclient.UpdatePreferenceCenterResponse{
+		PreferenceCenterAPIID: preferenceCenter.PreferenceCenterAPIID,
+		LiquidTag:             brazeclient.NewOptString(preferenceCenterLiquidTag(preferenceCenter.Name)),
+		CreatedAt:             brazeclient.NewOptDateTime(preferenceCenter.CreatedAt.Or(now)),
+		UpdatedAt:             brazeclient.NewOptDateTime(now),
+		Message:               brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) setPreferenceCenter(preferenceCenterID, name, title, pageHTML, confirmationHTML string, state brazeclient.PreferenceCenterState, options brazeclient.OptPreferenceCenterOptions) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	preferenceCenter := &brazeclient.GetPreferenceCenterResponse{
+		PreferenceCenterAPIID:    preferenceCenterID,
+		Name:                     name,
+		PreferenceCenterPageHTML: brazeclient.NewOptNilString(pageHTML),
+		ConfirmationPageHTML:     brazeclient.NewOptNilString(confirmationHTML),
+		PreferenceCenterOptions:  options,
+		State:                    brazeclient.NewOptPreferenceCenterState(state),
+	}
+
+	if title != "" {
+		preferenceCenter.PreferenceCenterTitle = brazeclient.NewOptNilString(title)
+	}
+
+	h.preferenceCenters[preferenceCenterID] = preferenceCenter
+}
+
+func preferenceCenterLiquidTag(name string) string {
+	return "{{preference_center.${" + name + "}}}"
+}
+
+func optNilStringFromOptString(value brazeclient.OptString) brazeclient.OptNilString {
+	if v, ok := value.Get(); ok {
+		return brazeclient.NewOptNilString(v)
+	}
+
+	return brazeclient.OptNilString{}
+}
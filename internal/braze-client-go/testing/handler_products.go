@@ -0,0 +1,31 @@
+package testing
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+const productsPageSize = 250
+
+func (h *Handler) ListProducts(_ context.Context, params brazeclient.ListProductsParams) (*brazeclient.ListProductsResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	page := max(params.Page.Or(0), 0)
+	products := paginatedItems(slices.Sorted(maps.Keys(h.products)), brazeclient.NewOptInt(productsPageSize), brazeclient.NewOptInt(page*productsPageSize))
+
+	return &brazeclient.ListProductsResponse{
+		Products: slices.Clone(products),
+		Message:  brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) setProduct(productID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.products[productID] = struct{}{}
+}
@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+func (h *Handler) ListSegments(_ context.Context, params brazeclient.ListSegmentsParams) (*brazeclient.ListSegmentsResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ids := slices.Sorted(maps.Keys(h.segments))
+
+	if params.SortDirection.Or(brazeclient.ListSegmentsSortDirectionAsc) == brazeclient.ListSegmentsSortDirectionDesc {
+		slices.Reverse(ids)
+	}
+
+	segments := []brazeclient.ListSegmentsResponseSegmentsItem{}
+
+	for _, id := range exportPage(ids, params.Page.Or(0)) {
+		segment := h.segments[id]
+		segments = append(segments, brazeclient.ListSegmentsResponseSegmentsItem{
+			ID:                       id,
+			Name:                     segment.Name,
+			AnalyticsTrackingEnabled: segment.AnalyticsTrackingEnabled,
+			Tags:                     slices.Clone(segment.Tags),
+		})
+	}
+
+	return &brazeclient.ListSegmentsResponse{
+		Segments: segments,
+		Message:  brazeclient.NewOptString("success"),
+	}, nil
+}
+
+func (h *Handler) GetSegmentDetails(_ context.Context, params brazeclient.GetSegmentDetailsParams) (*brazeclient.GetSegmentDetailsResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	segment, exists := h.segments[params.SegmentID]
+	if !exists {
+		return nil, newBrazeMessageError("Invalid segment_id")
+	}
+
+	return segment, nil
+}
+
+func (h *Handler) setSegment(segmentID, name, description string, tags []string, analyticsTrackingEnabled bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	segment := &brazeclient.GetSegmentDetailsResponse{
+		Name:                     name,
+		AnalyticsTrackingEnabled: brazeclient.NewOptBool(analyticsTrackingEnabled),
+		Tags:                     slices.Clone(tags),
+		CreatedAt:                brazeclient.NewOptDateTime(now),
+		UpdatedAt:                brazeclient.NewOptDateTime(now),
+		Message:                  brazeclient.NewOptString("success"),
+	}
+
+	if description != "" {
+		segment.Description = brazeclient.NewOptNilString(description)
+	}
+
+	h.segments[segmentID] = segment
+}
@@ -0,0 +1,55 @@
+package testing
+
+import (
+	"context"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+// CreateSendID records a send identifier for a campaign. Braze accepts a send
+// identifier that already exists, so creating one is idempotent.
+func (h *Handler) CreateSendID(_ context.Context, req *brazeclient.CreateSendIDRequest) (*brazeclient.CreateSendIDResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, exists := h.campaigns[req.CampaignID]; !exists {
+		return nil, newBrazeMessageError("Invalid campaign_id")
+	}
+
+	err := validateSendID(req.SendID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.addSendID(req.CampaignID, req.SendID)
+
+	return &brazeclient.CreateSendIDResponse{
+		SendID:  brazeclient.NewOptString(req.SendID),
+		Message: brazeclient.NewOptString("success"),
+	}, nil
+}
+
+// addSendID records a send identifier. The caller must hold h.mu.
+func (h *Handler) addSendID(campaignID, sendID string) {
+	if h.sendIDs[campaignID] == nil {
+		h.sendIDs[campaignID] = make(map[string]struct{})
+	}
+
+	h.sendIDs[campaignID][sendID] = struct{}{}
+}
+
+func (h *Handler) setSendID(campaignID, sendID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.addSendID(campaignID, sendID)
+}
+
+func (h *Handler) hasSendID(campaignID, sendID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	_, exists := h.sendIDs[campaignID][sendID]
+
+	return exists
+}
@@ -1,6 +1,14 @@
 package testing
 
-import brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+var errInvalidPageCursor = errors.New("invalid page cursor")
 
 func paginatedItems[T any](items []T, limitOpt, offsetOpt brazeclient.OptInt) []T {
 	offset := offsetOpt.Or(0)
@@ -17,3 +25,33 @@ func paginatedItems[T any](items []T, limitOpt, offsetOpt brazeclient.OptInt) []
 
 	return items[offset:end]
 }
+
+// cursorPageBounds returns the bounds of the page of at most pageSize items
+// starting at cursor, along with the cursor for the next page if there is one.
+// Cursors are the decimal offset of the first item on the page.
+func cursorPageBounds(total int, cursorOpt brazeclient.OptString, pageSize int) (int, int, string, error) {
+	offset := 0
+
+	if cursor, ok := cursorOpt.Get(); ok {
+		parsed, err := strconv.Atoi(cursor)
+		if err != nil || parsed < 0 {
+			return 0, 0, "", fmt.Errorf("%w: %s", errInvalidPageCursor, cursor)
+		}
+
+		offset = parsed
+	}
+
+	offset = min(offset, total)
+	end := min(offset+pageSize, total)
+
+	nextCursor := ""
+	if end < total {
+		nextCursor = strconv.Itoa(end)
+	}
+
+	return offset, end, nextCursor, nil
+}
+
+func nextPageLinkHeader(path string, nextCursor string) string {
+	return fmt.Sprintf(`<%s?cursor=%s>; rel="next"`, path, nextCursor)
+}
@@ -1,7 +1,6 @@
 package testing
 
 import (
-	"context"
 	"net/http"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
@@ -10,30 +9,28 @@ import (
 type Server struct {
 	server *brazeclient.Server
 
-	handler *Handler
+	handler  *Handler
+	faults   *faultInjector
+	security *apiKeySecurityHandler
 }
 
 var _ http.Handler = (*Server)(nil)
 
-type noOpSecurityHandler struct{}
-
-//revive:disable:var-naming
-func (noOpSecurityHandler) HandleBrazeApiKey(ctx context.Context, _ brazeclient.OperationName, _ brazeclient.BrazeApiKey) (context.Context, error) {
-	return ctx, nil
-}
-
 func NewBrazeServer() (*Server, error) {
 	handler := NewBrazeHandler()
+	security := newAPIKeySecurityHandler()
 
-	server, err := brazeclient.NewServer(handler, noOpSecurityHandler{})
+	server, err := brazeclient.NewServer(handler, security, brazeclient.WithMiddleware(handler.countCallsMiddleware))
 	if err != nil {
 		//nolint:wrapcheck
 		return nil, err
 	}
 
 	return &Server{
-		server:  server,
-		handler: handler,
+		server:   server,
+		handler:  handler,
+		faults:   newFaultInjector(),
+		security: security,
 	}, nil
 }
 
@@ -41,6 +38,10 @@ func (s *Server) Handler() *Handler {
 	return s.handler
 }
 
+func (s *Server) CallCount(operation brazeclient.OperationName) int {
+	return s.handler.CallCount(operation)
+}
+
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	s.server.ServeHTTP(w, r)
+	s.serveWithFaults(w, r)
 }
@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+// apiKeySecurityHandler checks the API key of each request against the
+// permissions registered for it. Until a key is registered any key is
+// accepted, so that tests which do not care about permissions need not set
+// them up.
+type apiKeySecurityHandler struct {
+	mu sync.Mutex
+
+	permissions map[string]map[string]struct{}
+	scimToken   string
+}
+
+var _ brazeclient.SecurityHandler = (*apiKeySecurityHandler)(nil)
+
+func newAPIKeySecurityHandler() *apiKeySecurityHandler {
+	return &apiKeySecurityHandler{
+		permissions: make(map[string]map[string]struct{}),
+	}
+}
+
+// SetAPIKeyPermissions registers an API key with the Braze permissions it is
+// granted, for example "content_blocks.update". Once any key is registered,
+// requests with an unknown key are rejected with 401 Unauthorized and
+// requests for an operation outside the key's permissions with 403
+// Forbidden.
+func (s *Server) SetAPIKeyPermissions(apiKey string, permissions ...string) {
+	s.security.mu.Lock()
+	defer s.security.mu.Unlock()
+
+	granted := make(map[string]struct{}, len(permissions))
+	for _, permission := range permissions {
+		granted[permission] = struct{}{}
+	}
+
+	s.security.permissions[apiKey] = granted
+}
+
+// SetSCIMToken registers the SCIM token that dashboard user requests must
+// present. Until it is set any SCIM token is accepted.
+func (s *Server) SetSCIMToken(token string) {
+	s.security.mu.Lock()
+	defer s.security.mu.Unlock()
+
+	s.security.scimToken = token
+}
+
+//revive:disable:var-naming
+func (h *apiKeySecurityHandler) HandleBrazeApiKey(ctx context.Context, _ brazeclient.OperationName, t brazeclient.BrazeApiKey) (context.Context, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.permissions) == 0 {
+		return ctx, nil
+	}
+
+	granted, exists := h.permissions[t.Token]
+	if !exists {
+		return ctx, brazeError{StatusCode: http.StatusUnauthorized, Message: "Invalid API key"}
+	}
+
+	for _, role := range t.Roles {
+		if _, ok := granted[role]; !ok {
+			return ctx, brazeError{StatusCode: http.StatusForbidden, Message: "You do not have access to this resource"}
+		}
+	}
+
+	return ctx, nil
+}
+
+func (h *apiKeySecurityHandler) HandleBrazeScimToken(ctx context.Context, _ brazeclient.OperationName, t brazeclient.BrazeScimToken) (context.Context, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.scimToken != "" && t.Token != h.scimToken {
+		return ctx, brazeError{StatusCode: http.StatusUnauthorized, Message: "Invalid SCIM token"}
+	}
+
+	return ctx, nil
+}
@@ -0,0 +1,133 @@
+package testing_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	brazetesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+)
+
+func TestServerAPIKeyPermissions(t *testing.T) {
+	t.Parallel()
+
+	server, ts := newFaultTestServer(t)
+
+	server.SetAPIKeyPermissions("read-only", "content_blocks.list", "content_blocks.info")
+
+	tests := map[string]struct {
+		apiKey             string
+		method             string
+		path               string
+		expectedStatusCode int
+	}{
+		"permitted": {
+			apiKey:             "read-only",
+			method:             http.MethodGet,
+			path:               "/content_blocks/list",
+			expectedStatusCode: http.StatusOK,
+		},
+		"missing permission": {
+			apiKey:             "read-only",
+			method:             http.MethodPost,
+			path:               "/content_blocks/create",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		"unknown key": {
+			apiKey:             "unknown",
+			method:             http.MethodGet,
+			path:               "/content_blocks/list",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), test.method, ts.URL+test.path, strings.NewReader(`{"name":"greeting","content":"Hello"}`))
+			if err != nil {
+				t.Fatalf("http.NewRequestWithContext() error = %v", err)
+			}
+
+			req.Header.Set("Authorization", "Bearer "+test.apiKey)
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("http.DefaultClient.Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != test.expectedStatusCode {
+				t.Errorf("expected status code %d, got %d", test.expectedStatusCode, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestNewWorkspacesByAPIKey(t *testing.T) {
+	t.Parallel()
+
+	production, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	production.SetContentBlock("cb-1", "production-block", "", "", nil)
+
+	staging, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	ts := httptest.NewServer(brazetesting.NewWorkspacesByAPIKey(map[string]http.Handler{
+		"production-key": production,
+		"staging-key":    staging,
+	}))
+	t.Cleanup(ts.Close)
+
+	tests := map[string]struct {
+		apiKey             string
+		expectedStatusCode int
+		expectedBlock      bool
+	}{
+		"production":  {apiKey: "production-key", expectedStatusCode: http.StatusOK, expectedBlock: true},
+		"staging":     {apiKey: "staging-key", expectedStatusCode: http.StatusOK},
+		"unknown key": {apiKey: "unknown-key", expectedStatusCode: http.StatusUnauthorized},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL+"/content_blocks/list", nil)
+			if err != nil {
+				t.Fatalf("http.NewRequestWithContext() error = %v", err)
+			}
+
+			req.Header.Set("Authorization", "Bearer "+test.apiKey)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("http.DefaultClient.Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("io.ReadAll() error = %v", err)
+			}
+
+			if resp.StatusCode != test.expectedStatusCode {
+				t.Errorf("expected status code %d, got %d", test.expectedStatusCode, resp.StatusCode)
+			}
+
+			if found := strings.Contains(string(body), "production-block"); found != test.expectedBlock {
+				t.Errorf("expected production-block listed %t, got %t", test.expectedBlock, found)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package testing
+
+// SetCampaign adds or replaces a campaign. scheduleType is one of the
+// schedule types Braze reports, for example "api_triggered".
+func (s *Server) SetCampaign(campaignID, name string, tags []string, scheduleType string, archived bool) {
+	s.handler.setCampaign(campaignID, name, tags, scheduleType, archived)
+}
@@ -0,0 +1,7 @@
+package testing
+
+// SetCanvas adds or replaces a Canvas. scheduleType is one of the schedule
+// types Braze reports, for example "api_triggered".
+func (s *Server) SetCanvas(canvasID, name string, tags []string, scheduleType string, archived bool) {
+	s.handler.setCanvas(canvasID, name, tags, scheduleType, archived)
+}
@@ -0,0 +1,19 @@
+package testing
+
+// SetCustomAttribute adds or replaces a custom attribute. dataType is one of
+// the data types Braze reports, for example "String", and status is "Active"
+// or "Blocklisted".
+func (s *Server) SetCustomAttribute(name, dataType, description string, tags []string, status string) {
+	s.handler.setCustomAttribute(name, dataType, description, tags, status)
+}
+
+// SetCustomEvent adds or replaces a custom event. status is "Active" or
+// "Blocklisted".
+func (s *Server) SetCustomEvent(name, description string, tags []string, status string) {
+	s.handler.setCustomEvent(name, description, tags, status)
+}
+
+// SetProduct adds the product identifier of a logged purchase.
+func (s *Server) SetProduct(productID string) {
+	s.handler.setProduct(productID)
+}
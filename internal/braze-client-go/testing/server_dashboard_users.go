@@ -0,0 +1,9 @@
+package testing
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+func (s *Server) SetDashboardUser(userID, email, givenName, familyName, department string, permissions brazeclient.DashboardUserPermissions) {
+	s.handler.setDashboardUser(userID, email, givenName, familyName, department, permissions)
+}
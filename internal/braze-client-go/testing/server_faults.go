@@ -0,0 +1,229 @@
+package testing
+
+import (
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+// Fault describes a failure injected into requests for an operation.
+type Fault struct {
+	// Times is the number of requests the fault applies to. Zero applies it
+	// to every request until the faults are cleared.
+	Times int
+
+	// Latency delays the request before it is handled.
+	Latency time.Duration
+
+	// StatusCode, when set, is returned instead of handling the request.
+	StatusCode int
+	// RetryAfter, when set, is returned as the Retry-After header alongside
+	// StatusCode.
+	RetryAfter time.Duration
+
+	// DropAfterHandle handles the request, committing any change it makes,
+	// and then drops the connection without sending the response.
+	DropAfterHandle bool
+}
+
+type faultRule struct {
+	fault     Fault
+	remaining int
+}
+
+type rateLimitQuota struct {
+	limit   int
+	window  time.Duration
+	resetAt time.Time
+	used    int
+}
+
+// faultInjector applies programmed faults and an optional request quota to
+// the requests a Server receives.
+type faultInjector struct {
+	mu sync.Mutex
+
+	now       func() time.Time
+	rules     map[brazeclient.OperationName][]*faultRule
+	rateLimit *rateLimitQuota
+}
+
+func newFaultInjector() *faultInjector {
+	return &faultInjector{
+		now:   time.Now,
+		rules: make(map[brazeclient.OperationName][]*faultRule),
+	}
+}
+
+// AddFault programs a fault for requests to the given operation. Faults for
+// an operation are applied in the order they were added, each for its
+// configured number of requests.
+func (s *Server) AddFault(operation brazeclient.OperationName, fault Fault) {
+	s.faults.mu.Lock()
+	defer s.faults.mu.Unlock()
+
+	s.faults.rules[operation] = append(s.faults.rules[operation], &faultRule{fault: fault, remaining: fault.Times})
+}
+
+// ClearFaults removes all programmed faults.
+func (s *Server) ClearFaults() {
+	s.faults.mu.Lock()
+	defer s.faults.mu.Unlock()
+
+	clear(s.faults.rules)
+}
+
+// PendingFaults returns the number of requests to the given operation that
+// faults added with a non-zero Times have still to be applied to.
+func (s *Server) PendingFaults(operation brazeclient.OperationName) int {
+	s.faults.mu.Lock()
+	defer s.faults.mu.Unlock()
+
+	pending := 0
+
+	for _, rule := range s.faults.rules[operation] {
+		pending += rule.remaining
+	}
+
+	return pending
+}
+
+// SetRateLimit limits the server to limit requests per window, across all
+// operations. Every response carries the X-Ratelimit-Limit,
+// X-Ratelimit-Remaining and X-Ratelimit-Reset headers, and requests over
+// the limit are rejected with 429 Too Many Requests. A limit of zero removes
+// the quota.
+func (s *Server) SetRateLimit(limit int, window time.Duration) {
+	s.faults.mu.Lock()
+	defer s.faults.mu.Unlock()
+
+	if limit <= 0 {
+		s.faults.rateLimit = nil
+
+		return
+	}
+
+	s.faults.rateLimit = &rateLimitQuota{limit: limit, window: window}
+}
+
+// next returns the fault to apply to a request for the operation, consuming
+// one use of it.
+func (f *faultInjector) next(operation brazeclient.OperationName) (Fault, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	rules := f.rules[operation]
+	for len(rules) > 0 {
+		rule := rules[0]
+		if rule.fault.Times == 0 {
+			return rule.fault, true
+		}
+
+		if rule.remaining > 0 {
+			rule.remaining--
+
+			return rule.fault, true
+		}
+
+		rules = rules[1:]
+		f.rules[operation] = rules
+	}
+
+	return Fault{}, false
+}
+
+// take consumes one request from the quota, returning the rate limit headers
+// to send and whether the request is allowed.
+func (f *faultInjector) take() (http.Header, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	quota := f.rateLimit
+	if quota == nil {
+		return nil, true
+	}
+
+	now := f.now()
+	if !now.Before(quota.resetAt) {
+		quota.resetAt = now.Add(quota.window)
+		quota.used = 0
+	}
+
+	allowed := quota.used < quota.limit
+	if allowed {
+		quota.used++
+	}
+
+	header := http.Header{}
+	header.Set("X-Ratelimit-Limit", strconv.Itoa(quota.limit))
+	header.Set("X-Ratelimit-Remaining", strconv.Itoa(quota.limit-quota.used))
+	header.Set("X-Ratelimit-Reset", strconv.FormatInt(ceilUnix(quota.resetAt), 10))
+
+	if !allowed {
+		header.Set("Retry-After", strconv.FormatInt(ceilUnix(quota.resetAt)-now.Unix(), 10))
+	}
+
+	return header, allowed
+}
+
+func ceilUnix(t time.Time) int64 {
+	if t.Equal(time.Unix(t.Unix(), 0)) {
+		return t.Unix()
+	}
+
+	return t.Unix() + 1
+}
+
+func (s *Server) serveWithFaults(w http.ResponseWriter, r *http.Request) {
+	header, allowed := s.faults.take()
+	maps.Copy(w.Header(), header)
+
+	if !allowed {
+		w.WriteHeader(http.StatusTooManyRequests)
+
+		return
+	}
+
+	var operation brazeclient.OperationName
+	if route, ok := s.server.FindRoute(r.Method, r.URL.Path); ok {
+		operation = route.Name()
+	}
+
+	fault, ok := s.faults.next(operation)
+	if !ok {
+		s.server.ServeHTTP(w, r)
+
+		return
+	}
+
+	if fault.Latency > 0 {
+		select {
+		case <-time.After(fault.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if fault.StatusCode != 0 {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.FormatInt(int64((fault.RetryAfter+time.Second-1)/time.Second), 10))
+		}
+
+		w.WriteHeader(fault.StatusCode)
+
+		return
+	}
+
+	if fault.DropAfterHandle {
+		s.server.ServeHTTP(httptest.NewRecorder(), r)
+
+		panic(http.ErrAbortHandler)
+	}
+
+	s.server.ServeHTTP(w, r)
+}
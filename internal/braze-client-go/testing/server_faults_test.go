@@ -0,0 +1,175 @@
+package testing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazetesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+)
+
+func TestServerFaultStatusCode(t *testing.T) {
+	t.Parallel()
+
+	server, ts := newFaultTestServer(t)
+
+	server.AddFault(brazeclient.ListCatalogsOperation, brazetesting.Fault{Times: 2, StatusCode: http.StatusServiceUnavailable, RetryAfter: 1500 * time.Millisecond})
+
+	if pending := server.PendingFaults(brazeclient.ListCatalogsOperation); pending != 2 {
+		t.Errorf("expected 2 pending faults, got %d", pending)
+	}
+
+	for range 2 {
+		resp := getCatalogs(t, ts.URL)
+
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("expected status code %d, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+		}
+
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "2" {
+			t.Errorf("expected Retry-After 2, got %q", retryAfter)
+		}
+	}
+
+	if pending := server.PendingFaults(brazeclient.ListCatalogsOperation); pending != 0 {
+		t.Errorf("expected no pending faults, got %d", pending)
+	}
+
+	if resp := getCatalogs(t, ts.URL); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code %d after fault is exhausted, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	if count := server.CallCount(brazeclient.ListCatalogsOperation); count != 1 {
+		t.Errorf("expected 1 handled ListCatalogs call, got %d", count)
+	}
+}
+
+func TestServerFaultLatency(t *testing.T) {
+	t.Parallel()
+
+	server, ts := newFaultTestServer(t)
+
+	server.AddFault(brazeclient.ListCatalogsOperation, brazetesting.Fault{Times: 1, Latency: 50 * time.Millisecond})
+
+	start := time.Now()
+
+	if resp := getCatalogs(t, ts.URL); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected request to take at least 50ms, took %s", elapsed)
+	}
+}
+
+func TestServerFaultDropAfterHandle(t *testing.T) {
+	t.Parallel()
+
+	server, ts := newFaultTestServer(t)
+
+	server.AddFault(brazeclient.ListCatalogsOperation, brazetesting.Fault{Times: 1, DropAfterHandle: true})
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL+"/catalogs", nil)
+	if err != nil {
+		t.Fatalf("http.NewRequestWithContext() error = %v", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer test")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("expected dropped connection, got status code %d", resp.StatusCode)
+	}
+
+	if count := server.CallCount(brazeclient.ListCatalogsOperation); count != 1 {
+		t.Errorf("expected the dropped request to be handled, got %d calls", count)
+	}
+
+	server.ClearFaults()
+
+	if resp := getCatalogs(t, ts.URL); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server, ts := newFaultTestServer(t)
+
+	server.SetRateLimit(2, time.Minute)
+
+	for i := range 2 {
+		resp := getCatalogs(t, ts.URL)
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+
+		if remaining := resp.Header.Get("X-Ratelimit-Remaining"); remaining != strconv.Itoa(1-i) {
+			t.Errorf("expected X-Ratelimit-Remaining %d, got %q", 1-i, remaining)
+		}
+	}
+
+	resp := getCatalogs(t, ts.URL)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status code %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+
+	resetAt, err := strconv.ParseInt(resp.Header.Get("X-Ratelimit-Reset"), 10, 64)
+	if err != nil {
+		t.Fatalf("expected numeric X-Ratelimit-Reset, got %q", resp.Header.Get("X-Ratelimit-Reset"))
+	}
+
+	if delay := time.Until(time.Unix(resetAt, 0)); delay <= 0 || delay > time.Minute+time.Second {
+		t.Errorf("expected reset within the window, got %s", delay)
+	}
+
+	if resp.Header.Get("Retry-After") == "" {
+		t.Error("expected Retry-After header on rate limited response")
+	}
+
+	server.SetRateLimit(0, 0)
+
+	if resp := getCatalogs(t, ts.URL); resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status code %d once the limit is removed, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func newFaultTestServer(t *testing.T) (*brazetesting.Server, *httptest.Server) {
+	t.Helper()
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	return server, ts
+}
+
+func getCatalogs(t *testing.T, baseURL string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, baseURL+"/catalogs", nil)
+	if err != nil {
+		t.Fatalf("http.NewRequestWithContext() error = %v", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer test")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("http.DefaultClient.Do() error = %v", err)
+	}
+
+	resp.Body.Close()
+
+	return resp
+}
@@ -0,0 +1,9 @@
+package testing
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+func (s *Server) SetPreferenceCenter(preferenceCenterID, name, title, pageHTML, confirmationHTML string, state brazeclient.PreferenceCenterState, options brazeclient.OptPreferenceCenterOptions) {
+	s.handler.setPreferenceCenter(preferenceCenterID, name, title, pageHTML, confirmationHTML, state, options)
+}
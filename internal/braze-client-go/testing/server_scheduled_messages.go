@@ -0,0 +1,16 @@
+package testing
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+// SetScheduledMessage adds or replaces a message scheduled with req.
+func (s *Server) SetScheduledMessage(scheduleID string, req brazeclient.CreateScheduledMessageRequest) {
+	s.handler.setScheduledMessage(scheduleID, req)
+}
+
+// ScheduledMessage returns the request that scheduled a message, with any
+// updates applied, and whether the message is still scheduled.
+func (s *Server) ScheduledMessage(scheduleID string) (brazeclient.CreateScheduledMessageRequest, bool) {
+	return s.handler.getScheduledMessage(scheduleID)
+}
@@ -0,0 +1,7 @@
+package testing
+
+// SetSegment adds or replaces a segment. An empty description leaves the
+// segment without one.
+func (s *Server) SetSegment(segmentID, name, description string, tags []string, analyticsTrackingEnabled bool) {
+	s.handler.setSegment(segmentID, name, description, tags, analyticsTrackingEnabled)
+}
@@ -0,0 +1,11 @@
+package testing
+
+// SetSendID adds a send identifier for a campaign.
+func (s *Server) SetSendID(campaignID, sendID string) {
+	s.handler.setSendID(campaignID, sendID)
+}
+
+// HasSendID reports whether a send identifier exists for a campaign.
+func (s *Server) HasSendID(campaignID, sendID string) bool {
+	return s.handler.hasSendID(campaignID, sendID)
+}
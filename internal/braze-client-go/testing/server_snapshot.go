@@ -0,0 +1,304 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Snapshot returns the objects held by the server as canonical JSON: objects
+// are sorted by identifier and volatile values such as timestamps are left
+// out, so that snapshots of equal state are byte-for-byte identical.
+func (s *Server) Snapshot() ([]byte, error) {
+	data, err := canonicalJSON(s.Fixture())
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot: %w", err)
+	}
+
+	var snapshot bytes.Buffer
+
+	err = json.Indent(&snapshot, data, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("indent snapshot: %w", err)
+	}
+
+	return snapshot.Bytes(), nil
+}
+
+// canonicalJSON marshals value with the keys of every object sorted. The
+// generated client encodes free-form objects, such as message payloads, in
+// map iteration order, so their JSON is re-encoded through encoding/json.
+func canonicalJSON(value any) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var generic any
+
+	err = decoder.Decode(&generic)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return json.Marshal(generic) //nolint:wrapcheck
+}
+
+// Restore replaces the objects held by the server with those in a snapshot.
+// The snapshot is loaded into a separate handler first, so that requests
+// served during a restore see either the old objects or the new ones.
+func (s *Server) Restore(snapshot []byte) error {
+	var fixture Fixture
+
+	err := json.Unmarshal(snapshot, &fixture)
+	if err != nil {
+		return fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+
+	restored := &Server{handler: NewBrazeHandler()}
+	restored.LoadFixture(fixture)
+
+	s.handler.replaceObjects(restored.handler)
+
+	return nil
+}
+
+// replaceObjects replaces the objects held by h with those held by from,
+// which must not be used afterwards. Call counts are kept.
+func (h *Handler) replaceObjects(from *Handler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.contentBlocks = from.contentBlocks
+	h.emailTemplates = from.emailTemplates
+	h.catalogs = from.catalogs
+	h.catalogItems = from.catalogItems
+	h.preferenceCenters = from.preferenceCenters
+	h.dashboardUsers = from.dashboardUsers
+	h.campaigns = from.campaigns
+	h.canvases = from.canvases
+	h.segments = from.segments
+	h.customAttributes = from.customAttributes
+	h.customEvents = from.customEvents
+	h.products = from.products
+	h.scheduledMessages = from.scheduledMessages
+	h.campaignTriggerSchedules = from.campaignTriggerSchedules
+	h.canvasTriggerSchedules = from.canvasTriggerSchedules
+	h.sendIDs = from.sendIDs
+}
+
+// SnapshotDiff lists the objects that differ between two snapshots. Objects
+// are identified by kind and identifier, for example "content_block/abc" or
+// "catalog_item/products/sku-1".
+type SnapshotDiff struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+func (d SnapshotDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+func (d SnapshotDiff) String() string {
+	var b strings.Builder
+
+	for _, change := range []struct {
+		prefix string
+		keys   []string
+	}{
+		{"+ ", d.Added},
+		{"- ", d.Removed},
+		{"~ ", d.Changed},
+	} {
+		for _, key := range change.keys {
+			b.WriteString(change.prefix)
+			b.WriteString(key)
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// DiffSnapshots compares two snapshots taken with Server.Snapshot.
+func DiffSnapshots(before, after []byte) (SnapshotDiff, error) {
+	beforeObjects, err := snapshotObjects(before)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	afterObjects, err := snapshotObjects(after)
+	if err != nil {
+		return SnapshotDiff{}, err
+	}
+
+	diff := SnapshotDiff{}
+
+	for _, key := range slices.Sorted(maps.Keys(afterObjects)) {
+		beforeObject, existed := beforeObjects[key]
+
+		switch {
+		case !existed:
+			diff.Added = append(diff.Added, key)
+		case beforeObject != afterObjects[key]:
+			diff.Changed = append(diff.Changed, key)
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(beforeObjects)) {
+		if _, exists := afterObjects[key]; !exists {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+
+	return diff, nil
+}
+
+// snapshotObjects flattens a snapshot into the canonical JSON of each object,
+// keyed by kind and identifier. Catalog items are keyed separately from their
+// catalog so that changing an item does not report the catalog as changed.
+func snapshotObjects(snapshot []byte) (map[string]string, error) {
+	var fixture Fixture
+
+	err := json.Unmarshal(snapshot, &fixture)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+
+	objects := map[string]string{}
+
+	add := func(key string, object any) error {
+		data, err := canonicalJSON(object)
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", key, err)
+		}
+
+		objects[key] = string(data)
+
+		return nil
+	}
+
+	for _, block := range fixture.ContentBlocks {
+		err := add("content_block/"+block.ID, block)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, template := range fixture.EmailTemplates {
+		err := add("email_template/"+template.ID, template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, catalog := range fixture.Catalogs {
+		items := catalog.Items
+		catalog.Items = nil
+
+		err := add("catalog/"+catalog.Name, catalog)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			err := add("catalog_item/"+catalog.Name+"/"+item.ID, item)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, preferenceCenter := range fixture.PreferenceCenters {
+		err := add("preference_center/"+preferenceCenter.ID, preferenceCenter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, user := range fixture.DashboardUsers {
+		err := add("dashboard_user/"+user.ID, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, campaign := range fixture.Campaigns {
+		err := add("campaign/"+campaign.ID, campaign)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, canvas := range fixture.Canvases {
+		err := add("canvas/"+canvas.ID, canvas)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, segment := range fixture.Segments {
+		err := add("segment/"+segment.ID, segment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, attribute := range fixture.CustomAttributes {
+		err := add("custom_attribute/"+attribute.Name, attribute)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, event := range fixture.CustomEvents {
+		err := add("custom_event/"+event.Name, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, productID := range fixture.Products {
+		err := add("product/"+productID, productID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, message := range fixture.ScheduledMessages {
+		err := add("scheduled_message/"+message.ID, message)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, schedule := range fixture.CampaignTriggerSchedules {
+		err := add("campaign_trigger_schedule/"+schedule.ID, schedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, schedule := range fixture.CanvasTriggerSchedules {
+		err := add("canvas_trigger_schedule/"+schedule.ID, schedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, sendID := range fixture.SendIDs {
+		err := add("send_id/"+sendID.CampaignID+"/"+sendID.SendID, sendID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,179 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazetesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/go-faster/jx"
+)
+
+func TestServerSnapshotRestoreAndDiff(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	server.SetContentBlock("cb-1", "greeting", "Hello", "", nil)
+	server.SetContentBlock("cb-2", "farewell", "Goodbye", "", nil)
+	server.SetCatalog("products", "", []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}})
+	server.SetCatalogItem("products", "sku-1", map[string]json.RawMessage{"price": json.RawMessage(`1`)})
+
+	before, err := server.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	again, err := server.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	if string(before) != string(again) {
+		t.Errorf("expected snapshots of unchanged state to be identical")
+	}
+
+	err = server.Restore([]byte(`{"content_blocks":[{"id":"cb-2","name":"farewell","content":"Goodbye"}]}`))
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	server.SetContentBlock("cb-1", "greeting", "Hi", "", nil)
+	server.SetEmailTemplate("et-1", "welcome", "Welcome", "", "", "", nil, nil)
+	server.SetCatalog("products", "", []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}})
+	server.SetCatalogItem("products", "sku-1", map[string]json.RawMessage{"price": json.RawMessage(`2`)})
+	server.SetCatalogItem("products", "sku-2", nil)
+
+	after, err := server.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	diff, err := brazetesting.DiffSnapshots(before, after)
+	if err != nil {
+		t.Fatalf("DiffSnapshots() error = %v", err)
+	}
+
+	if expected := []string{"catalog_item/products/sku-2", "email_template/et-1"}; !slices.Equal(diff.Added, expected) {
+		t.Errorf("expected added %v, got %v", expected, diff.Added)
+	}
+
+	if len(diff.Removed) != 0 {
+		t.Errorf("expected nothing removed, got %v", diff.Removed)
+	}
+
+	if expected := []string{"catalog_item/products/sku-1", "content_block/cb-1"}; !slices.Equal(diff.Changed, expected) {
+		t.Errorf("expected changed %v, got %v", expected, diff.Changed)
+	}
+
+	err = server.Restore(before)
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	restored, err := server.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	if diff, err := brazetesting.DiffSnapshots(before, restored); err != nil || !diff.Empty() {
+		t.Errorf("expected restored state to match snapshot, got diff %q (error %v)", diff, err)
+	}
+}
+
+func TestServerRestoreIsAtomic(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	server.SetContentBlock("cb-1", "greeting", "Hello", "", nil)
+	server.SetContentBlock("cb-2", "farewell", "Goodbye", "", nil)
+
+	first, err := server.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	err = server.Restore([]byte(`{"content_blocks":[{"id":"cb-3","name":"reminder","content":"Soon"}]}`))
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	second, err := server.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := range 1000 {
+			err := server.Restore([][]byte{first, second}[i%2])
+			if err != nil {
+				t.Errorf("Restore() error = %v", err)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		blocks := server.Fixture().ContentBlocks
+		if len(blocks) != 2 && (len(blocks) != 1 || blocks[0].ID != "cb-3") {
+			t.Errorf("expected the content blocks of either restored state, got %v", blocks)
+			<-done
+
+			return
+		}
+	}
+}
+
+func TestServerSnapshotCanonicalPayloads(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	payload := brazeclient.ScheduledMessagePayload{}
+	for _, key := range []string{"app_id", "body", "from", "subject", "preheader", "reply_to"} {
+		payload[key] = jx.Raw(`"` + key + `"`)
+	}
+
+	server.SetScheduledMessage("schedule-1", brazeclient.CreateScheduledMessageRequest{
+		Broadcast: brazeclient.NewOptBool(true),
+		Schedule:  brazeclient.MessageSchedule{Time: time.Date(2030, time.January, 1, 9, 0, 0, 0, time.UTC)},
+		Messages:  brazeclient.ScheduledMessages{Email: brazeclient.NewOptScheduledMessagePayload(payload)},
+	})
+
+	first, err := server.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	for range 10 {
+		again, err := server.Snapshot()
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+
+		if string(first) != string(again) {
+			t.Fatalf("expected snapshots of unchanged state to be identical, got %s and %s", first, again)
+		}
+	}
+}
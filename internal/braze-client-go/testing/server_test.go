@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
 	brazetesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
 )
 
@@ -51,3 +52,39 @@ func TestServerServeHTTP(t *testing.T) {
 		t.Errorf("expected status code %d, got %d", http.StatusNotFound, resp.StatusCode)
 	}
 }
+
+func TestServerCallCount(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL+"/catalogs", nil)
+		if err != nil {
+			t.Fatalf("http.NewRequestWithContext() error = %v", err)
+		}
+
+		req.Header.Set("Authorization", "Bearer test")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("http.DefaultClient.Do() error = %v", err)
+		}
+
+		resp.Body.Close()
+	}
+
+	if count := server.CallCount(brazeclient.ListCatalogsOperation); count != 2 {
+		t.Errorf("expected 2 ListCatalogs calls, got %d", count)
+	}
+
+	if count := server.CallCount(brazeclient.CreateCatalogOperation); count != 0 {
+		t.Errorf("expected 0 CreateCatalog calls, got %d", count)
+	}
+}
@@ -0,0 +1,29 @@
+package testing
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+// SetCampaignTriggerSchedule adds or replaces a schedule for an
+// API-triggered campaign.
+func (s *Server) SetCampaignTriggerSchedule(scheduleID string, req brazeclient.CreateCampaignTriggerScheduleRequest) {
+	s.handler.setCampaignTriggerSchedule(scheduleID, req)
+}
+
+// CampaignTriggerSchedule returns the request that created a campaign
+// schedule, with any updates applied, and whether it is still scheduled.
+func (s *Server) CampaignTriggerSchedule(scheduleID string) (brazeclient.CreateCampaignTriggerScheduleRequest, bool) {
+	return s.handler.getCampaignTriggerSchedule(scheduleID)
+}
+
+// SetCanvasTriggerSchedule adds or replaces a schedule for an API-triggered
+// Canvas.
+func (s *Server) SetCanvasTriggerSchedule(scheduleID string, req brazeclient.CreateCanvasTriggerScheduleRequest) {
+	s.handler.setCanvasTriggerSchedule(scheduleID, req)
+}
+
+// CanvasTriggerSchedule returns the request that created a Canvas schedule,
+// with any updates applied, and whether it is still scheduled.
+func (s *Server) CanvasTriggerSchedule(scheduleID string) (brazeclient.CreateCanvasTriggerScheduleRequest, bool) {
+	return s.handler.getCanvasTriggerSchedule(scheduleID)
+}
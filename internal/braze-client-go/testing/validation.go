@@ -0,0 +1,302 @@
+package testing
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/go-faster/jx"
+)
+
+// Limits documented for the Braze REST API.
+const (
+	contentBlockNameMaxLength        = 100
+	contentBlockDescriptionMaxLength = 250
+	catalogNameMaxLength             = 250
+	catalogDescriptionMaxLength      = 250
+	catalogItemIDMaxLength           = 250
+	preferenceCenterNameMaxLength    = 100
+	sendIDMaxLength                  = 64
+)
+
+// brazeIdentifierPattern matches the names Braze accepts for content blocks
+// and catalogs, and the ids it accepts for catalog items and sends.
+var brazeIdentifierPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// validateContentBlockName checks a content block name against the Braze
+// naming rules. exceptID is the content block being updated, whose own name
+// does not count as a duplicate.
+func (h *Handler) validateContentBlockName(name, exceptID string) error {
+	switch {
+	case name == "":
+		return newBrazeMessageError("Content Block name cannot be blank")
+	case len(name) > contentBlockNameMaxLength:
+		return newBrazeMessageError("Content Block name must be 100 characters or less")
+	case !brazeIdentifierPattern.MatchString(name):
+		return newBrazeMessageError("Content Block name can only contain alphanumeric characters, dashes and underscores")
+	}
+
+	for id, block := range h.contentBlocks {
+		if id != exceptID && block.Name == name {
+			return newBrazeMessageError("Content Block name must be unique")
+		}
+	}
+
+	return nil
+}
+
+func validateContentBlockDescription(description brazeclient.OptNilString) error {
+	if value, ok := description.Get(); ok && len(value) > contentBlockDescriptionMaxLength {
+		return newBrazeMessageError("Content Block description must be 250 characters or less")
+	}
+
+	return nil
+}
+
+// validatePreferenceCenterName checks a new preference center name against
+// the Braze naming rules. Preference centers cannot be renamed.
+func (h *Handler) validatePreferenceCenterName(name string) error {
+	switch {
+	case name == "":
+		return newBrazeMessageError("Preference Center name cannot be blank")
+	case len(name) > preferenceCenterNameMaxLength:
+		return newBrazeMessageError("Preference Center name must be 100 characters or less")
+	case !brazeIdentifierPattern.MatchString(name):
+		return newBrazeMessageError("Preference Center name can only contain alphanumeric characters, dashes and underscores")
+	}
+
+	for _, preferenceCenter := range h.preferenceCenters {
+		if preferenceCenter.Name == name {
+			return newBrazeMessageError("Preference Center name must be unique")
+		}
+	}
+
+	return nil
+}
+
+func validatePreferenceCenterHTML(pageHTML, confirmationHTML string) error {
+	switch {
+	case pageHTML == "":
+		return newBrazeMessageError("Preference Center page HTML cannot be blank")
+	case confirmationHTML == "":
+		return newBrazeMessageError("Confirmation page HTML cannot be blank")
+	}
+
+	return nil
+}
+
+// validateDashboardUserEmail checks the email of a new dashboard user. Emails
+// are unique across the company and cannot be changed.
+func (h *Handler) validateDashboardUserEmail(email string) error {
+	if !strings.Contains(email, "@") {
+		return newBrazeMessageError("userName must be a valid email address")
+	}
+
+	for _, user := range h.dashboardUsers {
+		if strings.EqualFold(user.UserName, email) {
+			return brazeError{StatusCode: http.StatusConflict, Message: "User already exists"}
+		}
+	}
+
+	return nil
+}
+
+func validateDashboardUser(name brazeclient.DashboardUserName, department string) error {
+	switch {
+	case name.GivenName == "" || name.FamilyName == "":
+		return newBrazeMessageError("name.givenName and name.familyName are required")
+	case department == "":
+		return newBrazeMessageError("department is required")
+	}
+
+	return nil
+}
+
+// validateScheduledMessageAudience checks that a scheduled message is sent
+// either to listed users or, as a broadcast, to a segment or Connected
+// Audience.
+func validateScheduledMessageAudience(req *brazeclient.CreateScheduledMessageRequest) error {
+	broadcast := req.Broadcast.Or(false)
+
+	switch {
+	case broadcast && len(req.ExternalUserIds) > 0:
+		return newBrazeMessageError("Broadcast messages cannot specify external_user_ids")
+	case !broadcast && len(req.ExternalUserIds) == 0:
+		return newBrazeMessageError("Either broadcast or external_user_ids must be provided")
+	case broadcast && !req.SegmentID.IsSet() && !req.Audience.IsSet():
+		return newBrazeMessageError("Broadcast messages must specify a segment_id or audience")
+	}
+
+	return nil
+}
+
+func validateMessageSchedule(schedule brazeclient.MessageSchedule) error {
+	if schedule.InLocalTime.Or(false) && schedule.AtOptimalTime.Or(false) {
+		return newBrazeMessageError("in_local_time and at_optimal_time cannot both be true")
+	}
+
+	return nil
+}
+
+func validateScheduledMessage(schedule brazeclient.MessageSchedule, messages brazeclient.ScheduledMessages) error {
+	err := validateMessageSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	for _, payload := range []brazeclient.OptScheduledMessagePayload{
+		messages.AndroidPush,
+		messages.ApplePush,
+		messages.ContentCard,
+		messages.Email,
+		messages.SMS,
+		messages.WebPush,
+		messages.Webhook,
+	} {
+		if payload.IsSet() {
+			return nil
+		}
+	}
+
+	return newBrazeMessageError("At least one message must be provided")
+}
+
+// validateTriggerSchedule checks a schedule for an API-triggered campaign or
+// Canvas, which is sent either to recipients or, as a broadcast, to the
+// whole segment of the campaign or Canvas.
+func validateTriggerSchedule(scheduleType brazeclient.OptString, archived brazeclient.OptBool, broadcast brazeclient.OptBool, recipients int, schedule brazeclient.MessageSchedule) error {
+	switch {
+	case scheduleType.Or("") != apiTriggeredScheduleType:
+		return newBrazeMessageError("Campaign or Canvas must use API-triggered delivery")
+	case archived.Or(false):
+		return newBrazeMessageError("Campaign or Canvas is archived")
+	case broadcast.Or(false) && recipients > 0:
+		return newBrazeMessageError("Broadcast messages cannot specify recipients")
+	case !broadcast.Or(false) && recipients == 0:
+		return newBrazeMessageError("Either broadcast or recipients must be provided")
+	}
+
+	return validateMessageSchedule(schedule)
+}
+
+func validateSendID(sendID string) error {
+	switch {
+	case sendID == "":
+		return newBrazeMessageError("send_id cannot be blank")
+	case len(sendID) > sendIDMaxLength:
+		return newBrazeMessageError("send_id must be 64 characters or less")
+	case !brazeIdentifierPattern.MatchString(sendID):
+		return newBrazeMessageError("send_id can only contain alphanumeric characters, dashes and underscores")
+	}
+
+	return nil
+}
+
+func validateCatalog(catalog brazeclient.Catalog) error {
+	switch {
+	case len(catalog.Name) > catalogNameMaxLength:
+		return newBrazeCatalogError(http.StatusBadRequest, "catalog-name-too-large", "Catalog name must be less than 250 characters", "name", catalog.Name)
+	case !brazeIdentifierPattern.MatchString(catalog.Name):
+		return newBrazeCatalogError(http.StatusBadRequest, "invalid-catalog-name", "Catalog name can only include letters, numbers, hyphens, and underscores", "name", catalog.Name)
+	case len(catalog.Description) > catalogDescriptionMaxLength:
+		return newBrazeCatalogError(http.StatusBadRequest, "description-too-long", "Catalog description must be less than 250 characters", "description", catalog.Description)
+	case len(catalog.Fields) == 0 || catalog.Fields[0].Name != "id" || catalog.Fields[0].Type != brazeclient.CatalogFieldTypeString:
+		return newBrazeCatalogError(http.StatusBadRequest, "id-not-first-column", "The first field must be id with type string", "fields", catalog.Fields)
+	}
+
+	names := make(map[string]struct{}, len(catalog.Fields))
+	for _, field := range catalog.Fields {
+		if _, exists := names[field.Name]; exists || !brazeIdentifierPattern.MatchString(field.Name) {
+			return newBrazeCatalogError(http.StatusBadRequest, "invalid-fields", "Field names must be unique and can only include letters, numbers, hyphens, and underscores", "fields", field.Name)
+		}
+
+		names[field.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateCatalogItemID(itemID string) error {
+	switch {
+	case len(itemID) > catalogItemIDMaxLength:
+		return newBrazeCatalogError(http.StatusBadRequest, "ids-too-large", "Item ids must be less than 250 characters", "id", itemID)
+	case !brazeIdentifierPattern.MatchString(itemID):
+		return newBrazeCatalogError(http.StatusBadRequest, "invalid-ids", "Item ids can only include letters, numbers, hyphens, and underscores", "id", itemID)
+	}
+
+	return nil
+}
+
+// validateCatalogItemFields checks that every value in an item names a field
+// of the catalog and can be stored as that field's type.
+func validateCatalogItemFields(catalog brazeclient.Catalog, item brazeclient.CatalogItemWrite) error {
+	types := make(map[string]brazeclient.CatalogFieldType, len(catalog.Fields))
+	for _, field := range catalog.Fields {
+		types[field.Name] = field.Type
+	}
+
+	for name, value := range item {
+		fieldType, exists := types[name]
+		if !exists {
+			return newBrazeCatalogError(http.StatusBadRequest, "invalid-fields", "Some of the fields given do not exist in the catalog", name, nil)
+		}
+
+		if !catalogValueHasType(value, fieldType) {
+			return newBrazeCatalogError(http.StatusBadRequest, "unable-to-coerce-value", "Item types can't be converted to their corresponding field types", name, string(value))
+		}
+	}
+
+	return nil
+}
+
+func catalogValueHasType(value jx.Raw, fieldType brazeclient.CatalogFieldType) bool {
+	valueType := jx.DecodeBytes(value).Next()
+	if valueType == jx.Null {
+		return true
+	}
+
+	switch fieldType {
+	case brazeclient.CatalogFieldTypeString:
+		return valueType == jx.String
+	case brazeclient.CatalogFieldTypeNumber:
+		return valueType == jx.Number
+	case brazeclient.CatalogFieldTypeBoolean:
+		return valueType == jx.Bool
+	case brazeclient.CatalogFieldTypeArray:
+		return valueType == jx.Array
+	case brazeclient.CatalogFieldTypeObject:
+		return valueType == jx.Object
+	case brazeclient.CatalogFieldTypeTime:
+		s, err := jx.DecodeBytes(value).Str()
+		if err != nil {
+			return false
+		}
+
+		_, err = time.Parse(time.RFC3339, s)
+
+		return err == nil
+	case brazeclient.CatalogFieldTypeGeo:
+		return catalogValueIsGeo(value)
+	}
+
+	return false
+}
+
+func catalogValueIsGeo(value jx.Raw) bool {
+	var latitude, longitude bool
+
+	err := jx.DecodeBytes(value).ObjBytes(func(d *jx.Decoder, key []byte) error {
+		switch string(key) {
+		case "latitude":
+			latitude = d.Next() == jx.Number
+		case "longitude":
+			longitude = d.Next() == jx.Number
+		}
+
+		return d.Skip()
+	})
+
+	return err == nil && latitude && longitude
+}
@@ -0,0 +1,511 @@
+package testing_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazetesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/go-faster/jx"
+)
+
+func TestHandlerValidatesContentBlockNames(t *testing.T) {
+	t.Parallel()
+
+	handler := brazetesting.NewBrazeHandler()
+
+	created, err := handler.CreateContentBlock(t.Context(), &brazeclient.CreateContentBlockRequest{Name: "greeting", Content: "Hello"})
+	if err != nil {
+		t.Fatalf("CreateContentBlock() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		name            string
+		expectedMessage string
+	}{
+		"blank":     {name: "", expectedMessage: "Content Block name cannot be blank"},
+		"spaces":    {name: "a greeting", expectedMessage: "Content Block name can only contain alphanumeric characters, dashes and underscores"},
+		"special":   {name: "greeting!", expectedMessage: "Content Block name can only contain alphanumeric characters, dashes and underscores"},
+		"too long":  {name: strings.Repeat("a", 101), expectedMessage: "Content Block name must be 100 characters or less"},
+		"duplicate": {name: "greeting", expectedMessage: "Content Block name must be unique"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreateContentBlock(t.Context(), &brazeclient.CreateContentBlockRequest{Name: test.name, Content: "Hello"})
+
+			response := handler.NewError(t.Context(), err)
+			if response.StatusCode != http.StatusBadRequest || response.Response.Message != test.expectedMessage {
+				t.Errorf("expected 400 %q, got %d %q", test.expectedMessage, response.StatusCode, response.Response.Message)
+			}
+		})
+	}
+
+	_, err = handler.UpdateContentBlock(t.Context(), &brazeclient.UpdateContentBlockRequest{
+		ContentBlockID: created.ContentBlockID,
+		Name:           brazeclient.NewOptString("greeting"),
+	})
+	if err != nil {
+		t.Errorf("expected a content block to keep its own name, got %v", err)
+	}
+}
+
+func TestHandlerValidatesCatalogs(t *testing.T) {
+	t.Parallel()
+
+	handler := brazetesting.NewBrazeHandler()
+
+	fields := []brazeclient.CatalogField{
+		{Name: "id", Type: brazeclient.CatalogFieldTypeString},
+		{Name: "price", Type: brazeclient.CatalogFieldTypeNumber},
+		{Name: "released", Type: brazeclient.CatalogFieldTypeTime},
+	}
+
+	tests := map[string]struct {
+		catalog    brazeclient.Catalog
+		expectedID string
+	}{
+		"invalid name": {
+			catalog:    brazeclient.Catalog{Name: "my catalog", Fields: fields},
+			expectedID: "invalid-catalog-name",
+		},
+		"id not first": {
+			catalog:    brazeclient.Catalog{Name: "products", Fields: fields[1:]},
+			expectedID: "id-not-first-column",
+		},
+		"id not string": {
+			catalog:    brazeclient.Catalog{Name: "products", Fields: []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeNumber}}},
+			expectedID: "id-not-first-column",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreateCatalog(t.Context(), &brazeclient.CreateCatalogRequest{Catalogs: []brazeclient.Catalog{test.catalog}})
+
+			assertCatalogError(t, handler.NewError(t.Context(), err), test.expectedID)
+		})
+	}
+
+	_, err := handler.CreateCatalog(t.Context(), &brazeclient.CreateCatalogRequest{Catalogs: []brazeclient.Catalog{{Name: "products", Fields: fields}}})
+	if err != nil {
+		t.Fatalf("CreateCatalog() error = %v", err)
+	}
+
+	_, err = handler.CreateCatalog(t.Context(), &brazeclient.CreateCatalogRequest{Catalogs: []brazeclient.Catalog{{Name: "products", Fields: fields}}})
+	assertCatalogError(t, handler.NewError(t.Context(), err), "catalog-name-already-exists")
+}
+
+func TestHandlerValidatesCatalogItems(t *testing.T) {
+	t.Parallel()
+
+	handler := brazetesting.NewBrazeHandler()
+
+	_, err := handler.CreateCatalog(t.Context(), &brazeclient.CreateCatalogRequest{Catalogs: []brazeclient.Catalog{{
+		Name: "products",
+		Fields: []brazeclient.CatalogField{
+			{Name: "id", Type: brazeclient.CatalogFieldTypeString},
+			{Name: "price", Type: brazeclient.CatalogFieldTypeNumber},
+			{Name: "released", Type: brazeclient.CatalogFieldTypeTime},
+		},
+	}}})
+	if err != nil {
+		t.Fatalf("CreateCatalog() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		itemID     string
+		item       brazeclient.CatalogItemWrite
+		expectedID string
+	}{
+		"valid": {
+			itemID: "sku-1",
+			item:   brazeclient.CatalogItemWrite{"price": jx.Raw(`9.5`), "released": jx.Raw(`"2024-01-02T03:04:05Z"`)},
+		},
+		"invalid id": {
+			itemID:     "sku 1",
+			item:       brazeclient.CatalogItemWrite{},
+			expectedID: "invalid-ids",
+		},
+		"unknown field": {
+			itemID:     "sku-2",
+			item:       brazeclient.CatalogItemWrite{"colour": jx.Raw(`"red"`)},
+			expectedID: "invalid-fields",
+		},
+		"number mismatch": {
+			itemID:     "sku-3",
+			item:       brazeclient.CatalogItemWrite{"price": jx.Raw(`"cheap"`)},
+			expectedID: "unable-to-coerce-value",
+		},
+		"time mismatch": {
+			itemID:     "sku-4",
+			item:       brazeclient.CatalogItemWrite{"released": jx.Raw(`"yesterday"`)},
+			expectedID: "unable-to-coerce-value",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreateCatalogItem(t.Context(),
+				&brazeclient.CreateCatalogItemRequest{Items: []brazeclient.CatalogItemWrite{test.item}},
+				brazeclient.CreateCatalogItemParams{CatalogName: "products", ItemID: test.itemID},
+			)
+
+			if test.expectedID == "" {
+				if err != nil {
+					t.Errorf("CreateCatalogItem() error = %v", err)
+				}
+
+				return
+			}
+
+			assertCatalogError(t, handler.NewError(t.Context(), err), test.expectedID)
+		})
+	}
+}
+
+func assertCatalogError(t *testing.T, response *brazeclient.ErrorResponseStatusCode, expectedID string) {
+	t.Helper()
+
+	if response.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code %d, got %d", http.StatusBadRequest, response.StatusCode)
+	}
+
+	if len(response.Response.Errors) != 1 || !response.Response.Errors[0].IsErrorDetail() {
+		t.Fatalf("expected one error detail, got %v", response.Response.Errors)
+	}
+
+	if id := response.Response.Errors[0].ErrorDetail.ID; id != expectedID {
+		t.Errorf("expected error %q, got %q", expectedID, id)
+	}
+}
+
+func TestHandlerValidatesPreferenceCenters(t *testing.T) {
+	t.Parallel()
+
+	handler := brazetesting.NewBrazeHandler()
+
+	_, err := handler.CreatePreferenceCenter(t.Context(), &brazeclient.CreatePreferenceCenterRequest{
+		Name:                     "newsletter",
+		PreferenceCenterPageHTML: "<p>Preferences</p>",
+		ConfirmationPageHTML:     "<p>Saved</p>",
+	})
+	if err != nil {
+		t.Fatalf("CreatePreferenceCenter() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		request         brazeclient.CreatePreferenceCenterRequest
+		expectedMessage string
+	}{
+		"blank name": {
+			request:         brazeclient.CreatePreferenceCenterRequest{PreferenceCenterPageHTML: "<p/>", ConfirmationPageHTML: "<p/>"},
+			expectedMessage: "Preference Center name cannot be blank",
+		},
+		"invalid name": {
+			request:         brazeclient.CreatePreferenceCenterRequest{Name: "news letter", PreferenceCenterPageHTML: "<p/>", ConfirmationPageHTML: "<p/>"},
+			expectedMessage: "Preference Center name can only contain alphanumeric characters, dashes and underscores",
+		},
+		"duplicate name": {
+			request:         brazeclient.CreatePreferenceCenterRequest{Name: "newsletter", PreferenceCenterPageHTML: "<p/>", ConfirmationPageHTML: "<p/>"},
+			expectedMessage: "Preference Center name must be unique",
+		},
+		"blank page": {
+			request:         brazeclient.CreatePreferenceCenterRequest{Name: "offers", ConfirmationPageHTML: "<p/>"},
+			expectedMessage: "Preference Center page HTML cannot be blank",
+		},
+		"blank confirmation page": {
+			request:         brazeclient.CreatePreferenceCenterRequest{Name: "offers", PreferenceCenterPageHTML: "<p/>"},
+			expectedMessage: "Confirmation page HTML cannot be blank",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreatePreferenceCenter(t.Context(), &test.request)
+
+			response := handler.NewError(t.Context(), err)
+			if response.StatusCode != http.StatusBadRequest || response.Response.Message != test.expectedMessage {
+				t.Errorf("expected 400 %q, got %d %q", test.expectedMessage, response.StatusCode, response.Response.Message)
+			}
+		})
+	}
+}
+
+func TestHandlerValidatesDashboardUsers(t *testing.T) {
+	t.Parallel()
+
+	handler := brazetesting.NewBrazeHandler()
+	name := brazeclient.DashboardUserName{GivenName: "Jane", FamilyName: "Doe"}
+
+	_, err := handler.CreateDashboardUser(t.Context(), &brazeclient.CreateDashboardUserRequest{
+		UserName:   "jane@example.com",
+		Name:       name,
+		Department: "engineering",
+	}, brazeclient.CreateDashboardUserParams{})
+	if err != nil {
+		t.Fatalf("CreateDashboardUser() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		request            brazeclient.CreateDashboardUserRequest
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		"invalid email": {
+			request:            brazeclient.CreateDashboardUserRequest{UserName: "jane", Name: name, Department: "engineering"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "userName must be a valid email address",
+		},
+		"duplicate email": {
+			request:            brazeclient.CreateDashboardUserRequest{UserName: "JANE@example.com", Name: name, Department: "engineering"},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "User already exists",
+		},
+		"blank name": {
+			request:            brazeclient.CreateDashboardUserRequest{UserName: "john@example.com", Department: "engineering"},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "name.givenName and name.familyName are required",
+		},
+		"blank department": {
+			request:            brazeclient.CreateDashboardUserRequest{UserName: "john@example.com", Name: name},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "department is required",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreateDashboardUser(t.Context(), &test.request, brazeclient.CreateDashboardUserParams{})
+
+			response := handler.NewError(t.Context(), err)
+			if response.StatusCode != test.expectedStatusCode || response.Response.Message != test.expectedMessage {
+				t.Errorf("expected %d %q, got %d %q", test.expectedStatusCode, test.expectedMessage, response.StatusCode, response.Response.Message)
+			}
+		})
+	}
+
+	_, err = handler.SearchDashboardUsers(t.Context(), brazeclient.SearchDashboardUsersParams{Filter: "department eq \"engineering\""})
+
+	response := handler.NewError(t.Context(), err)
+	if response.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 for unsupported filter, got %d", response.StatusCode)
+	}
+}
+
+func TestHandlerValidatesScheduledMessages(t *testing.T) {
+	t.Parallel()
+
+	handler := brazetesting.NewBrazeHandler()
+	schedule := brazeclient.MessageSchedule{Time: time.Now().Add(time.Hour)}
+	messages := brazeclient.ScheduledMessages{
+		Email: brazeclient.NewOptScheduledMessagePayload(brazeclient.ScheduledMessagePayload{"subject": jx.Raw(`"Maintenance"`)}),
+	}
+
+	tests := map[string]struct {
+		request         brazeclient.CreateScheduledMessageRequest
+		expectedMessage string
+	}{
+		"no audience": {
+			request:         brazeclient.CreateScheduledMessageRequest{Schedule: schedule, Messages: messages},
+			expectedMessage: "Either broadcast or external_user_ids must be provided",
+		},
+		"broadcast with users": {
+			request:         brazeclient.CreateScheduledMessageRequest{Broadcast: brazeclient.NewOptBool(true), ExternalUserIds: []string{"user-1"}, Schedule: schedule, Messages: messages},
+			expectedMessage: "Broadcast messages cannot specify external_user_ids",
+		},
+		"broadcast without segment": {
+			request:         brazeclient.CreateScheduledMessageRequest{Broadcast: brazeclient.NewOptBool(true), Schedule: schedule, Messages: messages},
+			expectedMessage: "Broadcast messages must specify a segment_id or audience",
+		},
+		"no messages": {
+			request:         brazeclient.CreateScheduledMessageRequest{ExternalUserIds: []string{"user-1"}, Schedule: schedule},
+			expectedMessage: "At least one message must be provided",
+		},
+		"local and optimal time": {
+			request: brazeclient.CreateScheduledMessageRequest{
+				ExternalUserIds: []string{"user-1"},
+				Schedule:        brazeclient.MessageSchedule{Time: schedule.Time, InLocalTime: brazeclient.NewOptBool(true), AtOptimalTime: brazeclient.NewOptBool(true)},
+				Messages:        messages,
+			},
+			expectedMessage: "in_local_time and at_optimal_time cannot both be true",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreateScheduledMessage(t.Context(), &test.request)
+
+			response := handler.NewError(t.Context(), err)
+			if response.StatusCode != http.StatusBadRequest || response.Response.Message != test.expectedMessage {
+				t.Errorf("expected 400 %q, got %d %q", test.expectedMessage, response.StatusCode, response.Response.Message)
+			}
+		})
+	}
+
+	sent, err := handler.CreateScheduledMessage(t.Context(), &brazeclient.CreateScheduledMessageRequest{
+		ExternalUserIds: []string{"user-1"},
+		Schedule:        brazeclient.MessageSchedule{Time: time.Now().Add(-time.Minute)},
+		Messages:        messages,
+	})
+	if err != nil {
+		t.Fatalf("CreateScheduledMessage() error = %v", err)
+	}
+
+	_, err = handler.UpdateScheduledMessage(t.Context(), &brazeclient.UpdateScheduledMessageRequest{
+		ScheduleID: sent.ScheduleID,
+		Schedule:   brazeclient.NewOptMessageSchedule(schedule),
+	})
+
+	response := handler.NewError(t.Context(), err)
+	if response.StatusCode != http.StatusBadRequest || response.Response.Message != "Scheduled message has already been sent" {
+		t.Errorf("expected 400 for a sent message, got %d %q", response.StatusCode, response.Response.Message)
+	}
+}
+
+func TestHandlerValidatesCampaignTriggerSchedules(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	server.SetCampaign("api-campaign", "API campaign", nil, "api_triggered", false)
+	server.SetCampaign("archived-campaign", "Archived campaign", nil, "api_triggered", true)
+	server.SetCampaign("time-campaign", "Time campaign", nil, "time_based", false)
+
+	handler := server.Handler()
+	schedule := brazeclient.MessageSchedule{Time: time.Now().Add(time.Hour)}
+	recipients := []brazeclient.CampaignTriggerRecipient{{ExternalUserID: "user-1"}}
+
+	tests := map[string]struct {
+		request         brazeclient.CreateCampaignTriggerScheduleRequest
+		expectedMessage string
+	}{
+		"unknown campaign": {
+			request:         brazeclient.CreateCampaignTriggerScheduleRequest{CampaignID: "missing", Recipients: recipients, Schedule: schedule},
+			expectedMessage: "Invalid campaign_id",
+		},
+		"not api-triggered": {
+			request:         brazeclient.CreateCampaignTriggerScheduleRequest{CampaignID: "time-campaign", Recipients: recipients, Schedule: schedule},
+			expectedMessage: "Campaign or Canvas must use API-triggered delivery",
+		},
+		"archived": {
+			request:         brazeclient.CreateCampaignTriggerScheduleRequest{CampaignID: "archived-campaign", Recipients: recipients, Schedule: schedule},
+			expectedMessage: "Campaign or Canvas is archived",
+		},
+		"no audience": {
+			request:         brazeclient.CreateCampaignTriggerScheduleRequest{CampaignID: "api-campaign", Schedule: schedule},
+			expectedMessage: "Either broadcast or recipients must be provided",
+		},
+		"broadcast with recipients": {
+			request:         brazeclient.CreateCampaignTriggerScheduleRequest{CampaignID: "api-campaign", Broadcast: brazeclient.NewOptBool(true), Recipients: recipients, Schedule: schedule},
+			expectedMessage: "Broadcast messages cannot specify recipients",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreateCampaignTriggerSchedule(t.Context(), &test.request)
+
+			response := handler.NewError(t.Context(), err)
+			if response.StatusCode != http.StatusBadRequest || response.Response.Message != test.expectedMessage {
+				t.Errorf("expected 400 %q, got %d %q", test.expectedMessage, response.StatusCode, response.Response.Message)
+			}
+		})
+	}
+
+	sent, err := handler.CreateCampaignTriggerSchedule(t.Context(), &brazeclient.CreateCampaignTriggerScheduleRequest{
+		CampaignID: "api-campaign",
+		Recipients: recipients,
+		Schedule:   brazeclient.MessageSchedule{Time: time.Now().Add(-time.Minute)},
+	})
+	if err != nil {
+		t.Fatalf("CreateCampaignTriggerSchedule() error = %v", err)
+	}
+
+	_, err = handler.DeleteCampaignTriggerSchedule(t.Context(), &brazeclient.DeleteCampaignTriggerScheduleRequest{
+		CampaignID: "api-campaign",
+		ScheduleID: sent.ScheduleID,
+	})
+
+	response := handler.NewError(t.Context(), err)
+	if response.StatusCode != http.StatusBadRequest || response.Response.Message != "Scheduled message has already been sent" {
+		t.Errorf("expected 400 for a sent schedule, got %d %q", response.StatusCode, response.Response.Message)
+	}
+
+	_, err = handler.DeleteCampaignTriggerSchedule(t.Context(), &brazeclient.DeleteCampaignTriggerScheduleRequest{
+		CampaignID: "other-campaign",
+		ScheduleID: sent.ScheduleID,
+	})
+
+	response = handler.NewError(t.Context(), err)
+	if response.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for a schedule of another campaign, got %d", response.StatusCode)
+	}
+}
+
+func TestHandlerValidatesSendIDs(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazetesting.NewBrazeServer()
+	if err != nil {
+		t.Fatalf("NewBrazeServer() error = %v", err)
+	}
+
+	server.SetCampaign("campaign-1", "Campaign", nil, "api_triggered", false)
+
+	handler := server.Handler()
+
+	tests := map[string]struct {
+		request         brazeclient.CreateSendIDRequest
+		expectedMessage string
+	}{
+		"unknown campaign": {
+			request:         brazeclient.CreateSendIDRequest{CampaignID: "missing", SendID: "launch"},
+			expectedMessage: "Invalid campaign_id",
+		},
+		"blank": {
+			request:         brazeclient.CreateSendIDRequest{CampaignID: "campaign-1"},
+			expectedMessage: "send_id cannot be blank",
+		},
+		"too long": {
+			request:         brazeclient.CreateSendIDRequest{CampaignID: "campaign-1", SendID: strings.Repeat("a", 65)},
+			expectedMessage: "send_id must be 64 characters or less",
+		},
+		"invalid characters": {
+			request:         brazeclient.CreateSendIDRequest{CampaignID: "campaign-1", SendID: "spring launch"},
+			expectedMessage: "send_id can only contain alphanumeric characters, dashes and underscores",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.CreateSendID(t.Context(), &test.request)
+
+			response := handler.NewError(t.Context(), err)
+			if response.StatusCode != http.StatusBadRequest || response.Response.Message != test.expectedMessage {
+				t.Errorf("expected 400 %q, got %d %q", test.expectedMessage, response.StatusCode, response.Response.Message)
+			}
+		})
+	}
+}
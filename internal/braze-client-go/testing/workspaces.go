@@ -0,0 +1,27 @@
+package testing
+
+import (
+	"net/http"
+	"strings"
+)
+
+// NewWorkspacesByAPIKey returns a handler that serves each request from the
+// workspace its API key belongs to, for testing keys from several Braze
+// workspaces against one base URL. Requests with an unknown key are rejected
+// with 401 Unauthorized.
+func NewWorkspacesByAPIKey(workspaces map[string]http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+		workspace, found := workspaces[apiKey]
+		if !found {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Invalid API key"}`))
+
+			return
+		}
+
+		workspace.ServeHTTP(w, r)
+	})
+}
@@ -2,23 +2,114 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
 )
 
+// Families of Braze operations that can be given their own API key.
+const (
+	brazeAPIKeyFamilyCampaigns         = "campaigns"
+	brazeAPIKeyFamilyCanvases          = "canvases"
+	brazeAPIKeyFamilyCatalogs          = "catalogs"
+	brazeAPIKeyFamilyContentBlocks     = "content_blocks"
+	brazeAPIKeyFamilyCustomAttributes  = "custom_attributes"
+	brazeAPIKeyFamilyEmailTemplates    = "email_templates"
+	brazeAPIKeyFamilyEvents            = "events"
+	brazeAPIKeyFamilyMessages          = "messages"
+	brazeAPIKeyFamilyPreferenceCenters = "preference_centers"
+	brazeAPIKeyFamilyPurchases         = "purchases"
+	brazeAPIKeyFamilySegments          = "segments"
+	brazeAPIKeyFamilySends             = "sends"
+)
+
+// brazeAPIKeyFamilyPermissionPrefixes maps the prefix of the permissions an
+// operation requires to the family whose key is used for it.
+//
+//nolint:gochecknoglobals
+var brazeAPIKeyFamilyPermissionPrefixes = map[string]string{
+	"campaigns.":         brazeAPIKeyFamilyCampaigns,
+	"canvas.":            brazeAPIKeyFamilyCanvases,
+	"catalogs.":          brazeAPIKeyFamilyCatalogs,
+	"content_blocks.":    brazeAPIKeyFamilyContentBlocks,
+	"custom_attributes.": brazeAPIKeyFamilyCustomAttributes,
+	"events.":            brazeAPIKeyFamilyEvents,
+	"messages.":          brazeAPIKeyFamilyMessages,
+	"preference_center.": brazeAPIKeyFamilyPreferenceCenters,
+	"purchases.":         brazeAPIKeyFamilyPurchases,
+	"segments.":          brazeAPIKeyFamilySegments,
+	"sends.":             brazeAPIKeyFamilySends,
+	"templates.email.":   brazeAPIKeyFamilyEmailTemplates,
+}
+
+var errBrazeSCIMTokenNotConfigured = errors.New("no Braze SCIM token is configured; set scim_token or BRAZE_SCIM_TOKEN to manage dashboard users")
+
 type BrazeAPIKeySecuritySource struct {
-	token string
+	token        func(ctx context.Context) (string, error)
+	familyTokens map[string]string
+	scimToken    string
 }
 
 var _ brazeclient.SecuritySource = (*BrazeAPIKeySecuritySource)(nil)
 
 func NewBrazeAPIKeySecuritySource(token string) BrazeAPIKeySecuritySource {
 	return BrazeAPIKeySecuritySource{
-		token: token,
+		token: brazeStaticAPIKey(token),
+	}
+}
+
+// NewBrazeScopedAPIKeySecuritySource uses the key in familyTokens for the
+// family of each operation, and the key returned by token for operations
+// whose family has no key. token is called for every such request and is
+// responsible for any caching.
+func NewBrazeScopedAPIKeySecuritySource(token func(ctx context.Context) (string, error), familyTokens map[string]string) BrazeAPIKeySecuritySource {
+	return BrazeAPIKeySecuritySource{
+		token:        token,
+		familyTokens: familyTokens,
 	}
 }
 
 //revive:disable:var-naming
-func (source BrazeAPIKeySecuritySource) BrazeApiKey(_ context.Context, _ brazeclient.OperationName, _ *brazeclient.Client) (brazeclient.BrazeApiKey, error) {
-	return brazeclient.BrazeApiKey{Token: source.token}, nil
+func (source BrazeAPIKeySecuritySource) BrazeApiKey(ctx context.Context, operationName brazeclient.OperationName, _ *brazeclient.Client) (brazeclient.BrazeApiKey, error) {
+	if token := source.familyTokens[brazeAPIKeyFamily(operationName)]; token != "" {
+		return brazeclient.BrazeApiKey{Token: token}, nil
+	}
+
+	token, err := source.token(ctx)
+	if err != nil {
+		return brazeclient.BrazeApiKey{}, err
+	}
+
+	return brazeclient.BrazeApiKey{Token: token}, nil
+}
+
+// withSCIMToken returns a copy of the source that presents token for SCIM
+// operations, which Braze authenticates separately from the REST API.
+func (source BrazeAPIKeySecuritySource) withSCIMToken(token string) BrazeAPIKeySecuritySource {
+	source.scimToken = token
+
+	return source
+}
+
+func (source BrazeAPIKeySecuritySource) BrazeScimToken(_ context.Context, _ brazeclient.OperationName, _ *brazeclient.Client) (brazeclient.BrazeScimToken, error) {
+	if source.scimToken == "" {
+		return brazeclient.BrazeScimToken{}, errBrazeSCIMTokenNotConfigured
+	}
+
+	return brazeclient.BrazeScimToken{Token: source.scimToken}, nil
+}
+
+// brazeAPIKeyFamily returns the family of an operation, or "" when the
+// operation belongs to no family.
+func brazeAPIKeyFamily(operationName brazeclient.OperationName) string {
+	for _, permission := range brazeclient.GetRolesForBrazeApiKey(operationName) {
+		for prefix, family := range brazeAPIKeyFamilyPermissionPrefixes {
+			if strings.HasPrefix(permission, prefix) {
+				return family
+			}
+		}
+	}
+
+	return ""
 }
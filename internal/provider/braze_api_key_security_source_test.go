@@ -0,0 +1,74 @@
+//nolint:testpackage
+package provider
+
+import (
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrazeAPIKeySecuritySourceScopedKeys(t *testing.T) {
+	t.Parallel()
+
+	source := NewBrazeScopedAPIKeySecuritySource(brazeStaticAPIKey("default"), map[string]string{
+		brazeAPIKeyFamilyCatalogs:      "catalogs",
+		brazeAPIKeyFamilyContentBlocks: "content-blocks",
+	})
+
+	tests := map[brazeclient.OperationName]string{
+		brazeclient.ListCatalogsOperation:         "catalogs",
+		brazeclient.ReplaceCatalogItemOperation:   "catalogs",
+		brazeclient.CreateContentBlockOperation:   "content-blocks",
+		brazeclient.GetEmailTemplateInfoOperation: "default",
+		"UnknownOperation":                        "default",
+	}
+
+	for operation, expected := range tests {
+		t.Run(operation, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := source.BrazeApiKey(t.Context(), operation, nil)
+			require.NoError(t, err)
+			assert.Equal(t, expected, key.Token)
+		})
+	}
+}
+
+func TestBrazeAPIKeyFamilyCoversOperations(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, brazeAPIKeyFamilyCampaigns, brazeAPIKeyFamily(brazeclient.GetCampaignDetailsOperation))
+	assert.Equal(t, brazeAPIKeyFamilyCampaigns, brazeAPIKeyFamily(brazeclient.CreateCampaignTriggerScheduleOperation))
+	assert.Equal(t, brazeAPIKeyFamilyCanvases, brazeAPIKeyFamily(brazeclient.ListCanvasesOperation))
+	assert.Equal(t, brazeAPIKeyFamilyCanvases, brazeAPIKeyFamily(brazeclient.DeleteCanvasTriggerScheduleOperation))
+	assert.Equal(t, brazeAPIKeyFamilyCatalogs, brazeAPIKeyFamily(brazeclient.DeleteCatalogItemOperation))
+	assert.Equal(t, brazeAPIKeyFamilyContentBlocks, brazeAPIKeyFamily(brazeclient.ListContentBlocksOperation))
+	assert.Equal(t, brazeAPIKeyFamilyCustomAttributes, brazeAPIKeyFamily(brazeclient.ListCustomAttributesOperation))
+	assert.Equal(t, brazeAPIKeyFamilyEmailTemplates, brazeAPIKeyFamily(brazeclient.UpdateEmailTemplateOperation))
+	assert.Equal(t, brazeAPIKeyFamilyEvents, brazeAPIKeyFamily(brazeclient.ListCustomEventsOperation))
+	assert.Equal(t, brazeAPIKeyFamilyMessages, brazeAPIKeyFamily(brazeclient.CreateScheduledMessageOperation))
+	assert.Equal(t, brazeAPIKeyFamilyPreferenceCenters, brazeAPIKeyFamily(brazeclient.CreatePreferenceCenterOperation))
+	assert.Equal(t, brazeAPIKeyFamilyPurchases, brazeAPIKeyFamily(brazeclient.ListProductsOperation))
+	assert.Equal(t, brazeAPIKeyFamilySegments, brazeAPIKeyFamily(brazeclient.ListSegmentsOperation))
+	assert.Equal(t, brazeAPIKeyFamilySends, brazeAPIKeyFamily(brazeclient.CreateSendIDOperation))
+	assert.Empty(t, brazeAPIKeyFamily("UnknownOperation"))
+}
+
+func TestBrazeAPIKeySecuritySourceSCIMToken(t *testing.T) {
+	t.Parallel()
+
+	source := NewBrazeAPIKeySecuritySource("default")
+
+	_, err := source.BrazeScimToken(t.Context(), brazeclient.GetDashboardUserOperation, nil)
+	require.ErrorIs(t, err, errBrazeSCIMTokenNotConfigured)
+
+	token, err := source.withSCIMToken("scim").BrazeScimToken(t.Context(), brazeclient.GetDashboardUserOperation, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "scim", token.Token)
+
+	key, err := source.withSCIMToken("scim").BrazeApiKey(t.Context(), brazeclient.GetContentBlockInfoOperation, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "default", key.Token)
+}
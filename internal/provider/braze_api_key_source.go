@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+var (
+	errBrazeAPIKeyEmpty        = errors.New("api key is empty")
+	errBrazeAPIKeyCommandEmpty = errors.New("api_key_command must name a command")
+)
+
+// configuredAPIKeySources counts the api_key, api_key_file and
+// api_key_command attributes that are set, of which at most one may be.
+func (m brazeProviderModel) configuredAPIKeySources() int {
+	configured := 0
+
+	for _, value := range []attr.Value{m.APIKey, m.APIKeyFile, m.APIKeyCommand} {
+		if !value.IsNull() {
+			configured++
+		}
+	}
+
+	return configured
+}
+
+// brazeStaticAPIKey returns a key source for a key known at configure time.
+func brazeStaticAPIKey(token string) func(context.Context) (string, error) {
+	return func(context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// readBrazeAPIKeyFile reads a key from a file, ignoring surrounding
+// whitespace such as a trailing newline.
+func readBrazeAPIKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read api key file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("read api key file %s: %w", path, errBrazeAPIKeyEmpty)
+	}
+
+	return token, nil
+}
+
+// brazeCommandAPIKey is a key source that runs a command, such as a secret
+// manager client, the first time a key is needed and uses its output as the
+// key for the rest of the run. Failures are not cached, so a later request
+// runs the command again.
+type brazeCommandAPIKey struct {
+	argv []string
+
+	mu    sync.Mutex
+	token string
+}
+
+func newBrazeCommandAPIKey(argv []string) (*brazeCommandAPIKey, error) {
+	if len(argv) == 0 || argv[0] == "" {
+		return nil, errBrazeAPIKeyCommandEmpty
+	}
+
+	return &brazeCommandAPIKey{argv: argv}, nil
+}
+
+func (c *brazeCommandAPIKey) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" {
+		return c.token, nil
+	}
+
+	//nolint:gosec // The command is configured by the operator to produce the key.
+	cmd := exec.CommandContext(ctx, c.argv[0], c.argv[1:]...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	// The output is the key, so it must never be included in errors or logs.
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("run api_key_command %s: %w: %s", c.argv[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(string(output))
+	if token == "" {
+		return "", fmt.Errorf("run api_key_command %s: %w", c.argv[0], errBrazeAPIKeyEmpty)
+	}
+
+	c.token = token
+
+	return token, nil
+}
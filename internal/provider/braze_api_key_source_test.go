@@ -0,0 +1,68 @@
+//nolint:testpackage
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBrazeAPIKeyFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	keyPath := filepath.Join(dir, "key")
+	require.NoError(t, os.WriteFile(keyPath, []byte("  file-key\n"), 0o600))
+
+	token, err := readBrazeAPIKeyFile(keyPath)
+	require.NoError(t, err)
+	assert.Equal(t, "file-key", token)
+
+	emptyPath := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(emptyPath, []byte("\n"), 0o600))
+
+	_, err = readBrazeAPIKeyFile(emptyPath)
+	require.ErrorIs(t, err, errBrazeAPIKeyEmpty)
+
+	_, err = readBrazeAPIKeyFile(filepath.Join(dir, "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestBrazeCommandAPIKeyRunsOnce(t *testing.T) {
+	t.Parallel()
+
+	runsPath := filepath.Join(t.TempDir(), "runs")
+
+	command, err := newBrazeCommandAPIKey([]string{"sh", "-c", `echo run >> "$0" && echo command-key`, runsPath})
+	require.NoError(t, err)
+
+	for range 3 {
+		token, err := command.Token(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "command-key", token)
+	}
+
+	runs, err := os.ReadFile(runsPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(runs), "run"))
+}
+
+func TestBrazeCommandAPIKeyErrorOmitsOutput(t *testing.T) {
+	t.Parallel()
+
+	command, err := newBrazeCommandAPIKey([]string{"sh", "-c", "echo secret-key && echo vault sealed >&2 && exit 1"})
+	require.NoError(t, err)
+
+	_, err = command.Token(t.Context())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vault sealed")
+	assert.NotContains(t, err.Error(), "secret-key")
+
+	_, err = newBrazeCommandAPIKey(nil)
+	require.ErrorIs(t, err, errBrazeAPIKeyCommandEmpty)
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type brazeLogPayloads string
+
+const (
+	brazeLogPayloadsNone     brazeLogPayloads = "none"
+	brazeLogPayloadsMetadata brazeLogPayloads = "metadata"
+	brazeLogPayloadsFull     brazeLogPayloads = "full"
+)
+
+const (
+	brazeAPILogPayloadMaxBytes = 4096
+	brazeAPILogMaskedValue     = "***"
+)
+
+var errInvalidBrazeLogPayloads = errors.New("invalid log_payloads value")
+
+func brazeLogPayloadsValues() []string {
+	return []string{
+		string(brazeLogPayloadsNone),
+		string(brazeLogPayloadsMetadata),
+		string(brazeLogPayloadsFull),
+	}
+}
+
+func parseBrazeLogPayloads(value string) (brazeLogPayloads, error) {
+	if value == "" {
+		return brazeLogPayloadsMetadata, nil
+	}
+
+	if !slices.Contains(brazeLogPayloadsValues(), value) {
+		return "", fmt.Errorf("%w: %q (expected one of %s)", errInvalidBrazeLogPayloads, value, strings.Join(brazeLogPayloadsValues(), ", "))
+	}
+
+	return brazeLogPayloads(value), nil
+}
+
+// brazeAPILogger logs Braze API calls made by the adapters. Operation
+// metadata is logged at DEBUG level and request/response bodies are only
+// logged, at TRACE level, when payload logging is set to full. Values of
+// sensitive fields are masked wherever they appear in a payload.
+type brazeAPILogger struct {
+	payloads        brazeLogPayloads
+	sensitiveFields []string
+}
+
+type brazeAPILogEntry struct {
+	Params   any
+	Request  any
+	Response any
+	Err      error
+}
+
+func newBrazeAPILogger(payloads brazeLogPayloads, sensitiveFields ...string) brazeAPILogger {
+	return brazeAPILogger{
+		payloads:        payloads,
+		sensitiveFields: sensitiveFields,
+	}
+}
+
+func (l brazeAPILogger) Log(ctx context.Context, operation string, entry brazeAPILogEntry) {
+	for _, field := range l.fields(entry) {
+		if field.trace {
+			tflog.Trace(ctx, operation, field.values)
+		} else {
+			tflog.Debug(ctx, operation, field.values)
+		}
+	}
+}
+
+type brazeAPILogFields struct {
+	trace  bool
+	values map[string]any
+}
+
+func (l brazeAPILogger) fields(entry brazeAPILogEntry) []brazeAPILogFields {
+	metadata := map[string]any{}
+
+	if entry.Err != nil {
+		metadata["err"] = entry.Err.Error()
+	}
+
+	if l.payloads == brazeLogPayloadsNone {
+		return []brazeAPILogFields{{values: metadata}}
+	}
+
+	if params, _ := l.encodePayload(entry.Params); params != "" {
+		metadata["params"] = params
+	}
+
+	request, requestBytes := l.encodePayload(entry.Request)
+	if requestBytes > 0 {
+		metadata["request_bytes"] = requestBytes
+	}
+
+	response, responseBytes := l.encodePayload(entry.Response)
+	if responseBytes > 0 {
+		metadata["response_bytes"] = responseBytes
+	}
+
+	fields := []brazeAPILogFields{{values: metadata}}
+
+	if l.payloads == brazeLogPayloadsFull {
+		payloads := map[string]any{}
+
+		if request != "" {
+			payloads["request"] = request
+		}
+
+		if response != "" {
+			payloads["response"] = response
+		}
+
+		fields = append(fields, brazeAPILogFields{trace: true, values: payloads})
+	}
+
+	return fields
+}
+
+// encodePayload returns the masked and truncated JSON encoding of value
+// along with the size in bytes of its unmodified encoding.
+func (l brazeAPILogger) encodePayload(value any) (string, int) {
+	if value == nil {
+		return "", 0
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "<unencodable payload: " + err.Error() + ">", 0
+	}
+
+	if string(encoded) == "null" {
+		return "", 0
+	}
+
+	size := len(encoded)
+
+	if len(l.sensitiveFields) > 0 {
+		var decoded any
+		if json.Unmarshal(encoded, &decoded) == nil {
+			if masked, err := json.Marshal(maskBrazeAPILogFields(decoded, l.sensitiveFields)); err == nil {
+				encoded = masked
+			}
+		}
+	}
+
+	return truncateBrazeAPILogPayload(string(encoded), brazeAPILogPayloadMaxBytes), size
+}
+
+func maskBrazeAPILogFields(value any, sensitiveFields []string) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, fieldValue := range value {
+			if slices.Contains(sensitiveFields, key) {
+				value[key] = brazeAPILogMaskedValue
+			} else {
+				value[key] = maskBrazeAPILogFields(fieldValue, sensitiveFields)
+			}
+		}
+
+		return value
+	case []any:
+		for i, element := range value {
+			value[i] = maskBrazeAPILogFields(element, sensitiveFields)
+		}
+
+		return value
+	default:
+		return value
+	}
+}
+
+func truncateBrazeAPILogPayload(payload string, maxBytes int) string {
+	if len(payload) <= maxBytes {
+		return payload
+	}
+
+	return strings.ToValidUTF8(payload[:maxBytes], "") + fmt.Sprintf("...(%d bytes truncated)", len(payload)-maxBytes)
+}
@@ -0,0 +1,155 @@
+//nolint:testpackage
+package provider
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestBrazeAPILog = errors.New("request failed")
+
+func TestParseBrazeLogPayloads(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value       string
+		expected    brazeLogPayloads
+		expectedErr error
+	}{
+		"empty defaults to metadata": {
+			value:    "",
+			expected: brazeLogPayloadsMetadata,
+		},
+		"none": {
+			value:    "none",
+			expected: brazeLogPayloadsNone,
+		},
+		"full": {
+			value:    "full",
+			expected: brazeLogPayloadsFull,
+		},
+		"invalid": {
+			value:       "everything",
+			expectedErr: errInvalidBrazeLogPayloads,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseBrazeLogPayloads(test.value)
+
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestBrazeAPILoggerFields(t *testing.T) {
+	t.Parallel()
+
+	entry := brazeAPILogEntry{
+		Params: brazeclient.GetContentBlockInfoParams{ContentBlockID: "content-block-id"},
+		Request: &brazeclient.CreateContentBlockRequest{
+			Name:    "Content block",
+			Content: "<p>customer@example.com</p>",
+		},
+		Response: &brazeclient.CreateContentBlockResponse{
+			ContentBlockID: "content-block-id",
+			Message:        "success",
+		},
+		Err: errTestBrazeAPILog,
+	}
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		fields := newBrazeAPILogger(brazeLogPayloadsNone, "content").fields(entry)
+
+		require.Len(t, fields, 1)
+		assert.False(t, fields[0].trace)
+		assert.Equal(t, map[string]any{"err": "request failed"}, fields[0].values)
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		t.Parallel()
+
+		fields := newBrazeAPILogger(brazeLogPayloadsMetadata, "content").fields(entry)
+
+		require.Len(t, fields, 1)
+		assert.False(t, fields[0].trace)
+		assert.Equal(t, "request failed", fields[0].values["err"])
+		assert.Contains(t, fields[0].values["params"], "content-block-id")
+		assert.Positive(t, fields[0].values["request_bytes"])
+		assert.Positive(t, fields[0].values["response_bytes"])
+		assert.NotContains(t, fields[0].values, "request")
+		assert.NotContains(t, fields[0].values, "response")
+	})
+
+	t.Run("full", func(t *testing.T) {
+		t.Parallel()
+
+		fields := newBrazeAPILogger(brazeLogPayloadsFull, "content").fields(entry)
+
+		require.Len(t, fields, 2)
+		assert.False(t, fields[0].trace)
+		assert.True(t, fields[1].trace)
+		assert.JSONEq(t, `{"name":"Content block","content":"***"}`, fields[1].values["request"].(string))
+		assert.JSONEq(t, `{"content_block_id":"content-block-id","message":"success"}`, fields[1].values["response"].(string))
+	})
+
+	t.Run("omits nil payloads", func(t *testing.T) {
+		t.Parallel()
+
+		var response *brazeclient.CreateContentBlockResponse
+
+		fields := newBrazeAPILogger(brazeLogPayloadsFull).fields(brazeAPILogEntry{Response: response})
+
+		require.Len(t, fields, 2)
+		assert.Empty(t, fields[0].values)
+		assert.Empty(t, fields[1].values)
+	})
+}
+
+func TestBrazeAPILoggerEncodePayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("masks nested sensitive fields", func(t *testing.T) {
+		t.Parallel()
+
+		logger := newBrazeAPILogger(brazeLogPayloadsFull, "items")
+
+		payload, size := logger.encodePayload(map[string]any{
+			"message": "success",
+			"response": map[string]any{
+				"items": []any{map[string]any{"id": "item", "email": "customer@example.com"}},
+			},
+		})
+
+		assert.JSONEq(t, `{"message":"success","response":{"items":"***"}}`, payload)
+		assert.Positive(t, size)
+	})
+
+	t.Run("truncates large payloads", func(t *testing.T) {
+		t.Parallel()
+
+		logger := newBrazeAPILogger(brazeLogPayloadsFull)
+
+		payload, size := logger.encodePayload(strings.Repeat("x", brazeAPILogPayloadMaxBytes*2))
+
+		assert.Equal(t, brazeAPILogPayloadMaxBytes*2+2, size)
+		assert.True(t, strings.HasPrefix(payload, `"xxx`))
+		assert.True(t, strings.HasSuffix(payload, "...(4098 bytes truncated)"))
+	})
+}
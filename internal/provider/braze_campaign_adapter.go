@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+type campaignClient interface {
+	List(ctx context.Context, filter brazeMessagingObjectFilter) ([]brazeMessagingObject, error)
+}
+
+type generatedCampaignClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedCampaignClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedCampaignClient {
+	return generatedCampaignClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+	}
+}
+
+func (c generatedCampaignClient) List(ctx context.Context, filter brazeMessagingObjectFilter) ([]brazeMessagingObject, error) {
+	return listBrazeMessagingObjects(filter, func(page int, includeArchived bool) ([]brazeMessagingObject, error) {
+		return c.listPage(ctx, page, includeArchived)
+	}, func(object *brazeMessagingObject) error {
+		return c.readDetails(ctx, object)
+	})
+}
+
+func (c generatedCampaignClient) listPage(ctx context.Context, page int, includeArchived bool) ([]brazeMessagingObject, error) {
+	listParams := brazeclient.ListCampaignsParams{
+		Page:            brazeclient.NewOptInt(page),
+		IncludeArchived: brazeclient.NewOptBool(includeArchived),
+	}
+
+	listResponse, listErr := c.client.ListCampaigns(ctx, listParams)
+
+	c.logger.Log(ctx, "braze_campaigns.list", brazeAPILogEntry{
+		Params:   listParams,
+		Response: listResponse,
+		Err:      listErr,
+	})
+
+	listErr = classifyBrazePermissionError(brazeclient.ListCampaignsOperation, listErr)
+
+	if listErr != nil {
+		return nil, fmt.Errorf("list campaigns: %w", listErr)
+	}
+
+	if listResponse == nil {
+		return nil, errBrazeObjectEmptyResponse
+	}
+
+	campaigns := listResponse.GetCampaigns()
+	objects := make([]brazeMessagingObject, len(campaigns))
+
+	for i, campaign := range campaigns {
+		objects[i] = brazeMessagingObject{
+			ID:           campaign.GetID(),
+			Name:         campaign.GetName(),
+			Tags:         campaign.GetTags(),
+			LastEdited:   campaign.GetLastEdited().Or(time.Time{}),
+			APITriggered: campaign.GetIsAPICampaign().Or(false),
+		}
+	}
+
+	return objects, nil
+}
+
+func (c generatedCampaignClient) readDetails(ctx context.Context, object *brazeMessagingObject) error {
+	detailsParams := brazeclient.GetCampaignDetailsParams{
+		CampaignID: object.ID,
+	}
+
+	detailsResponse, detailsErr := c.client.GetCampaignDetails(ctx, detailsParams)
+
+	c.logger.Log(ctx, "braze_campaigns.details", brazeAPILogEntry{
+		Params:   detailsParams,
+		Response: detailsResponse,
+		Err:      detailsErr,
+	})
+
+	detailsErr = classifyBrazePermissionError(brazeclient.GetCampaignDetailsOperation, detailsErr)
+
+	if detailsErr != nil {
+		return fmt.Errorf("read campaign %s: %w", object.ID, detailsErr)
+	}
+
+	if detailsResponse == nil {
+		return errBrazeObjectEmptyResponse
+	}
+
+	object.Archived = detailsResponse.GetArchived().Or(false)
+	object.APITriggered = object.APITriggered || detailsResponse.GetScheduleType().Or("") == brazeAPITriggeredScheduleType
+
+	return nil
+}
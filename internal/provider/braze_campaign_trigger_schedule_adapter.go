@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type campaignTriggerScheduleClient interface {
+	Create(ctx context.Context, plan brazeCampaignTriggerScheduleModel) (brazeCampaignTriggerScheduleModel, error)
+	Update(ctx context.Context, plan brazeCampaignTriggerScheduleModel) (brazeCampaignTriggerScheduleModel, error)
+	Delete(ctx context.Context, campaignID, scheduleID string) error
+}
+
+type generatedCampaignTriggerScheduleClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedCampaignTriggerScheduleClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedCampaignTriggerScheduleClient {
+	return generatedCampaignTriggerScheduleClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads, "recipients", "trigger_properties"),
+	}
+}
+
+// Create schedules the campaign. Braze cannot read schedules back, so the
+// result is the plan with the identifiers Braze assigned.
+func (c generatedCampaignTriggerScheduleClient) Create(ctx context.Context, plan brazeCampaignTriggerScheduleModel) (brazeCampaignTriggerScheduleModel, error) {
+	createRequest, err := plan.ToCreateCampaignTriggerScheduleRequest()
+	if err != nil {
+		return brazeCampaignTriggerScheduleModel{}, err
+	}
+
+	createResponse, createErr := c.client.CreateCampaignTriggerSchedule(ctx, &createRequest)
+
+	c.logger.Log(ctx, "braze_campaign_trigger_schedule.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
+	})
+
+	createErr = classifyBrazePermissionError(brazeclient.CreateCampaignTriggerScheduleOperation, createErr)
+
+	if createErr != nil {
+		return brazeCampaignTriggerScheduleModel{}, fmt.Errorf("create campaign trigger schedule: %w", createErr)
+	}
+
+	if createResponse == nil {
+		return brazeCampaignTriggerScheduleModel{}, errBrazeObjectEmptyResponse
+	}
+
+	plan.ScheduleID = types.StringValue(createResponse.GetScheduleID())
+	plan.DispatchID = types.StringPointerValue(createResponse.GetDispatchID().GetPointer())
+
+	return plan, nil
+}
+
+func (c generatedCampaignTriggerScheduleClient) Update(ctx context.Context, plan brazeCampaignTriggerScheduleModel) (brazeCampaignTriggerScheduleModel, error) {
+	updateRequest, err := plan.ToUpdateCampaignTriggerScheduleRequest()
+	if err != nil {
+		return brazeCampaignTriggerScheduleModel{}, err
+	}
+
+	updateResponse, updateErr := c.client.UpdateCampaignTriggerSchedule(ctx, &updateRequest)
+
+	c.logger.Log(ctx, "braze_campaign_trigger_schedule.update", brazeAPILogEntry{
+		Request:  &updateRequest,
+		Response: updateResponse,
+		Err:      updateErr,
+	})
+
+	updateErr = classifyBrazePermissionError(brazeclient.UpdateCampaignTriggerScheduleOperation, updateErr)
+
+	if updateErr != nil {
+		return brazeCampaignTriggerScheduleModel{}, fmt.Errorf("update campaign trigger schedule: %w", classifyBrazeObjectReadError(updateErr))
+	}
+
+	return plan, nil
+}
+
+func (c generatedCampaignTriggerScheduleClient) Delete(ctx context.Context, campaignID, scheduleID string) error {
+	deleteRequest := brazeclient.DeleteCampaignTriggerScheduleRequest{
+		CampaignID: campaignID,
+		ScheduleID: scheduleID,
+	}
+
+	deleteResponse, deleteErr := c.client.DeleteCampaignTriggerSchedule(ctx, &deleteRequest)
+
+	c.logger.Log(ctx, "braze_campaign_trigger_schedule.delete", brazeAPILogEntry{
+		Request:  &deleteRequest,
+		Response: deleteResponse,
+		Err:      deleteErr,
+	})
+
+	deleteErr = classifyBrazePermissionError(brazeclient.DeleteCampaignTriggerScheduleOperation, deleteErr)
+
+	if deleteErr != nil {
+		return fmt.Errorf("delete campaign trigger schedule: %w", classifyBrazeObjectReadError(deleteErr))
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type brazeCampaignTriggerScheduleModel struct {
+	CampaignID            types.String                         `tfsdk:"campaign_id"`
+	ScheduleID            types.String                         `tfsdk:"schedule_id"`
+	DispatchID            types.String                         `tfsdk:"dispatch_id"`
+	SendID                types.String                         `tfsdk:"send_id"`
+	Broadcast             types.Bool                           `tfsdk:"broadcast"`
+	Recipients            []brazeCampaignTriggerRecipientModel `tfsdk:"recipients"`
+	AudienceJSON          jsontypes.Normalized                 `tfsdk:"audience_json"`
+	TriggerPropertiesJSON jsontypes.Normalized                 `tfsdk:"trigger_properties_json"`
+	Schedule              brazeMessageScheduleModel            `tfsdk:"schedule"`
+}
+
+type brazeCampaignTriggerRecipientModel struct {
+	ExternalUserID        types.String         `tfsdk:"external_user_id"`
+	TriggerPropertiesJSON jsontypes.Normalized `tfsdk:"trigger_properties_json"`
+}
+
+func (m brazeCampaignTriggerScheduleModel) ToCreateCampaignTriggerScheduleRequest() (brazeclient.CreateCampaignTriggerScheduleRequest, error) {
+	schedule, err := m.Schedule.toMessageSchedule()
+	if err != nil {
+		return brazeclient.CreateCampaignTriggerScheduleRequest{}, err
+	}
+
+	request := brazeclient.CreateCampaignTriggerScheduleRequest{
+		CampaignID: m.CampaignID.ValueString(),
+		SendID:     brazeclient.NewOptPointerString(m.SendID.ValueStringPointer()),
+		Broadcast:  brazeclient.NewOptPointerBool(m.Broadcast.ValueBoolPointer()),
+		Schedule:   schedule,
+	}
+
+	for _, recipient := range m.Recipients {
+		properties, err := jsonObjectFromNormalized(recipient.TriggerPropertiesJSON, "recipients.trigger_properties_json")
+		if err != nil {
+			return brazeclient.CreateCampaignTriggerScheduleRequest{}, err
+		}
+
+		triggerRecipient := brazeclient.CampaignTriggerRecipient{
+			ExternalUserID: recipient.ExternalUserID.ValueString(),
+		}
+
+		if properties != nil {
+			triggerRecipient.TriggerProperties = brazeclient.NewOptTriggerProperties(properties)
+		}
+
+		request.Recipients = append(request.Recipients, triggerRecipient)
+	}
+
+	audience, err := jsonObjectFromNormalized(m.AudienceJSON, "audience_json")
+	if err != nil {
+		return brazeclient.CreateCampaignTriggerScheduleRequest{}, err
+	}
+
+	if audience != nil {
+		request.Audience = brazeclient.NewOptConnectedAudience(audience)
+	}
+
+	properties, err := jsonObjectFromNormalized(m.TriggerPropertiesJSON, "trigger_properties_json")
+	if err != nil {
+		return brazeclient.CreateCampaignTriggerScheduleRequest{}, err
+	}
+
+	if properties != nil {
+		request.TriggerProperties = brazeclient.NewOptTriggerProperties(properties)
+	}
+
+	return request, nil
+}
+
+func (m brazeCampaignTriggerScheduleModel) ToUpdateCampaignTriggerScheduleRequest() (brazeclient.UpdateCampaignTriggerScheduleRequest, error) {
+	schedule, err := m.Schedule.toMessageSchedule()
+	if err != nil {
+		return brazeclient.UpdateCampaignTriggerScheduleRequest{}, err
+	}
+
+	return brazeclient.UpdateCampaignTriggerScheduleRequest{
+		CampaignID: m.CampaignID.ValueString(),
+		ScheduleID: m.ScheduleID.ValueString(),
+		Schedule:   schedule,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrazeCampaignTriggerScheduleModelToCreateRequest(t *testing.T) {
+	t.Parallel()
+
+	model := brazeCampaignTriggerScheduleModel{
+		CampaignID: types.StringValue("campaign-1"),
+		SendID:     types.StringNull(),
+		Broadcast:  types.BoolNull(),
+		Recipients: []brazeCampaignTriggerRecipientModel{
+			{
+				ExternalUserID:        types.StringValue("user-1"),
+				TriggerPropertiesJSON: jsontypes.NewNormalizedValue(`{"order_id":"order-1"}`),
+			},
+			{
+				ExternalUserID:        types.StringValue("user-2"),
+				TriggerPropertiesJSON: jsontypes.NewNormalizedNull(),
+			},
+		},
+		AudienceJSON:          jsontypes.NewNormalizedNull(),
+		TriggerPropertiesJSON: jsontypes.NewNormalizedValue(`{"source":"terraform"}`),
+		Schedule: brazeMessageScheduleModel{
+			Time:          timetypes.NewRFC3339ValueMust("2030-03-10T09:00:00Z"),
+			AtOptimalTime: types.BoolValue(true),
+		},
+	}
+
+	request, err := model.ToCreateCampaignTriggerScheduleRequest()
+	require.NoError(t, err)
+
+	assert.Equal(t, "campaign-1", request.CampaignID)
+	assert.False(t, request.SendID.IsSet())
+	assert.False(t, request.Broadcast.IsSet())
+	require.Len(t, request.Recipients, 2)
+	assert.Equal(t, "user-1", request.Recipients[0].ExternalUserID)
+	assert.Equal(t, `"order-1"`, string(request.Recipients[0].TriggerProperties.Value["order_id"]))
+	assert.False(t, request.Recipients[1].TriggerProperties.IsSet())
+	assert.False(t, request.Audience.IsSet())
+	assert.Equal(t, `"terraform"`, string(request.TriggerProperties.Value["source"]))
+	assert.True(t, request.Schedule.AtOptimalTime.Or(false))
+
+	model.Recipients[1].TriggerPropertiesJSON = jsontypes.NewNormalizedValue(`"not an object"`)
+
+	_, err = model.ToCreateCampaignTriggerScheduleRequest()
+	require.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = (*brazeCampaignTriggerScheduleResource)(nil)
+	_ resource.ResourceWithConfigure      = (*brazeCampaignTriggerScheduleResource)(nil)
+	_ resource.ResourceWithIdentity       = (*brazeCampaignTriggerScheduleResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*brazeCampaignTriggerScheduleResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*brazeCampaignTriggerScheduleResource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeCampaignTriggerScheduleResource() resource.Resource {
+	return &brazeCampaignTriggerScheduleResource{}
+}
+
+type brazeCampaignTriggerScheduleResource struct {
+	providerData brazeProviderData
+}
+
+func (r *brazeCampaignTriggerScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_campaign_trigger_schedule"
+}
+
+func (r *brazeCampaignTriggerScheduleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = BrazeCampaignTriggerScheduleResourceIdentitySchema()
+}
+
+func (r *brazeCampaignTriggerScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = BrazeCampaignTriggerScheduleResourceSchema()
+}
+
+func (r *brazeCampaignTriggerScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazeCampaignTriggerScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateTriggerScheduleConfig(ctx, req.Config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sendID types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("send_id"), &sendID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateBrazeSendID(path.Root("send_id"), sendID)...)
+}
+
+func (r *brazeCampaignTriggerScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "campaign trigger schedule")
+	addRequiresReplaceIfScheduleSent(ctx, req, resp)
+}
+
+func (r *brazeCampaignTriggerScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_campaign_trigger_schedule", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeCampaignTriggerScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.campaignTriggerSchedules.Create(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Campaign Trigger Schedule", detailFromError(err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(setTriggerScheduleIdentityAndState(ctx, resp.Identity, &resp.State, "campaign_id", data.CampaignID.ValueString(), data.ScheduleID.ValueString(), &data)...)
+}
+
+// Read keeps the prior state, as Braze has no API to read schedules, until
+// Braze has started sending the campaign, when the schedule is removed.
+func (r *brazeCampaignTriggerScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state brazeCampaignTriggerScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Schedule.sent(time.Now()) {
+		resp.Diagnostics.AddWarning("Campaign Trigger Schedule sent", "Braze has started sending the campaign, so the schedule has been removed from state.")
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(setTriggerScheduleIdentityAndState(ctx, resp.Identity, &resp.State, "campaign_id", state.CampaignID.ValueString(), state.ScheduleID.ValueString(), &state)...)
+}
+
+func (r *brazeCampaignTriggerScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_campaign_trigger_schedule", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeCampaignTriggerScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.campaignTriggerSchedules.Update(ctx, plan)
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddError("Campaign Trigger Schedule not found", detailFromError(err))
+		} else {
+			resp.Diagnostics.AddError("Failed to update Campaign Trigger Schedule", detailFromError(err))
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(setTriggerScheduleIdentityAndState(ctx, resp.Identity, &resp.State, "campaign_id", data.CampaignID.ValueString(), data.ScheduleID.ValueString(), &data)...)
+}
+
+// Delete cancels the schedule. A schedule Braze has started sending cannot be
+// cancelled, so it is only removed from state.
+func (r *brazeCampaignTriggerScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_campaign_trigger_schedule", "delete")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var state brazeCampaignTriggerScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || state.Schedule.sent(time.Now()) {
+		return
+	}
+
+	err := r.providerData.campaignTriggerSchedules.Delete(ctx, state.CampaignID.ValueString(), state.ScheduleID.ValueString())
+	if err != nil && !isBrazeObjectNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete Campaign Trigger Schedule", detailFromError(err))
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func BrazeCampaignTriggerScheduleResourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"campaign_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"schedule_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func BrazeCampaignTriggerScheduleResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manage a scheduled send of an API-triggered campaign. Braze has no API to read schedules, so changes made outside Terraform are not detected. Once Braze has started sending the campaign the schedule is removed from state, and changing its schedule replaces it with a new one.",
+		Attributes: map[string]schema.Attribute{
+			"campaign_id": schema.StringAttribute{
+				Description: "The identifier of the API-triggered campaign to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				Description: "The identifier Braze assigned to the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dispatch_id": schema.StringAttribute{
+				Description: "The identifier of the send, for matching message events to the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"send_id": schema.StringAttribute{
+				Description: "A send identifier of the campaign, for example from a braze_send_id resource, to track the analytics of this send by.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"broadcast": triggerScheduleBroadcastAttribute("campaign"),
+			"recipients": schema.ListNestedAttribute{
+				Description: "The users to send to, each with properties for the campaign's templates. Either broadcast must be true or recipients must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"external_user_id": schema.StringAttribute{
+							Description: "The external user ID of the user.",
+							Required:    true,
+						},
+						"trigger_properties_json": schema.StringAttribute{
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "A JSON object of properties for this user, available in the campaign's templates as api_trigger_properties. They override trigger_properties_json.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"audience_json": triggerScheduleAudienceAttribute(),
+			"trigger_properties_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "A JSON object of properties for every user, available in the campaign's templates as api_trigger_properties.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": messageScheduleAttribute("campaign"),
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package provider_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var (
+	errCampaignTriggerScheduleNotScheduled = errors.New("campaign trigger schedule not scheduled")
+	errCampaignTriggerScheduleTime         = errors.New("unexpected campaign trigger schedule time")
+)
+
+func brazeCampaignTriggerScheduleConfig(scheduleTime time.Time) string {
+	return fmt.Sprintf(`
+resource "braze_campaign_trigger_schedule" "test" {
+  campaign_id = "campaign-1"
+
+  recipients = [
+    {
+      external_user_id        = "user-1"
+      trigger_properties_json = jsonencode({ order_id = "order-1" })
+    },
+  ]
+
+  trigger_properties_json = jsonencode({ source = "terraform" })
+
+  schedule = {
+    time = %q
+  }
+}
+`, scheduleTime.UTC().Format(time.RFC3339))
+}
+
+func testCheckBrazeCampaignTriggerScheduleTime(server *brazeclienttesting.Server, scheduleTime time.Time) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		scheduleID := state.RootModule().Resources["braze_campaign_trigger_schedule.test"].Primary.Attributes["schedule_id"]
+
+		request, exists := server.CampaignTriggerSchedule(scheduleID)
+		if !exists {
+			return fmt.Errorf("%w: %s", errCampaignTriggerScheduleNotScheduled, scheduleID)
+		}
+
+		if !request.Schedule.Time.Equal(scheduleTime) {
+			return fmt.Errorf("%w: expected %s, got %s", errCampaignTriggerScheduleTime, scheduleTime, request.Schedule.Time)
+		}
+
+		return nil
+	}
+}
+
+func TestAccBrazeCampaignTriggerSchedule(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCampaign("campaign-1", "Order shipped", nil, "api_triggered", false)
+
+	scheduleTime := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: brazeCampaignTriggerScheduleConfig(scheduleTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("braze_campaign_trigger_schedule.test", "schedule_id"),
+					resource.TestCheckResourceAttrSet("braze_campaign_trigger_schedule.test", "dispatch_id"),
+					resource.TestCheckResourceAttr("braze_campaign_trigger_schedule.test", "recipients.#", "1"),
+					testCheckBrazeCampaignTriggerScheduleTime(server, scheduleTime),
+				),
+			},
+			{
+				Config: brazeCampaignTriggerScheduleConfig(scheduleTime.Add(time.Hour)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_campaign_trigger_schedule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testCheckBrazeCampaignTriggerScheduleTime(server, scheduleTime.Add(time.Hour)),
+			},
+		},
+	})
+}
+
+func TestAccBrazeCampaignTriggerScheduleSent(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCampaign("campaign-1", "Order shipped", nil, "api_triggered", false)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:             brazeCampaignTriggerScheduleConfig(time.Now().Add(-time.Hour).Truncate(time.Second)),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_campaign_trigger_schedule.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccBrazeCampaignTriggerScheduleInvalidAudience(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "braze_campaign_trigger_schedule" "test" {
+					campaign_id = "campaign-1"
+					broadcast   = true
+
+					recipients = [
+						{ external_user_id = "user-1" },
+					]
+
+					schedule = {
+						time = "2030-01-01T09:00:00Z"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid trigger schedule audience`),
+			},
+		},
+	})
+}
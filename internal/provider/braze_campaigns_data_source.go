@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource                   = (*brazeCampaignsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*brazeCampaignsDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*brazeCampaignsDataSource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeCampaignsDataSource() datasource.DataSource {
+	return &brazeCampaignsDataSource{}
+}
+
+type brazeCampaignsDataSource struct {
+	providerData brazeProviderData
+}
+
+type brazeCampaignsDataSourceModel struct {
+	brazeMessagingObjectsFilterModel
+
+	Campaigns []brazeMessagingObjectModel `tfsdk:"campaigns"`
+}
+
+func (d *brazeCampaignsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_campaigns"
+}
+
+func (d *brazeCampaignsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = brazeMessagingObjectsDataSourceSchema(ctx,
+		"Reads the campaigns in the workspace, for example to look up the identifiers of API-triggered campaigns.",
+		"campaign", "campaigns", "campaigns")
+}
+
+func (d *brazeCampaignsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(SetProviderDataFromDataSourceConfigureRequest(req, &d.providerData)...)
+}
+
+func (d *brazeCampaignsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config brazeCampaignsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, filterDiags := config.toFilter(0)
+	resp.Diagnostics.Append(filterDiags...)
+}
+
+func (d *brazeCampaignsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, d.providerData.tracer, "braze_campaigns", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var config brazeCampaignsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, filterDiags := config.toFilter(d.providerData.listConcurrency)
+	resp.Diagnostics.Append(filterDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	campaigns, err := d.providerData.campaigns.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list campaigns", detailFromError(err))
+
+		return
+	}
+
+	config.Campaigns = newBrazeMessagingObjectModels(campaigns)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,81 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeCampaignsDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCampaign("campaign-password-reset", "Password reset", []string{"transactional"}, "api_triggered", false)
+	server.SetCampaign("campaign-receipt", "Receipt", []string{"transactional"}, "api_triggered", true)
+	server.SetCampaign("campaign-newsletter", "Newsletter", []string{"marketing"}, "time_based", false)
+
+	for i := range 150 {
+		server.SetCampaign(fmt.Sprintf("campaign-bulk-%03d", i), fmt.Sprintf("Bulk %03d", i), nil, "time_based", false)
+	}
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_campaigns" "transactional" {
+					tag = "transactional"
+				}
+
+				data "braze_campaigns" "unarchived_api_triggered" {
+					tag      = "transactional"
+					archived = false
+				}
+
+				data "braze_campaigns" "newsletter" {
+					name = "Newsletter"
+				}
+
+				data "braze_campaigns" "bulk" {
+					name_regex = "^Bulk 1[0-9]{2}$"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braze_campaigns.transactional", "campaigns.#", "2"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.unarchived_api_triggered", "campaigns.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.unarchived_api_triggered", "campaigns.0.id", "campaign-password-reset"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.unarchived_api_triggered", "campaigns.0.name", "Password reset"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.unarchived_api_triggered", "campaigns.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.unarchived_api_triggered", "campaigns.0.tags.0", "transactional"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.unarchived_api_triggered", "campaigns.0.api_triggered", "true"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.unarchived_api_triggered", "campaigns.0.archived", "false"),
+					resource.TestCheckResourceAttrSet("data.braze_campaigns.unarchived_api_triggered", "campaigns.0.last_edited"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.newsletter", "campaigns.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.newsletter", "campaigns.0.api_triggered", "false"),
+					resource.TestCheckResourceAttr("data.braze_campaigns.bulk", "campaigns.#", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBrazeCampaignsDataSourceInvalidNameRegex(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_campaigns" "test" {
+					name_regex = "("
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid name_regex`),
+			},
+		},
+	})
+}
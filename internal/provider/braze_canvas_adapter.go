@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+type canvasClient interface {
+	List(ctx context.Context, filter brazeMessagingObjectFilter) ([]brazeMessagingObject, error)
+}
+
+type generatedCanvasClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedCanvasClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedCanvasClient {
+	return generatedCanvasClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+	}
+}
+
+func (c generatedCanvasClient) List(ctx context.Context, filter brazeMessagingObjectFilter) ([]brazeMessagingObject, error) {
+	return listBrazeMessagingObjects(filter, func(page int, includeArchived bool) ([]brazeMessagingObject, error) {
+		return c.listPage(ctx, page, includeArchived)
+	}, func(object *brazeMessagingObject) error {
+		return c.readDetails(ctx, object)
+	})
+}
+
+func (c generatedCanvasClient) listPage(ctx context.Context, page int, includeArchived bool) ([]brazeMessagingObject, error) {
+	listParams := brazeclient.ListCanvasesParams{
+		Page:            brazeclient.NewOptInt(page),
+		IncludeArchived: brazeclient.NewOptBool(includeArchived),
+	}
+
+	listResponse, listErr := c.client.ListCanvases(ctx, listParams)
+
+	c.logger.Log(ctx, "braze_canvases.list", brazeAPILogEntry{
+		Params:   listParams,
+		Response: listResponse,
+		Err:      listErr,
+	})
+
+	listErr = classifyBrazePermissionError(brazeclient.ListCanvasesOperation, listErr)
+
+	if listErr != nil {
+		return nil, fmt.Errorf("list Canvases: %w", listErr)
+	}
+
+	if listResponse == nil {
+		return nil, errBrazeObjectEmptyResponse
+	}
+
+	canvases := listResponse.GetCanvases()
+	objects := make([]brazeMessagingObject, len(canvases))
+
+	for i, canvas := range canvases {
+		objects[i] = brazeMessagingObject{
+			ID:         canvas.GetID(),
+			Name:       canvas.GetName(),
+			Tags:       canvas.GetTags(),
+			LastEdited: canvas.GetLastEdited().Or(time.Time{}),
+		}
+	}
+
+	return objects, nil
+}
+
+func (c generatedCanvasClient) readDetails(ctx context.Context, object *brazeMessagingObject) error {
+	detailsParams := brazeclient.GetCanvasDetailsParams{
+		CanvasID: object.ID,
+	}
+
+	detailsResponse, detailsErr := c.client.GetCanvasDetails(ctx, detailsParams)
+
+	c.logger.Log(ctx, "braze_canvases.details", brazeAPILogEntry{
+		Params:   detailsParams,
+		Response: detailsResponse,
+		Err:      detailsErr,
+	})
+
+	detailsErr = classifyBrazePermissionError(brazeclient.GetCanvasDetailsOperation, detailsErr)
+
+	if detailsErr != nil {
+		return fmt.Errorf("read Canvas %s: %w", object.ID, detailsErr)
+	}
+
+	if detailsResponse == nil {
+		return errBrazeObjectEmptyResponse
+	}
+
+	object.Archived = detailsResponse.GetArchived().Or(false)
+	object.APITriggered = detailsResponse.GetScheduleType().Or("") == brazeAPITriggeredScheduleType
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type canvasTriggerScheduleClient interface {
+	Create(ctx context.Context, plan brazeCanvasTriggerScheduleModel) (brazeCanvasTriggerScheduleModel, error)
+	Update(ctx context.Context, plan brazeCanvasTriggerScheduleModel) (brazeCanvasTriggerScheduleModel, error)
+	Delete(ctx context.Context, canvasID, scheduleID string) error
+}
+
+type generatedCanvasTriggerScheduleClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedCanvasTriggerScheduleClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedCanvasTriggerScheduleClient {
+	return generatedCanvasTriggerScheduleClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads, "recipients", "canvas_entry_properties"),
+	}
+}
+
+// Create schedules the Canvas. Braze cannot read schedules back, so the
+// result is the plan with the identifiers Braze assigned.
+func (c generatedCanvasTriggerScheduleClient) Create(ctx context.Context, plan brazeCanvasTriggerScheduleModel) (brazeCanvasTriggerScheduleModel, error) {
+	createRequest, err := plan.ToCreateCanvasTriggerScheduleRequest()
+	if err != nil {
+		return brazeCanvasTriggerScheduleModel{}, err
+	}
+
+	createResponse, createErr := c.client.CreateCanvasTriggerSchedule(ctx, &createRequest)
+
+	c.logger.Log(ctx, "braze_canvas_trigger_schedule.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
+	})
+
+	createErr = classifyBrazePermissionError(brazeclient.CreateCanvasTriggerScheduleOperation, createErr)
+
+	if createErr != nil {
+		return brazeCanvasTriggerScheduleModel{}, fmt.Errorf("create Canvas trigger schedule: %w", createErr)
+	}
+
+	if createResponse == nil {
+		return brazeCanvasTriggerScheduleModel{}, errBrazeObjectEmptyResponse
+	}
+
+	plan.ScheduleID = types.StringValue(createResponse.GetScheduleID())
+	plan.DispatchID = types.StringPointerValue(createResponse.GetDispatchID().GetPointer())
+
+	return plan, nil
+}
+
+func (c generatedCanvasTriggerScheduleClient) Update(ctx context.Context, plan brazeCanvasTriggerScheduleModel) (brazeCanvasTriggerScheduleModel, error) {
+	updateRequest, err := plan.ToUpdateCanvasTriggerScheduleRequest()
+	if err != nil {
+		return brazeCanvasTriggerScheduleModel{}, err
+	}
+
+	updateResponse, updateErr := c.client.UpdateCanvasTriggerSchedule(ctx, &updateRequest)
+
+	c.logger.Log(ctx, "braze_canvas_trigger_schedule.update", brazeAPILogEntry{
+		Request:  &updateRequest,
+		Response: updateResponse,
+		Err:      updateErr,
+	})
+
+	updateErr = classifyBrazePermissionError(brazeclient.UpdateCanvasTriggerScheduleOperation, updateErr)
+
+	if updateErr != nil {
+		return brazeCanvasTriggerScheduleModel{}, fmt.Errorf("update Canvas trigger schedule: %w", classifyBrazeObjectReadError(updateErr))
+	}
+
+	return plan, nil
+}
+
+func (c generatedCanvasTriggerScheduleClient) Delete(ctx context.Context, canvasID, scheduleID string) error {
+	deleteRequest := brazeclient.DeleteCanvasTriggerScheduleRequest{
+		CanvasID:   canvasID,
+		ScheduleID: scheduleID,
+	}
+
+	deleteResponse, deleteErr := c.client.DeleteCanvasTriggerSchedule(ctx, &deleteRequest)
+
+	c.logger.Log(ctx, "braze_canvas_trigger_schedule.delete", brazeAPILogEntry{
+		Request:  &deleteRequest,
+		Response: deleteResponse,
+		Err:      deleteErr,
+	})
+
+	deleteErr = classifyBrazePermissionError(brazeclient.DeleteCanvasTriggerScheduleOperation, deleteErr)
+
+	if deleteErr != nil {
+		return fmt.Errorf("delete Canvas trigger schedule: %w", classifyBrazeObjectReadError(deleteErr))
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type brazeCanvasTriggerScheduleModel struct {
+	CanvasID                  types.String                       `tfsdk:"canvas_id"`
+	ScheduleID                types.String                       `tfsdk:"schedule_id"`
+	DispatchID                types.String                       `tfsdk:"dispatch_id"`
+	Broadcast                 types.Bool                         `tfsdk:"broadcast"`
+	Recipients                []brazeCanvasTriggerRecipientModel `tfsdk:"recipients"`
+	AudienceJSON              jsontypes.Normalized               `tfsdk:"audience_json"`
+	CanvasEntryPropertiesJSON jsontypes.Normalized               `tfsdk:"canvas_entry_properties_json"`
+	Schedule                  brazeMessageScheduleModel          `tfsdk:"schedule"`
+}
+
+type brazeCanvasTriggerRecipientModel struct {
+	ExternalUserID            types.String         `tfsdk:"external_user_id"`
+	CanvasEntryPropertiesJSON jsontypes.Normalized `tfsdk:"canvas_entry_properties_json"`
+}
+
+func (m brazeCanvasTriggerScheduleModel) ToCreateCanvasTriggerScheduleRequest() (brazeclient.CreateCanvasTriggerScheduleRequest, error) {
+	schedule, err := m.Schedule.toMessageSchedule()
+	if err != nil {
+		return brazeclient.CreateCanvasTriggerScheduleRequest{}, err
+	}
+
+	request := brazeclient.CreateCanvasTriggerScheduleRequest{
+		CanvasID:  m.CanvasID.ValueString(),
+		Broadcast: brazeclient.NewOptPointerBool(m.Broadcast.ValueBoolPointer()),
+		Schedule:  schedule,
+	}
+
+	for _, recipient := range m.Recipients {
+		properties, err := jsonObjectFromNormalized(recipient.CanvasEntryPropertiesJSON, "recipients.canvas_entry_properties_json")
+		if err != nil {
+			return brazeclient.CreateCanvasTriggerScheduleRequest{}, err
+		}
+
+		triggerRecipient := brazeclient.CanvasTriggerRecipient{
+			ExternalUserID: recipient.ExternalUserID.ValueString(),
+		}
+
+		if properties != nil {
+			triggerRecipient.CanvasEntryProperties = brazeclient.NewOptCanvasEntryProperties(properties)
+		}
+
+		request.Recipients = append(request.Recipients, triggerRecipient)
+	}
+
+	audience, err := jsonObjectFromNormalized(m.AudienceJSON, "audience_json")
+	if err != nil {
+		return brazeclient.CreateCanvasTriggerScheduleRequest{}, err
+	}
+
+	if audience != nil {
+		request.Audience = brazeclient.NewOptConnectedAudience(audience)
+	}
+
+	properties, err := jsonObjectFromNormalized(m.CanvasEntryPropertiesJSON, "canvas_entry_properties_json")
+	if err != nil {
+		return brazeclient.CreateCanvasTriggerScheduleRequest{}, err
+	}
+
+	if properties != nil {
+		request.CanvasEntryProperties = brazeclient.NewOptCanvasEntryProperties(properties)
+	}
+
+	return request, nil
+}
+
+func (m brazeCanvasTriggerScheduleModel) ToUpdateCanvasTriggerScheduleRequest() (brazeclient.UpdateCanvasTriggerScheduleRequest, error) {
+	schedule, err := m.Schedule.toMessageSchedule()
+	if err != nil {
+		return brazeclient.UpdateCanvasTriggerScheduleRequest{}, err
+	}
+
+	return brazeclient.UpdateCanvasTriggerScheduleRequest{
+		CanvasID:   m.CanvasID.ValueString(),
+		ScheduleID: m.ScheduleID.ValueString(),
+		Schedule:   schedule,
+	}, nil
+}
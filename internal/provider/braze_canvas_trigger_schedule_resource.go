@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource                   = (*brazeCanvasTriggerScheduleResource)(nil)
+	_ resource.ResourceWithConfigure      = (*brazeCanvasTriggerScheduleResource)(nil)
+	_ resource.ResourceWithIdentity       = (*brazeCanvasTriggerScheduleResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*brazeCanvasTriggerScheduleResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*brazeCanvasTriggerScheduleResource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeCanvasTriggerScheduleResource() resource.Resource {
+	return &brazeCanvasTriggerScheduleResource{}
+}
+
+type brazeCanvasTriggerScheduleResource struct {
+	providerData brazeProviderData
+}
+
+func (r *brazeCanvasTriggerScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_canvas_trigger_schedule"
+}
+
+func (r *brazeCanvasTriggerScheduleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = BrazeCanvasTriggerScheduleResourceIdentitySchema()
+}
+
+func (r *brazeCanvasTriggerScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = BrazeCanvasTriggerScheduleResourceSchema()
+}
+
+func (r *brazeCanvasTriggerScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazeCanvasTriggerScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateTriggerScheduleConfig(ctx, req.Config)...)
+}
+
+func (r *brazeCanvasTriggerScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "Canvas trigger schedule")
+	addRequiresReplaceIfScheduleSent(ctx, req, resp)
+}
+
+func (r *brazeCanvasTriggerScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_canvas_trigger_schedule", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeCanvasTriggerScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.canvasTriggerSchedules.Create(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Canvas Trigger Schedule", detailFromError(err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(setTriggerScheduleIdentityAndState(ctx, resp.Identity, &resp.State, "canvas_id", data.CanvasID.ValueString(), data.ScheduleID.ValueString(), &data)...)
+}
+
+// Read keeps the prior state, as Braze has no API to read schedules, until
+// Braze has started sending the Canvas, when the schedule is removed.
+func (r *brazeCanvasTriggerScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state brazeCanvasTriggerScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Schedule.sent(time.Now()) {
+		resp.Diagnostics.AddWarning("Canvas Trigger Schedule sent", "Braze has started sending the Canvas, so the schedule has been removed from state.")
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(setTriggerScheduleIdentityAndState(ctx, resp.Identity, &resp.State, "canvas_id", state.CanvasID.ValueString(), state.ScheduleID.ValueString(), &state)...)
+}
+
+func (r *brazeCanvasTriggerScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_canvas_trigger_schedule", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeCanvasTriggerScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.canvasTriggerSchedules.Update(ctx, plan)
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddError("Canvas Trigger Schedule not found", detailFromError(err))
+		} else {
+			resp.Diagnostics.AddError("Failed to update Canvas Trigger Schedule", detailFromError(err))
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(setTriggerScheduleIdentityAndState(ctx, resp.Identity, &resp.State, "canvas_id", data.CanvasID.ValueString(), data.ScheduleID.ValueString(), &data)...)
+}
+
+// Delete cancels the schedule. A schedule Braze has started sending cannot be
+// cancelled, so it is only removed from state.
+func (r *brazeCanvasTriggerScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_canvas_trigger_schedule", "delete")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var state brazeCanvasTriggerScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || state.Schedule.sent(time.Now()) {
+		return
+	}
+
+	err := r.providerData.canvasTriggerSchedules.Delete(ctx, state.CanvasID.ValueString(), state.ScheduleID.ValueString())
+	if err != nil && !isBrazeObjectNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete Canvas Trigger Schedule", detailFromError(err))
+	}
+}
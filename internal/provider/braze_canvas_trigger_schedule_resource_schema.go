@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func BrazeCanvasTriggerScheduleResourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"canvas_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"schedule_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func BrazeCanvasTriggerScheduleResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manage a scheduled entry into an API-triggered Canvas. Braze has no API to read schedules, so changes made outside Terraform are not detected. Once Braze has started sending the Canvas the schedule is removed from state, and changing its schedule replaces it with a new one.",
+		Attributes: map[string]schema.Attribute{
+			"canvas_id": schema.StringAttribute{
+				Description: "The identifier of the API-triggered Canvas to send.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				Description: "The identifier Braze assigned to the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dispatch_id": schema.StringAttribute{
+				Description: "The identifier of the send, for matching message events to the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcast": triggerScheduleBroadcastAttribute("Canvas"),
+			"recipients": schema.ListNestedAttribute{
+				Description: "The users to enter into the Canvas, each with properties for its steps. Either broadcast must be true or recipients must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"external_user_id": schema.StringAttribute{
+							Description: "The external user ID of the user.",
+							Required:    true,
+						},
+						"canvas_entry_properties_json": schema.StringAttribute{
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "A JSON object of properties for this user, available in the Canvas as canvas_entry_properties. They override canvas_entry_properties_json.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"audience_json": triggerScheduleAudienceAttribute(),
+			"canvas_entry_properties_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "A JSON object of properties for every user, available in the Canvas as canvas_entry_properties.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": messageScheduleAttribute("Canvas"),
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package provider_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var errCanvasTriggerScheduleNotCancelled = errors.New("canvas trigger schedule not cancelled")
+
+func brazeCanvasTriggerScheduleConfig(scheduleTime time.Time) string {
+	return fmt.Sprintf(`
+resource "braze_canvas_trigger_schedule" "test" {
+  canvas_id = "canvas-1"
+  broadcast = true
+
+  canvas_entry_properties_json = jsonencode({ source = "terraform" })
+
+  schedule = {
+    time          = %q
+    in_local_time = true
+  }
+}
+`, scheduleTime.UTC().Format(time.RFC3339))
+}
+
+func testCheckBrazeCanvasTriggerScheduleCancelled(server *brazeclienttesting.Server, scheduleID *string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, exists := server.CanvasTriggerSchedule(*scheduleID); exists {
+			return fmt.Errorf("%w: %s", errCanvasTriggerScheduleNotCancelled, *scheduleID)
+		}
+
+		return nil
+	}
+}
+
+func TestAccBrazeCanvasTriggerSchedule(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCanvas("canvas-1", "Winter sale", nil, "api_triggered", false)
+
+	scheduleTime := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+
+	var scheduleID string
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: brazeCanvasTriggerScheduleConfig(scheduleTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_canvas_trigger_schedule.test", "canvas_id", "canvas-1"),
+					resource.TestCheckResourceAttrSet("braze_canvas_trigger_schedule.test", "schedule_id"),
+					resource.TestCheckResourceAttrWith("braze_canvas_trigger_schedule.test", "schedule_id", func(value string) error {
+						scheduleID = value
+
+						return nil
+					}),
+				),
+			},
+			{
+				Config: brazeCanvasTriggerScheduleConfig(scheduleTime.Add(24 * time.Hour)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_canvas_trigger_schedule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+		CheckDestroy: testCheckBrazeCanvasTriggerScheduleCancelled(server, &scheduleID),
+	})
+}
+
+func TestAccBrazeCanvasTriggerScheduleSent(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCanvas("canvas-1", "Winter sale", nil, "api_triggered", false)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:             brazeCanvasTriggerScheduleConfig(time.Now().Add(-time.Hour).Truncate(time.Second)),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_canvas_trigger_schedule.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccBrazeCanvasTriggerScheduleInvalidCanvas(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCanvas("canvas-1", "Winter sale", nil, "time_based", false)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      brazeCanvasTriggerScheduleConfig(time.Now().Add(48 * time.Hour).Truncate(time.Second)),
+				ExpectError: regexp.MustCompile(`Failed to create Canvas Trigger Schedule`),
+			},
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource                   = (*brazeCanvasesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*brazeCanvasesDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*brazeCanvasesDataSource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeCanvasesDataSource() datasource.DataSource {
+	return &brazeCanvasesDataSource{}
+}
+
+type brazeCanvasesDataSource struct {
+	providerData brazeProviderData
+}
+
+type brazeCanvasesDataSourceModel struct {
+	brazeMessagingObjectsFilterModel
+
+	Canvases []brazeMessagingObjectModel `tfsdk:"canvases"`
+}
+
+func (d *brazeCanvasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_canvases"
+}
+
+func (d *brazeCanvasesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = brazeMessagingObjectsDataSourceSchema(ctx,
+		"Reads the Canvases in the workspace, for example to look up the identifiers of API-triggered Canvases.",
+		"Canvas", "Canvases", "canvases")
+}
+
+func (d *brazeCanvasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(SetProviderDataFromDataSourceConfigureRequest(req, &d.providerData)...)
+}
+
+func (d *brazeCanvasesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config brazeCanvasesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, filterDiags := config.toFilter(0)
+	resp.Diagnostics.Append(filterDiags...)
+}
+
+func (d *brazeCanvasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, d.providerData.tracer, "braze_canvases", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var config brazeCanvasesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, filterDiags := config.toFilter(d.providerData.listConcurrency)
+	resp.Diagnostics.Append(filterDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	canvases, err := d.providerData.canvases.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Canvases", detailFromError(err))
+
+		return
+	}
+
+	config.Canvases = newBrazeMessagingObjectModels(canvases)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
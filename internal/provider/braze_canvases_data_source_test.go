@@ -0,0 +1,45 @@
+package provider_test
+
+import (
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeCanvasesDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCanvas("canvas-onboarding", "Onboarding", []string{"lifecycle"}, "action_based", false)
+	server.SetCanvas("canvas-order-updates", "Order updates", []string{"transactional"}, "api_triggered", false)
+	server.SetCanvas("canvas-winback", "Winback", []string{"lifecycle"}, "time_based", true)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_canvases" "all" {}
+
+				data "braze_canvases" "archived" {
+					archived = true
+				}
+
+				data "braze_canvases" "order" {
+					name_regex = "^Order"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braze_canvases.all", "canvases.#", "3"),
+					resource.TestCheckResourceAttr("data.braze_canvases.archived", "canvases.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_canvases.archived", "canvases.0.id", "canvas-winback"),
+					resource.TestCheckResourceAttr("data.braze_canvases.archived", "canvases.0.archived", "true"),
+					resource.TestCheckResourceAttr("data.braze_canvases.order", "canvases.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_canvases.order", "canvases.0.id", "canvas-order-updates"),
+					resource.TestCheckResourceAttr("data.braze_canvases.order", "canvases.0.api_triggered", "true"),
+					resource.TestCheckResourceAttr("data.braze_canvases.order", "canvases.0.tags.0", "transactional"),
+				),
+			},
+		},
+	})
+}
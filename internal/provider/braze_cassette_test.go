@@ -0,0 +1,133 @@
+//nolint:testpackage
+package provider
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBrazeCassetteCatalogLifecycle runs a catalog and catalog item
+// lifecycle through the generated clients. Run it with TF_ACC_RECORD,
+// BRAZE_BASE_URL and BRAZE_API_KEY set to record it against a Braze
+// workspace, and with TF_ACC_REPLAY set to replay that recording.
+func TestBrazeCassetteCatalogLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client := newCassetteBrazeClient(t)
+	catalogs := newGeneratedCatalogClient(client, brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
+	items := newGeneratedCatalogItemClient(client, brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
+
+	catalogName := "terraform_provider_braze_cassette"
+
+	created, err := catalogs.Create(t.Context(), brazeCatalogModel{
+		Name:        types.StringValue(catalogName),
+		Description: types.StringValue("Recorded by the terraform-provider-braze tests"),
+		Fields: types.ListValueMust(BrazeCatalogFieldObjectType(), []attr.Value{
+			types.ObjectValueMust(BrazeCatalogFieldObjectType().AttrTypes, map[string]attr.Value{
+				"name": types.StringValue("id"),
+				"type": types.StringValue("string"),
+			}),
+			types.ObjectValueMust(BrazeCatalogFieldObjectType().AttrTypes, map[string]attr.Value{
+				"name": types.StringValue("name"),
+				"type": types.StringValue("string"),
+			}),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, catalogName, created.Name.ValueString())
+
+	read, err := catalogs.Read(t.Context(), catalogName)
+	require.NoError(t, err)
+	assert.Equal(t, "Recorded by the terraform-provider-braze tests", read.Description.ValueString())
+
+	item, err := items.Create(t.Context(), brazeCatalogItemModel{
+		CatalogName: types.StringValue(catalogName),
+		ItemID:      types.StringValue("centre1"),
+		ValuesJSON:  jsontypes.NewNormalizedValue(`{"name":"Centre 1"}`),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Centre 1"}`, item.ValuesJSON.ValueString())
+
+	item, err = items.Update(t.Context(), brazeCatalogItemModel{
+		CatalogName: types.StringValue(catalogName),
+		ItemID:      types.StringValue("centre1"),
+		ValuesJSON:  jsontypes.NewNormalizedValue(`{"name":"Centre One"}`),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Centre One"}`, item.ValuesJSON.ValueString())
+
+	require.NoError(t, items.Delete(t.Context(), catalogName, "centre1"))
+	require.NoError(t, catalogs.Delete(t.Context(), catalogName))
+
+	_, err = catalogs.Read(t.Context(), catalogName)
+	require.ErrorIs(t, err, errCatalogNotFound)
+}
+
+// newCassetteBrazeClient returns a client for a test recorded or replayed
+// with BrazeCassetteTransport. The test is skipped when neither TF_ACC_RECORD
+// nor TF_ACC_REPLAY is set.
+func newCassetteBrazeClient(t *testing.T) *brazeclient.Client {
+	t.Helper()
+
+	transport, baseURL, apiKey, ok := BrazeCassetteTransport(t)
+	if !ok {
+		t.Skip("cassette tests are run with TF_ACC_RECORD or TF_ACC_REPLAY set")
+	}
+
+	client, err := brazeclient.NewClient(baseURL, NewBrazeAPIKeySecuritySource(apiKey), brazeclient.WithClient(&http.Client{Transport: transport}))
+	require.NoError(t, err)
+
+	return client
+}
+
+// BrazeCassetteTransport returns the transport, base URL and API key for a
+// test that records to or replays from its cassette. With TF_ACC_RECORD set,
+// requests are sent to BRAZE_BASE_URL with BRAZE_API_KEY and the cassette is
+// saved when the test ends. With TF_ACC_REPLAY set, requests are answered from
+// the cassette, and the test fails if the cassette is missing or if any
+// recorded interaction is not replayed. ok is false when neither is set.
+func BrazeCassetteTransport(t *testing.T) (http.RoundTripper, string, string, bool) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "cassettes", t.Name()+".json")
+
+	switch {
+	case os.Getenv("TF_ACC_RECORD") != "":
+		recorder := brazeclienttesting.NewRecordingTransport(nil)
+		t.Cleanup(func() {
+			err := recorder.Save(path)
+			if err != nil {
+				t.Errorf("failed to save cassette: %v", err)
+			}
+		})
+
+		return recorder, os.Getenv("BRAZE_BASE_URL"), os.Getenv("BRAZE_API_KEY"), true
+
+	case os.Getenv("TF_ACC_REPLAY") != "":
+		replayer, err := brazeclienttesting.LoadReplayingTransport(path)
+		if err != nil {
+			t.Fatalf("failed to load cassette: %v", err)
+		}
+
+		t.Cleanup(func() {
+			if unused := replayer.Unused(); unused != 0 {
+				t.Errorf("%d recorded interactions were not replayed", unused)
+			}
+		})
+
+		return replayer, "https://rest.braze.invalid", "REDACTED", true
+
+	default:
+		return nil, "", "", false
+	}
+}
@@ -4,12 +4,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var errCatalogNotFound = errors.New("catalog not found")
+// Braze has no endpoint to get a single catalog, so every catalog read lists
+// all of them. Caching the listing lets reads within a short window share it.
+const catalogListCacheTTL = 10 * time.Second
+
+var (
+	errCatalogNotFound         = errors.New("catalog not found")
+	errCatalogItemCountUnknown = errors.New("catalog item count not reported")
+)
+
+type catalogListCache = cachedFetch[[]brazeclient.Catalog]
+
+func newCatalogListCache(ttl time.Duration) *catalogListCache {
+	return newCachedFetch[[]brazeclient.Catalog](ttl)
+}
 
 type catalogClient interface {
 	Create(ctx context.Context, plan brazeCatalogModel) (brazeCatalogModel, error)
@@ -20,10 +33,16 @@ type catalogClient interface {
 
 type generatedCatalogClient struct {
 	client *brazeclient.Client
+	logger brazeAPILogger
+	cache  *catalogListCache
 }
 
-func newGeneratedCatalogClient(client *brazeclient.Client) generatedCatalogClient {
-	return generatedCatalogClient{client: client}
+func newGeneratedCatalogClient(client *brazeclient.Client, logPayloads brazeLogPayloads, cache *catalogListCache) generatedCatalogClient {
+	return generatedCatalogClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+		cache:  cache,
+	}
 }
 
 func (c generatedCatalogClient) Create(ctx context.Context, plan brazeCatalogModel) (brazeCatalogModel, error) {
@@ -33,13 +52,16 @@ func (c generatedCatalogClient) Create(ctx context.Context, plan brazeCatalogMod
 	}
 
 	createResponse, createErr := c.client.CreateCatalog(ctx, &createRequest)
+	c.cache.Invalidate()
 
-	tflog.Info(ctx, "braze_catalog.create", map[string]any{
-		"request":  createRequest,
-		"response": createResponse,
-		"err":      createErr,
+	c.logger.Log(ctx, "braze_catalog.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
 	})
 
+	createErr = classifyBrazePermissionError(brazeclient.CreateCatalogOperation, createErr)
+
 	if createErr != nil {
 		return brazeCatalogModel{}, fmt.Errorf("create catalog: %w", createErr)
 	}
@@ -52,23 +74,12 @@ func (c generatedCatalogClient) Create(ctx context.Context, plan brazeCatalogMod
 }
 
 func (c generatedCatalogClient) Read(ctx context.Context, name string) (brazeCatalogModel, error) {
-	listResponse, listErr := c.client.ListCatalogs(ctx)
-
-	tflog.Info(ctx, "braze_catalog.read", map[string]any{
-		"name":     name,
-		"response": listResponse,
-		"err":      listErr,
-	})
-
-	if listErr != nil {
-		return brazeCatalogModel{}, fmt.Errorf("list catalogs: %w", listErr)
-	}
-
-	if listResponse == nil {
-		return brazeCatalogModel{}, errBrazeObjectEmptyResponse
+	catalogs, err := c.listCatalogs(ctx)
+	if err != nil {
+		return brazeCatalogModel{}, err
 	}
 
-	for _, catalog := range listResponse.GetCatalogs() {
+	for _, catalog := range catalogs {
 		if catalog.GetName() == name {
 			return newBrazeCatalogModelFromCatalog(ctx, catalog)
 		}
@@ -77,16 +88,43 @@ func (c generatedCatalogClient) Read(ctx context.Context, name string) (brazeCat
 	return brazeCatalogModel{}, brazeObjectNotFoundError{err: fmt.Errorf("%w: %s", errCatalogNotFound, name)}
 }
 
+// itemCount returns the number of items in a catalog, as reported by the
+// cached catalog listing.
+func (c generatedCatalogClient) itemCount(ctx context.Context, name string) (int, error) {
+	catalogs, err := c.listCatalogs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, catalog := range catalogs {
+		if catalog.GetName() != name {
+			continue
+		}
+
+		numItems, ok := catalog.GetNumItems().Get()
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", errCatalogItemCountUnknown, name)
+		}
+
+		return numItems, nil
+	}
+
+	return 0, fmt.Errorf("%w: %s", errCatalogNotFound, name)
+}
+
 func (c generatedCatalogClient) Delete(ctx context.Context, name string) error {
 	params := brazeclient.DeleteCatalogParams{CatalogName: name}
 	deleteResponse, deleteErr := c.client.DeleteCatalog(ctx, params)
+	c.cache.Invalidate()
 
-	tflog.Info(ctx, "braze_catalog.delete", map[string]any{
-		"params":   params,
-		"response": deleteResponse,
-		"err":      deleteErr,
+	c.logger.Log(ctx, "braze_catalog.delete", brazeAPILogEntry{
+		Params:   params,
+		Response: deleteResponse,
+		Err:      deleteErr,
 	})
 
+	deleteErr = classifyBrazePermissionError(brazeclient.DeleteCatalogOperation, deleteErr)
+
 	if deleteErr != nil {
 		return classifyBrazeObjectReadError(deleteErr)
 	}
@@ -95,23 +133,11 @@ func (c generatedCatalogClient) Delete(ctx context.Context, name string) error {
 }
 
 func (c generatedCatalogClient) List(ctx context.Context) ([]brazeObjectListEntry[brazeCatalogModel], error) {
-	listResponse, listErr := c.client.ListCatalogs(ctx)
-
-	tflog.Info(ctx, "braze_catalog.list", map[string]any{
-		"response": listResponse,
-		"err":      listErr,
-	})
-
-	if listErr != nil {
-		return nil, fmt.Errorf("list catalogs: %w", listErr)
-	}
-
-	if listResponse == nil {
-		return nil, errBrazeObjectEmptyResponse
+	catalogs, err := c.listCatalogs(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	catalogs := listResponse.GetCatalogs()
-
 	entries := make([]brazeObjectListEntry[brazeCatalogModel], 0, len(catalogs))
 	for _, catalog := range catalogs {
 		model, err := newBrazeCatalogModelFromCatalog(ctx, catalog)
@@ -132,3 +158,42 @@ func (c generatedCatalogClient) List(ctx context.Context) ([]brazeObjectListEntr
 
 	return entries, nil
 }
+
+func (c generatedCatalogClient) listCatalogs(ctx context.Context) ([]brazeclient.Catalog, error) {
+	return c.cache.Get(ctx, c.fetchCatalogs)
+}
+
+func (c generatedCatalogClient) fetchCatalogs(ctx context.Context) ([]brazeclient.Catalog, error) {
+	params := brazeclient.ListCatalogsParams{}
+	catalogs := []brazeclient.Catalog{}
+
+	for {
+		listResponse, listErr := c.client.ListCatalogs(ctx, params)
+
+		c.logger.Log(ctx, "braze_catalog.list", brazeAPILogEntry{
+			Params:   params,
+			Response: listResponse,
+			Err:      listErr,
+		})
+
+		listErr = classifyBrazePermissionError(brazeclient.ListCatalogsOperation, listErr)
+
+		if listErr != nil {
+			return nil, fmt.Errorf("list catalogs: %w", listErr)
+		}
+
+		if listResponse == nil {
+			return nil, errBrazeObjectEmptyResponse
+		}
+
+		pageResponse := listResponse.GetResponse()
+		catalogs = append(catalogs, pageResponse.GetCatalogs()...)
+
+		nextCursor, ok := nextCursorFromLinkHeader(listResponse.GetLink())
+		if !ok {
+			return catalogs, nil
+		}
+
+		params.Cursor.SetTo(nextCursor)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const catalogItemListPageSize = 50
@@ -21,11 +20,22 @@ type catalogItemClient interface {
 }
 
 type generatedCatalogItemClient struct {
-	client *brazeclient.Client
+	client    *brazeclient.Client
+	logger    brazeAPILogger
+	snapshots *catalogItemSnapshots
+	catalogs  generatedCatalogClient
 }
 
-func newGeneratedCatalogItemClient(client *brazeclient.Client) generatedCatalogItemClient {
-	return generatedCatalogItemClient{client: client}
+// newGeneratedCatalogItemClient reads catalog sizes through catalogListCache,
+// which it shares with the catalog client. Creating or deleting an item
+// changes the size of its catalog, so both invalidate the cache.
+func newGeneratedCatalogItemClient(client *brazeclient.Client, logPayloads brazeLogPayloads, snapshots *catalogItemSnapshots, catalogListCache *catalogListCache) generatedCatalogItemClient {
+	return generatedCatalogItemClient{
+		client:    client,
+		logger:    newBrazeAPILogger(logPayloads, "items"),
+		snapshots: snapshots,
+		catalogs:  newGeneratedCatalogClient(client, logPayloads, catalogListCache),
+	}
 }
 
 func (c generatedCatalogItemClient) Create(ctx context.Context, plan brazeCatalogItemModel) (brazeCatalogItemModel, error) {
@@ -37,21 +47,47 @@ func (c generatedCatalogItemClient) Create(ctx context.Context, plan brazeCatalo
 	request := brazeclient.CreateCatalogItemRequest{Items: []brazeclient.CatalogItemWrite{item}}
 	params := brazeclient.CreateCatalogItemParams{CatalogName: plan.CatalogName.ValueString(), ItemID: plan.ItemID.ValueString()}
 	response, createErr := c.client.CreateCatalogItem(ctx, &request, params)
+	c.snapshots.Invalidate(plan.CatalogName.ValueString())
+	c.catalogs.cache.Invalidate()
+
+	c.logger.Log(ctx, "braze_catalog_item.create", brazeAPILogEntry{Params: params, Request: &request, Response: response, Err: createErr})
 
-	tflog.Info(ctx, "braze_catalog_item.create", map[string]any{"params": params, "response": response, "err": createErr})
+	createErr = classifyBrazePermissionError(brazeclient.CreateCatalogItemOperation, createErr)
 
 	if createErr != nil {
 		return brazeCatalogItemModel{}, fmt.Errorf("create catalog item: %w", createErr)
 	}
 
-	return c.Read(ctx, plan.CatalogName.ValueString(), plan.ItemID.ValueString())
+	return c.get(ctx, plan.CatalogName.ValueString(), plan.ItemID.ValueString())
 }
 
+// Read serves the item from a snapshot of its catalog when enough of the
+// catalog's items are being read to make listing it cheaper, and otherwise
+// gets it individually.
 func (c generatedCatalogItemClient) Read(ctx context.Context, catalogName, itemID string) (brazeCatalogItemModel, error) {
+	item, ok, err := c.snapshots.Lookup(ctx, catalogName, itemID, func(ctx context.Context) (int, error) {
+		return c.catalogs.itemCount(ctx, catalogName)
+	}, func(ctx context.Context) (catalogItemSnapshot, error) {
+		return c.fetchSnapshot(ctx, catalogName)
+	})
+	if err != nil {
+		c.logger.Log(ctx, "braze_catalog_item.snapshot", brazeAPILogEntry{Params: map[string]string{"catalog_name": catalogName}, Err: err})
+	}
+
+	if ok {
+		return newBrazeCatalogItemModelFromCatalogItem(catalogName, item)
+	}
+
+	return c.get(ctx, catalogName, itemID)
+}
+
+func (c generatedCatalogItemClient) get(ctx context.Context, catalogName, itemID string) (brazeCatalogItemModel, error) {
 	params := brazeclient.GetCatalogItemParams{CatalogName: catalogName, ItemID: itemID}
 	response, getErr := c.client.GetCatalogItem(ctx, params)
 
-	tflog.Info(ctx, "braze_catalog_item.read", map[string]any{"params": params, "response": response, "err": getErr})
+	c.logger.Log(ctx, "braze_catalog_item.read", brazeAPILogEntry{Params: params, Response: response, Err: getErr})
+
+	getErr = classifyBrazePermissionError(brazeclient.GetCatalogItemOperation, getErr)
 
 	if getErr != nil {
 		return brazeCatalogItemModel{}, classifyBrazeObjectReadError(getErr)
@@ -73,21 +109,28 @@ func (c generatedCatalogItemClient) Update(ctx context.Context, plan brazeCatalo
 	request := brazeclient.ReplaceCatalogItemRequest{Items: []brazeclient.CatalogItemWrite{item}}
 	params := brazeclient.ReplaceCatalogItemParams{CatalogName: plan.CatalogName.ValueString(), ItemID: plan.ItemID.ValueString()}
 	response, updateErr := c.client.ReplaceCatalogItem(ctx, &request, params)
+	c.snapshots.Invalidate(plan.CatalogName.ValueString())
 
-	tflog.Info(ctx, "braze_catalog_item.update", map[string]any{"params": params, "response": response, "err": updateErr})
+	c.logger.Log(ctx, "braze_catalog_item.update", brazeAPILogEntry{Params: params, Request: &request, Response: response, Err: updateErr})
+
+	updateErr = classifyBrazePermissionError(brazeclient.ReplaceCatalogItemOperation, updateErr)
 
 	if updateErr != nil {
 		return brazeCatalogItemModel{}, fmt.Errorf("replace catalog item: %w", updateErr)
 	}
 
-	return c.Read(ctx, plan.CatalogName.ValueString(), plan.ItemID.ValueString())
+	return c.get(ctx, plan.CatalogName.ValueString(), plan.ItemID.ValueString())
 }
 
 func (c generatedCatalogItemClient) Delete(ctx context.Context, catalogName, itemID string) error {
 	params := brazeclient.DeleteCatalogItemParams{CatalogName: catalogName, ItemID: itemID}
 	response, deleteErr := c.client.DeleteCatalogItem(ctx, params)
+	c.snapshots.Invalidate(catalogName)
+	c.catalogs.cache.Invalidate()
+
+	c.logger.Log(ctx, "braze_catalog_item.delete", brazeAPILogEntry{Params: params, Response: response, Err: deleteErr})
 
-	tflog.Info(ctx, "braze_catalog_item.delete", map[string]any{"params": params, "response": response, "err": deleteErr})
+	deleteErr = classifyBrazePermissionError(brazeclient.DeleteCatalogItemOperation, deleteErr)
 
 	if deleteErr != nil {
 		return classifyBrazeObjectReadError(deleteErr)
@@ -97,13 +140,64 @@ func (c generatedCatalogItemClient) Delete(ctx context.Context, catalogName, ite
 }
 
 func (c generatedCatalogItemClient) List(ctx context.Context, catalogName string, limit int64) ([]brazeObjectListEntry[brazeCatalogItemModel], error) {
+	items, err := c.listItems(ctx, catalogName, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]brazeObjectListEntry[brazeCatalogItemModel], 0, len(items))
+	for _, item := range items {
+		model, err := newBrazeCatalogItemModelFromCatalogItem(catalogName, item)
+
+		entry := brazeObjectListEntry[brazeCatalogItemModel]{
+			ID:          catalogName + "/" + item.GetID(),
+			DisplayName: item.GetID(),
+			Identity: map[string]string{
+				"catalog_name": catalogName,
+				"item_id":      item.GetID(),
+			},
+		}
+
+		if err != nil {
+			entry.ResourceErr = err
+		} else {
+			entry.Resource = &model
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (c generatedCatalogItemClient) fetchSnapshot(ctx context.Context, catalogName string) (catalogItemSnapshot, error) {
+	items, err := c.listItems(ctx, catalogName, catalogItemSnapshotMaxItems+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > catalogItemSnapshotMaxItems {
+		return nil, fmt.Errorf("%w: %s", errCatalogItemSnapshotTooLarge, catalogName)
+	}
+
+	snapshot := make(catalogItemSnapshot, len(items))
+	for _, item := range items {
+		snapshot[item.GetID()] = item
+	}
+
+	return snapshot, nil
+}
+
+func (c generatedCatalogItemClient) listItems(ctx context.Context, catalogName string, limit int64) ([]brazeclient.CatalogItem, error) {
 	params := brazeclient.ListCatalogItemsParams{CatalogName: catalogName}
 	items := make([]brazeclient.CatalogItem, 0, catalogItemListPageSize)
 
 	for {
 		response, listErr := c.client.ListCatalogItems(ctx, params)
 
-		tflog.Info(ctx, "braze_catalog_item.list", map[string]any{"params": params, "response": response, "err": listErr})
+		c.logger.Log(ctx, "braze_catalog_item.list", brazeAPILogEntry{Params: params, Response: response, Err: listErr})
+
+		listErr = classifyBrazePermissionError(brazeclient.ListCatalogItemsOperation, listErr)
 
 		if listErr != nil {
 			return nil, fmt.Errorf("list catalog items: %w", listErr)
@@ -125,40 +219,16 @@ func (c generatedCatalogItemClient) List(ctx context.Context, catalogName string
 		}
 
 		if int64(len(items)) >= limit {
-			break
+			return items, nil
 		}
 
 		nextCursor, ok := nextCursorFromLinkHeader(response.GetLink())
 		if !ok {
-			break
+			return items, nil
 		}
 
 		params.Cursor.SetTo(nextCursor)
 	}
-
-	entries := make([]brazeObjectListEntry[brazeCatalogItemModel], 0, len(items))
-	for _, item := range items {
-		model, err := newBrazeCatalogItemModelFromCatalogItem(catalogName, item)
-
-		entry := brazeObjectListEntry[brazeCatalogItemModel]{
-			ID:          catalogName + "/" + item.GetID(),
-			DisplayName: item.GetID(),
-			Identity: map[string]string{
-				"catalog_name": catalogName,
-				"item_id":      item.GetID(),
-			},
-		}
-
-		if err != nil {
-			entry.ResourceErr = err
-		} else {
-			entry.Resource = &model
-		}
-
-		entries = append(entries, entry)
-	}
-
-	return entries, nil
 }
 
 func nextCursorFromLinkHeader(link brazeclient.OptString) (string, bool) {
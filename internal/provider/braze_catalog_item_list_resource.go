@@ -63,6 +63,9 @@ func (r *brazeCatalogItemListResource) List(ctx context.Context, req list.ListRe
 	}
 
 	resp.Results = func(yield func(list.ListResult) bool) {
+		ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog_item", "list")
+		defer span.End()
+
 		entries, listErr := r.providerData.catalogItems.List(ctx, config.CatalogName.ValueString(), req.Limit)
 		if listErr != nil {
 			streamBrazeObjectListError(ctx, req, "Failed to list catalog items", listErr, yield)
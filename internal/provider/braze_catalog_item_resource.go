@@ -13,6 +13,7 @@ var (
 	_ resource.ResourceWithConfigure      = (*brazeCatalogItemResource)(nil)
 	_ resource.ResourceWithIdentity       = (*brazeCatalogItemResource)(nil)
 	_ resource.ResourceWithImportState    = (*brazeCatalogItemResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*brazeCatalogItemResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*brazeCatalogItemResource)(nil)
 )
 
@@ -41,6 +42,10 @@ func (r *brazeCatalogItemResource) Configure(_ context.Context, req resource.Con
 	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
 }
 
+func (r *brazeCatalogItemResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "catalog item")
+}
+
 func (r *brazeCatalogItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config brazeCatalogItemModel
 
@@ -95,6 +100,9 @@ func (r *brazeCatalogItemResource) ImportState(ctx context.Context, req resource
 }
 
 func (r *brazeCatalogItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog_item", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var plan brazeCatalogItemModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -116,6 +124,9 @@ func (r *brazeCatalogItemResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *brazeCatalogItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog_item", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var state brazeCatalogItemModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -142,6 +153,9 @@ func (r *brazeCatalogItemResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *brazeCatalogItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog_item", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var plan brazeCatalogItemModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -163,6 +177,9 @@ func (r *brazeCatalogItemResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *brazeCatalogItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog_item", "delete")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var state brazeCatalogItemModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+const (
+	// catalogItemSnapshotReadThreshold is the least number of distinct items
+	// read from a catalog before it is snapshotted. Larger catalogs need more,
+	// see catalogItemSnapshotWorthwhile.
+	catalogItemSnapshotReadThreshold = 2
+	catalogItemSnapshotTTL           = 30 * time.Second
+	// catalogItemSnapshotMaxItems bounds the size of a snapshot, so that a
+	// large catalog is never held in memory.
+	catalogItemSnapshotMaxItems = 10000
+)
+
+var errCatalogItemSnapshotTooLarge = errors.New("catalog has too many items to snapshot")
+
+type catalogItemSnapshot = map[string]brazeclient.CatalogItem
+
+// catalogItemSnapshots tracks item reads per catalog and holds a short-lived
+// snapshot of the items of each catalog that is read repeatedly.
+type catalogItemSnapshots struct {
+	mu  sync.Mutex
+	ttl time.Duration
+
+	catalogs map[string]*catalogItemSnapshotState
+}
+
+type catalogItemSnapshotState struct {
+	// itemIDs are the distinct items read from the catalog.
+	itemIDs  map[string]struct{}
+	disabled bool
+	snapshot *cachedFetch[catalogItemSnapshot]
+}
+
+func newCatalogItemSnapshots(ttl time.Duration) *catalogItemSnapshots {
+	return &catalogItemSnapshots{
+		ttl:      ttl,
+		catalogs: make(map[string]*catalogItemSnapshotState),
+	}
+}
+
+// Lookup records a read of an item in a catalog and, once snapshotting the
+// catalog costs no more requests than the items read from it individually,
+// returns the item from a snapshot of the catalog taken with fetch. size
+// returns the number of items in the catalog. Lookup returns false when the
+// caller should get the item individually instead: while the catalog is not
+// worth snapshotting, when the snapshot cannot be fetched, or when the item
+// is not in the snapshot.
+func (s *catalogItemSnapshots) Lookup(
+	ctx context.Context,
+	catalogName, itemID string,
+	size func(ctx context.Context) (int, error),
+	fetch func(ctx context.Context) (catalogItemSnapshot, error),
+) (brazeclient.CatalogItem, bool, error) {
+	s.mu.Lock()
+
+	state := s.state(catalogName)
+	state.itemIDs[itemID] = struct{}{}
+	reads := len(state.itemIDs)
+
+	if state.disabled || reads < catalogItemSnapshotReadThreshold {
+		s.mu.Unlock()
+
+		return brazeclient.CatalogItem{}, false, nil
+	}
+
+	snapshot := state.snapshot
+	s.mu.Unlock()
+
+	items, err := size(ctx)
+	if err != nil {
+		return brazeclient.CatalogItem{}, false, err
+	}
+
+	if items > catalogItemSnapshotMaxItems {
+		s.disable(state)
+
+		return brazeclient.CatalogItem{}, false, nil
+	}
+
+	if !catalogItemSnapshotWorthwhile(items, reads) {
+		return brazeclient.CatalogItem{}, false, nil
+	}
+
+	snapshotItems, err := snapshot.Get(ctx, fetch)
+	if err != nil {
+		if errors.Is(err, errCatalogItemSnapshotTooLarge) {
+			s.disable(state)
+		}
+
+		return brazeclient.CatalogItem{}, false, err
+	}
+
+	item, ok := snapshotItems[itemID]
+
+	return item, ok, nil
+}
+
+// catalogItemSnapshotWorthwhile reports whether listing a catalog of items
+// items takes no more requests than the reads already made individually,
+// which predicts the reads still to come while Terraform refreshes the
+// catalog's items.
+func catalogItemSnapshotWorthwhile(items, reads int) bool {
+	pages := (items + catalogItemListPageSize - 1) / catalogItemListPageSize
+
+	return reads >= pages
+}
+
+func (s *catalogItemSnapshots) disable(state *catalogItemSnapshotState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state.disabled = true
+}
+
+// Invalidate discards the snapshot of a catalog after one of its items has
+// been written.
+func (s *catalogItemSnapshots) Invalidate(catalogName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state, ok := s.catalogs[catalogName]; ok {
+		state.snapshot.Invalidate()
+	}
+}
+
+func (s *catalogItemSnapshots) state(catalogName string) *catalogItemSnapshotState {
+	state, ok := s.catalogs[catalogName]
+	if !ok {
+		state = &catalogItemSnapshotState{
+			itemIDs:  make(map[string]struct{}),
+			snapshot: newCachedFetch[catalogItemSnapshot](s.ttl),
+		}
+		s.catalogs[catalogName] = state
+	}
+
+	return state
+}
@@ -0,0 +1,133 @@
+//nolint:testpackage
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func catalogItemSnapshotSize(items int) func(context.Context) (int, error) {
+	return func(context.Context) (int, error) {
+		return items, nil
+	}
+}
+
+func TestCatalogItemSnapshotsLookup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("snapshots after repeated reads", func(t *testing.T) {
+		t.Parallel()
+
+		snapshots := newCatalogItemSnapshots(time.Minute)
+
+		fetches := 0
+		fetch := func(context.Context) (catalogItemSnapshot, error) {
+			fetches++
+
+			return catalogItemSnapshot{"a": {ID: "a"}, "b": {ID: "b"}}, nil
+		}
+
+		_, ok, err := snapshots.Lookup(t.Context(), "centres", "a", catalogItemSnapshotSize(2), fetch)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, 0, fetches)
+
+		_, ok, err = snapshots.Lookup(t.Context(), "centres", "a", catalogItemSnapshotSize(2), fetch)
+		require.NoError(t, err)
+		assert.False(t, ok, "a repeated read of the same item is not a reason to snapshot")
+		assert.Equal(t, 0, fetches)
+
+		item, ok, err := snapshots.Lookup(t.Context(), "centres", "b", catalogItemSnapshotSize(2), fetch)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "b", item.ID)
+
+		_, ok, err = snapshots.Lookup(t.Context(), "centres", "c", catalogItemSnapshotSize(2), fetch)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, 1, fetches)
+
+		_, ok, err = snapshots.Lookup(t.Context(), "regions", "a", catalogItemSnapshotSize(2), fetch)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, 1, fetches)
+	})
+
+	t.Run("waits for as many reads as pages in large catalogs", func(t *testing.T) {
+		t.Parallel()
+
+		snapshots := newCatalogItemSnapshots(time.Minute)
+
+		fetches := 0
+		fetch := func(context.Context) (catalogItemSnapshot, error) {
+			fetches++
+
+			return catalogItemSnapshot{}, nil
+		}
+
+		items := 4 * catalogItemListPageSize
+
+		for i := range 3 {
+			_, _, err := snapshots.Lookup(t.Context(), "centres", fmt.Sprintf("centre%d", i), catalogItemSnapshotSize(items), fetch)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 0, fetches)
+
+		_, _, err := snapshots.Lookup(t.Context(), "centres", "centre3", catalogItemSnapshotSize(items), fetch)
+		require.NoError(t, err)
+		assert.Equal(t, 1, fetches)
+	})
+
+	t.Run("never snapshots catalogs that are too large", func(t *testing.T) {
+		t.Parallel()
+
+		snapshots := newCatalogItemSnapshots(time.Minute)
+
+		sizes := 0
+		size := func(context.Context) (int, error) {
+			sizes++
+
+			return catalogItemSnapshotMaxItems + 1, nil
+		}
+
+		fetch := func(context.Context) (catalogItemSnapshot, error) {
+			t.Fatal("unexpected snapshot fetch")
+
+			return nil, nil
+		}
+
+		for i := range catalogItemSnapshotMaxItems/catalogItemListPageSize + 5 {
+			_, ok, err := snapshots.Lookup(t.Context(), "centres", fmt.Sprintf("centre%d", i), size, fetch)
+			require.NoError(t, err)
+			assert.False(t, ok)
+		}
+
+		assert.Equal(t, 1, sizes)
+	})
+
+	t.Run("stops snapshotting catalogs that grow too large", func(t *testing.T) {
+		t.Parallel()
+
+		snapshots := newCatalogItemSnapshots(time.Minute)
+
+		fetches := 0
+		fetch := func(context.Context) (catalogItemSnapshot, error) {
+			fetches++
+
+			return nil, fmt.Errorf("%w: centres", errCatalogItemSnapshotTooLarge)
+		}
+
+		for i := range 4 {
+			_, ok, _ := snapshots.Lookup(t.Context(), "centres", fmt.Sprintf("centre%d", i), catalogItemSnapshotSize(2), fetch)
+			assert.False(t, ok)
+		}
+
+		assert.Equal(t, 1, fetches)
+	})
+}
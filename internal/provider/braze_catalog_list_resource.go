@@ -42,6 +42,9 @@ func (r *brazeCatalogListResource) List(ctx context.Context, req list.ListReques
 	}
 
 	resp.Results = func(yield func(list.ListResult) bool) {
+		ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog", "list")
+		defer span.End()
+
 		entries, listErr := r.providerData.catalogs.List(ctx)
 		if listErr != nil {
 			streamBrazeObjectListError(ctx, req, "Failed to list catalogs", listErr, yield)
@@ -15,6 +15,7 @@ var (
 	_ resource.ResourceWithConfigure      = (*brazeCatalogResource)(nil)
 	_ resource.ResourceWithIdentity       = (*brazeCatalogResource)(nil)
 	_ resource.ResourceWithImportState    = (*brazeCatalogResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*brazeCatalogResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*brazeCatalogResource)(nil)
 )
 
@@ -43,6 +44,10 @@ func (r *brazeCatalogResource) Configure(_ context.Context, req resource.Configu
 	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
 }
 
+func (r *brazeCatalogResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "catalog")
+}
+
 func (r *brazeCatalogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config brazeCatalogModel
 
@@ -84,6 +89,9 @@ func (r *brazeCatalogResource) ImportState(ctx context.Context, req resource.Imp
 }
 
 func (r *brazeCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var plan brazeCatalogModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -102,6 +110,9 @@ func (r *brazeCatalogResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *brazeCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var state brazeCatalogModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -131,6 +142,9 @@ func (r *brazeCatalogResource) Update(_ context.Context, _ resource.UpdateReques
 }
 
 func (r *brazeCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_catalog", "delete")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var state brazeCatalogModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type contentBlockClient interface {
@@ -20,6 +19,7 @@ type contentBlockClient interface {
 
 type generatedContentBlockClient struct {
 	client *brazeclient.Client
+	logger brazeAPILogger
 }
 
 var errUnexpectedUpdateContentBlockResponse = errors.New("unexpected update content block response")
@@ -28,8 +28,11 @@ type contentBlockListItem struct {
 	item brazeclient.ListContentBlocksResponseContentBlock
 }
 
-func newGeneratedContentBlockClient(client *brazeclient.Client) generatedContentBlockClient {
-	return generatedContentBlockClient{client: client}
+func newGeneratedContentBlockClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedContentBlockClient {
+	return generatedContentBlockClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads, "content"),
+	}
 }
 
 func (c generatedContentBlockClient) Create(ctx context.Context, plan brazeContentBlockModel) (brazeContentBlockModel, error) {
@@ -37,12 +40,14 @@ func (c generatedContentBlockClient) Create(ctx context.Context, plan brazeConte
 
 	createResponse, createErr := c.client.CreateContentBlock(ctx, &createRequest)
 
-	tflog.Info(ctx, "braze_content_block.create", map[string]any{
-		"request":  createRequest,
-		"response": createResponse,
-		"err":      createErr,
+	c.logger.Log(ctx, "braze_content_block.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
 	})
 
+	createErr = classifyBrazePermissionError(brazeclient.CreateContentBlockOperation, createErr)
+
 	if createErr != nil {
 		return brazeContentBlockModel{}, fmt.Errorf("create content block: %w", createErr)
 	}
@@ -61,12 +66,14 @@ func (c generatedContentBlockClient) Read(ctx context.Context, id string) (braze
 
 	getResponse, getErr := c.client.GetContentBlockInfo(ctx, getParams)
 
-	tflog.Info(ctx, "braze_content_block.read", map[string]any{
-		"params":   getParams,
-		"response": getResponse,
-		"err":      getErr,
+	c.logger.Log(ctx, "braze_content_block.read", brazeAPILogEntry{
+		Params:   getParams,
+		Response: getResponse,
+		Err:      getErr,
 	})
 
+	getErr = classifyBrazePermissionError(brazeclient.GetContentBlockInfoOperation, getErr)
+
 	if getErr != nil {
 		return brazeContentBlockModel{}, classifyBrazeObjectReadError(getErr)
 	}
@@ -83,12 +90,14 @@ func (c generatedContentBlockClient) Update(ctx context.Context, plan brazeConte
 
 	updateResponse, updateErr := c.client.UpdateContentBlock(ctx, &updateRequest)
 
-	tflog.Info(ctx, "braze_content_block.update", map[string]any{
-		"request":  updateRequest,
-		"response": updateResponse,
-		"err":      updateErr,
+	c.logger.Log(ctx, "braze_content_block.update", brazeAPILogEntry{
+		Request:  &updateRequest,
+		Response: updateResponse,
+		Err:      updateErr,
 	})
 
+	updateErr = classifyBrazePermissionError(brazeclient.UpdateContentBlockOperation, updateErr)
+
 	if updateErr != nil {
 		return brazeContentBlockModel{}, fmt.Errorf("update content block: %w", updateErr)
 	}
@@ -140,12 +149,14 @@ func (c generatedContentBlockClient) listPage(ctx context.Context, query brazeOb
 
 	listResponse, listErr := c.client.ListContentBlocks(ctx, params)
 
-	tflog.Info(ctx, "braze_content_block.list", map[string]any{
-		"params":   params,
-		"response": listResponse,
-		"err":      listErr,
+	c.logger.Log(ctx, "braze_content_block.list", brazeAPILogEntry{
+		Params:   params,
+		Response: listResponse,
+		Err:      listErr,
 	})
 
+	listErr = classifyBrazePermissionError(brazeclient.ListContentBlocksOperation, listErr)
+
 	if listErr != nil {
 		return nil, fmt.Errorf("list content blocks: %w", listErr)
 	}
@@ -73,6 +73,7 @@ func (r *brazeContentBlockListResource) List(ctx context.Context, req list.ListR
 	query := brazeObjectListQuery{
 		Limit:           req.Limit,
 		IncludeResource: req.IncludeResource,
+		Concurrency:     r.providerData.listConcurrency,
 	}
 	paramsDiags := diag.Diagnostics{}
 
@@ -97,6 +98,9 @@ func (r *brazeContentBlockListResource) List(ctx context.Context, req list.ListR
 	}
 
 	resp.Results = func(yield func(list.ListResult) bool) {
+		ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_content_block", "list")
+		defer span.End()
+
 		entries, listErr := r.providerData.contentBlocks.List(ctx, query)
 		if listErr != nil {
 			streamBrazeObjectListError(ctx, req, "Failed to list content blocks", listErr, yield)
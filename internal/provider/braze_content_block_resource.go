@@ -12,6 +12,7 @@ var (
 	_ resource.ResourceWithConfigure   = (*brazeContentBlockResource)(nil)
 	_ resource.ResourceWithIdentity    = (*brazeContentBlockResource)(nil)
 	_ resource.ResourceWithImportState = (*brazeContentBlockResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*brazeContentBlockResource)(nil)
 )
 
 //nolint:ireturn
@@ -39,11 +40,18 @@ func (r *brazeContentBlockResource) Configure(_ context.Context, req resource.Co
 	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
 }
 
+func (r *brazeContentBlockResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "content block")
+}
+
 func (r *brazeContentBlockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *brazeContentBlockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_content_block", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var plan brazeContentBlockModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -66,6 +74,9 @@ func (r *brazeContentBlockResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *brazeContentBlockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_content_block", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var state brazeContentBlockModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -92,6 +103,9 @@ func (r *brazeContentBlockResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *brazeContentBlockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_content_block", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var plan, state brazeContentBlockModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -147,7 +147,7 @@ func TestAccBrazeContentBlockUpdateNameEmpty(t *testing.T) {
 	}
 
 	configVariables1 := maps.Clone(configVariables)
-	configVariables1["content_block_name"] = config.StringVariable("initial name")
+	configVariables1["content_block_name"] = config.StringVariable("initial-name")
 
 	configVariables2 := maps.Clone(configVariables1)
 	configVariables2["content_block_name"] = config.StringVariable("")
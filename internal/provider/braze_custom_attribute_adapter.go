@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+type customAttributeClient interface {
+	List(ctx context.Context) ([]brazeCustomAttribute, error)
+}
+
+type brazeCustomAttribute struct {
+	Name        string
+	DataType    *string
+	Description *string
+	Tags        []string
+	Status      *string
+}
+
+type generatedCustomAttributeClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedCustomAttributeClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedCustomAttributeClient {
+	return generatedCustomAttributeClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+	}
+}
+
+// List reads every page of custom attributes, following the cursor in the
+// Link header of each page.
+func (c generatedCustomAttributeClient) List(ctx context.Context) ([]brazeCustomAttribute, error) {
+	params := brazeclient.ListCustomAttributesParams{}
+	attributes := []brazeCustomAttribute{}
+
+	for {
+		listResponse, listErr := c.client.ListCustomAttributes(ctx, params)
+
+		c.logger.Log(ctx, "braze_custom_attributes.list", brazeAPILogEntry{
+			Params:   params,
+			Response: listResponse,
+			Err:      listErr,
+		})
+
+		listErr = classifyBrazePermissionError(brazeclient.ListCustomAttributesOperation, listErr)
+
+		if listErr != nil {
+			return nil, fmt.Errorf("list custom attributes: %w", listErr)
+		}
+
+		if listResponse == nil {
+			return nil, errBrazeObjectEmptyResponse
+		}
+
+		pageResponse := listResponse.GetResponse()
+
+		for _, item := range pageResponse.GetAttributes() {
+			attributes = append(attributes, brazeCustomAttribute{
+				Name:        item.GetName(),
+				DataType:    item.GetDataType().GetPointer(),
+				Description: item.GetDescription().GetPointer(),
+				Tags:        item.GetTagNames(),
+				Status:      item.GetStatus().GetPointer(),
+			})
+		}
+
+		nextCursor, ok := nextCursorFromLinkHeader(listResponse.GetLink())
+		if !ok {
+			return attributes, nil
+		}
+
+		params.Cursor.SetTo(nextCursor)
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = (*brazeCustomAttributesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*brazeCustomAttributesDataSource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeCustomAttributesDataSource() datasource.DataSource {
+	return &brazeCustomAttributesDataSource{}
+}
+
+type brazeCustomAttributesDataSource struct {
+	providerData brazeProviderData
+}
+
+type brazeCustomAttributesDataSourceModel struct {
+	CustomAttributes []brazeCustomAttributeModel `tfsdk:"custom_attributes"`
+}
+
+type brazeCustomAttributeModel struct {
+	Name        types.String            `tfsdk:"name"`
+	DataType    types.String            `tfsdk:"data_type"`
+	Description types.String            `tfsdk:"description"`
+	Tags        TypedList[types.String] `tfsdk:"tags"`
+	Status      types.String            `tfsdk:"status"`
+}
+
+func (d *brazeCustomAttributesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_attributes"
+}
+
+func (d *brazeCustomAttributesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the custom attributes defined in the workspace, for example to check that the attributes referenced by Liquid in messages exist and are not blocklisted.",
+		Attributes: map[string]schema.Attribute{
+			"custom_attributes": schema.ListNestedAttribute{
+				Description: "The custom attributes, in the order Braze lists them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"data_type": schema.StringAttribute{
+							Description: "The data type Braze has recorded for the attribute, for example `String` or `Number`.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListAttribute{
+							CustomType:  NewTypedListNull[types.String]().CustomType(ctx),
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Whether the attribute is `Active` or `Blocklisted`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *brazeCustomAttributesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(SetProviderDataFromDataSourceConfigureRequest(req, &d.providerData)...)
+}
+
+func (d *brazeCustomAttributesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, d.providerData.tracer, "braze_custom_attributes", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var config brazeCustomAttributesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, err := d.providerData.customAttributes.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list custom attributes", detailFromError(err))
+
+		return
+	}
+
+	config.CustomAttributes = make([]brazeCustomAttributeModel, len(attributes))
+	for i, attribute := range attributes {
+		config.CustomAttributes[i] = brazeCustomAttributeModel{
+			Name:        types.StringValue(attribute.Name),
+			DataType:    types.StringPointerValue(attribute.DataType),
+			Description: types.StringPointerValue(attribute.Description),
+			Tags:        NewTypedListFromStringSlice(attribute.Tags),
+			Status:      types.StringPointerValue(attribute.Status),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
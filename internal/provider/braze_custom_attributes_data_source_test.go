@@ -0,0 +1,38 @@
+package provider_test
+
+import (
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeCustomAttributesDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCustomAttribute("favorite_color", "String", "The colour the user picked in onboarding", []string{"onboarding"}, "Active")
+	server.SetCustomAttribute("legacy_score", "Number", "", nil, "Blocklisted")
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_custom_attributes" "test" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.#", "2"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.0.name", "favorite_color"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.0.data_type", "String"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.0.description", "The colour the user picked in onboarding"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.0.tags.0", "onboarding"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.0.status", "Active"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.1.name", "legacy_score"),
+					resource.TestCheckNoResourceAttr("data.braze_custom_attributes.test", "custom_attributes.1.description"),
+					resource.TestCheckResourceAttr("data.braze_custom_attributes.test", "custom_attributes.1.status", "Blocklisted"),
+				),
+			},
+		},
+	})
+}
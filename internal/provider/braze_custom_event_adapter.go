@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+type customEventClient interface {
+	List(ctx context.Context) ([]brazeCustomEvent, error)
+}
+
+type brazeCustomEvent struct {
+	Name        string
+	Description *string
+	Tags        []string
+	Status      *string
+}
+
+type generatedCustomEventClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedCustomEventClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedCustomEventClient {
+	return generatedCustomEventClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+	}
+}
+
+// List reads every page of custom events, following the cursor in the Link
+// header of each page.
+func (c generatedCustomEventClient) List(ctx context.Context) ([]brazeCustomEvent, error) {
+	params := brazeclient.ListCustomEventsParams{}
+	events := []brazeCustomEvent{}
+
+	for {
+		listResponse, listErr := c.client.ListCustomEvents(ctx, params)
+
+		c.logger.Log(ctx, "braze_custom_events.list", brazeAPILogEntry{
+			Params:   params,
+			Response: listResponse,
+			Err:      listErr,
+		})
+
+		listErr = classifyBrazePermissionError(brazeclient.ListCustomEventsOperation, listErr)
+
+		if listErr != nil {
+			return nil, fmt.Errorf("list custom events: %w", listErr)
+		}
+
+		if listResponse == nil {
+			return nil, errBrazeObjectEmptyResponse
+		}
+
+		pageResponse := listResponse.GetResponse()
+
+		for _, item := range pageResponse.GetEvents() {
+			events = append(events, brazeCustomEvent{
+				Name:        item.GetName(),
+				Description: item.GetDescription().GetPointer(),
+				Tags:        item.GetTagNames(),
+				Status:      item.GetStatus().GetPointer(),
+			})
+		}
+
+		nextCursor, ok := nextCursorFromLinkHeader(listResponse.GetLink())
+		if !ok {
+			return events, nil
+		}
+
+		params.Cursor.SetTo(nextCursor)
+	}
+}
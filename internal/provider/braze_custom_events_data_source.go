@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = (*brazeCustomEventsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*brazeCustomEventsDataSource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeCustomEventsDataSource() datasource.DataSource {
+	return &brazeCustomEventsDataSource{}
+}
+
+type brazeCustomEventsDataSource struct {
+	providerData brazeProviderData
+}
+
+type brazeCustomEventsDataSourceModel struct {
+	CustomEvents []brazeCustomEventModel `tfsdk:"custom_events"`
+}
+
+type brazeCustomEventModel struct {
+	Name        types.String            `tfsdk:"name"`
+	Description types.String            `tfsdk:"description"`
+	Tags        TypedList[types.String] `tfsdk:"tags"`
+	Status      types.String            `tfsdk:"status"`
+}
+
+func (d *brazeCustomEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_events"
+}
+
+func (d *brazeCustomEventsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the custom events defined in the workspace, for example to check that the events used as campaign and Canvas triggers exist and are not blocklisted. They are read from the `/events` endpoint, as `/events/list` only returns event names, without descriptions, tags or status.",
+		Attributes: map[string]schema.Attribute{
+			"custom_events": schema.ListNestedAttribute{
+				Description: "The custom events, in the order Braze lists them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"tags": schema.ListAttribute{
+							CustomType:  NewTypedListNull[types.String]().CustomType(ctx),
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Whether the event is `Active` or `Blocklisted`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *brazeCustomEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(SetProviderDataFromDataSourceConfigureRequest(req, &d.providerData)...)
+}
+
+func (d *brazeCustomEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, d.providerData.tracer, "braze_custom_events", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var config brazeCustomEventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	events, err := d.providerData.customEvents.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list custom events", detailFromError(err))
+
+		return
+	}
+
+	config.CustomEvents = make([]brazeCustomEventModel, len(events))
+	for i, event := range events {
+		config.CustomEvents[i] = brazeCustomEventModel{
+			Name:        types.StringValue(event.Name),
+			Description: types.StringPointerValue(event.Description),
+			Tags:        NewTypedListFromStringSlice(event.Tags),
+			Status:      types.StringPointerValue(event.Status),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,36 @@
+package provider_test
+
+import (
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeCustomEventsDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCustomEvent("completed_checkout", "Fired when an order is placed", []string{"commerce"}, "Active")
+	server.SetCustomEvent("legacy_login", "", nil, "Blocklisted")
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_custom_events" "test" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braze_custom_events.test", "custom_events.#", "2"),
+					resource.TestCheckResourceAttr("data.braze_custom_events.test", "custom_events.0.name", "completed_checkout"),
+					resource.TestCheckResourceAttr("data.braze_custom_events.test", "custom_events.0.description", "Fired when an order is placed"),
+					resource.TestCheckResourceAttr("data.braze_custom_events.test", "custom_events.0.tags.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_custom_events.test", "custom_events.0.status", "Active"),
+					resource.TestCheckResourceAttr("data.braze_custom_events.test", "custom_events.1.name", "legacy_login"),
+					resource.TestCheckNoResourceAttr("data.braze_custom_events.test", "custom_events.1.description"),
+					resource.TestCheckResourceAttr("data.braze_custom_events.test", "custom_events.1.status", "Blocklisted"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+var errBrazeDashboardUserNotFound = errors.New("no dashboard user with this email")
+
+type dashboardUserClient interface {
+	Create(ctx context.Context, plan brazeDashboardUserModel) (brazeDashboardUserModel, error)
+	Read(ctx context.Context, id string) (brazeDashboardUserModel, error)
+	ReadByEmail(ctx context.Context, email string) (brazeDashboardUserModel, error)
+	Update(ctx context.Context, plan brazeDashboardUserModel) (brazeDashboardUserModel, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, query brazeObjectListQuery, emails []string) ([]brazeObjectListEntry[brazeDashboardUserModel], error)
+}
+
+// generatedDashboardUserClient manages dashboard users through the Braze
+// SCIM API, which is authenticated by the SCIM token and the origin it is
+// allowlisted for rather than by a REST API key.
+type generatedDashboardUserClient struct {
+	client        *brazeclient.Client
+	logger        brazeAPILogger
+	requestOrigin string
+}
+
+func newGeneratedDashboardUserClient(client *brazeclient.Client, logPayloads brazeLogPayloads, requestOrigin string) generatedDashboardUserClient {
+	return generatedDashboardUserClient{
+		client:        client,
+		logger:        newBrazeAPILogger(logPayloads),
+		requestOrigin: requestOrigin,
+	}
+}
+
+func (c generatedDashboardUserClient) Create(ctx context.Context, plan brazeDashboardUserModel) (brazeDashboardUserModel, error) {
+	createRequest := plan.ToCreateDashboardUserRequest()
+	createParams := brazeclient.CreateDashboardUserParams{
+		XRequestOrigin: c.requestOrigin,
+	}
+
+	createResponse, createErr := c.client.CreateDashboardUser(ctx, &createRequest, createParams)
+
+	c.logger.Log(ctx, "braze_dashboard_user.create", brazeAPILogEntry{
+		Params:   createParams,
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
+	})
+
+	if createErr != nil {
+		return brazeDashboardUserModel{}, fmt.Errorf("create dashboard user: %w", createErr)
+	}
+
+	if createResponse == nil {
+		return brazeDashboardUserModel{}, errBrazeObjectEmptyResponse
+	}
+
+	return NewBrazeDashboardUserModelFromDashboardUser(*createResponse), nil
+}
+
+func (c generatedDashboardUserClient) Read(ctx context.Context, id string) (brazeDashboardUserModel, error) {
+	getParams := brazeclient.GetDashboardUserParams{
+		XRequestOrigin: c.requestOrigin,
+		ID:             id,
+	}
+
+	getResponse, getErr := c.client.GetDashboardUser(ctx, getParams)
+
+	c.logger.Log(ctx, "braze_dashboard_user.read", brazeAPILogEntry{
+		Params:   getParams,
+		Response: getResponse,
+		Err:      getErr,
+	})
+
+	if getErr != nil {
+		return brazeDashboardUserModel{}, classifyBrazeObjectReadError(getErr)
+	}
+
+	if getResponse == nil {
+		return brazeDashboardUserModel{}, errBrazeObjectEmptyResponse
+	}
+
+	return NewBrazeDashboardUserModelFromDashboardUser(*getResponse), nil
+}
+
+// ReadByEmail looks a dashboard user up by email, as used for import. It
+// returns a not found error when Braze has no user with the email.
+func (c generatedDashboardUserClient) ReadByEmail(ctx context.Context, email string) (brazeDashboardUserModel, error) {
+	users, err := c.search(ctx, email)
+	if err != nil {
+		return brazeDashboardUserModel{}, err
+	}
+
+	if len(users) == 0 {
+		return brazeDashboardUserModel{}, brazeObjectNotFoundError{err: fmt.Errorf("%w: %s", errBrazeDashboardUserNotFound, email)}
+	}
+
+	return NewBrazeDashboardUserModelFromDashboardUser(users[0]), nil
+}
+
+func (c generatedDashboardUserClient) Update(ctx context.Context, plan brazeDashboardUserModel) (brazeDashboardUserModel, error) {
+	updateRequest := plan.ToUpdateDashboardUserRequest()
+	updateParams := brazeclient.UpdateDashboardUserParams{
+		XRequestOrigin: c.requestOrigin,
+		ID:             plan.ID.ValueString(),
+	}
+
+	updateResponse, updateErr := c.client.UpdateDashboardUser(ctx, &updateRequest, updateParams)
+
+	c.logger.Log(ctx, "braze_dashboard_user.update", brazeAPILogEntry{
+		Params:   updateParams,
+		Request:  &updateRequest,
+		Response: updateResponse,
+		Err:      updateErr,
+	})
+
+	if updateErr != nil {
+		return brazeDashboardUserModel{}, fmt.Errorf("update dashboard user: %w", classifyBrazeObjectReadError(updateErr))
+	}
+
+	if updateResponse == nil {
+		return brazeDashboardUserModel{}, errBrazeObjectEmptyResponse
+	}
+
+	return NewBrazeDashboardUserModelFromDashboardUser(*updateResponse), nil
+}
+
+func (c generatedDashboardUserClient) Delete(ctx context.Context, id string) error {
+	deleteParams := brazeclient.DeleteDashboardUserParams{
+		XRequestOrigin: c.requestOrigin,
+		ID:             id,
+	}
+
+	deleteErr := c.client.DeleteDashboardUser(ctx, deleteParams)
+
+	c.logger.Log(ctx, "braze_dashboard_user.delete", brazeAPILogEntry{
+		Params: deleteParams,
+		Err:    deleteErr,
+	})
+
+	if deleteErr != nil {
+		return classifyBrazeObjectReadError(deleteErr)
+	}
+
+	return nil
+}
+
+// List looks up each of emails, as the SCIM API can only search for
+// dashboard users by email, and returns at most query.Limit entries. Emails
+// without a dashboard user are skipped.
+func (c generatedDashboardUserClient) List(ctx context.Context, query brazeObjectListQuery, emails []string) ([]brazeObjectListEntry[brazeDashboardUserModel], error) {
+	entries := make([]brazeObjectListEntry[brazeDashboardUserModel], 0, min(int64(len(emails)), max(query.Limit, 0)))
+
+	for _, email := range emails {
+		if int64(len(entries)) >= query.Limit {
+			break
+		}
+
+		users, err := c.search(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users {
+			model := NewBrazeDashboardUserModelFromDashboardUser(user)
+
+			entry := brazeObjectListEntry[brazeDashboardUserModel]{
+				ID:          user.GetUserName(),
+				DisplayName: model.GivenName.ValueString() + " " + model.FamilyName.ValueString(),
+			}
+
+			if query.IncludeResource {
+				entry.Resource = &model
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	if int64(len(entries)) > query.Limit {
+		entries = entries[:max(query.Limit, 0)]
+	}
+
+	return entries, nil
+}
+
+func (c generatedDashboardUserClient) search(ctx context.Context, email string) ([]brazeclient.DashboardUser, error) {
+	searchParams := brazeclient.SearchDashboardUsersParams{
+		XRequestOrigin: c.requestOrigin,
+		Filter:         "userName eq " + strconv.Quote(email),
+	}
+
+	searchResponse, searchErr := c.client.SearchDashboardUsers(ctx, searchParams)
+
+	c.logger.Log(ctx, "braze_dashboard_user.search", brazeAPILogEntry{
+		Params:   searchParams,
+		Response: searchResponse,
+		Err:      searchErr,
+	})
+
+	if searchErr != nil {
+		return nil, fmt.Errorf("search dashboard users: %w", searchErr)
+	}
+
+	if searchResponse == nil {
+		return nil, errBrazeObjectEmptyResponse
+	}
+
+	return searchResponse.GetResources(), nil
+}
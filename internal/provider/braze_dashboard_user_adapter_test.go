@@ -0,0 +1,95 @@
+//nolint:testpackage
+package provider
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedDashboardUserClientKeepsUnsetPermissionsNull(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazeclienttesting.NewBrazeServer()
+	require.NoError(t, err)
+
+	client := newGeneratedDashboardUserClient(scimServerClient(t, server), brazeLogPayloadsMetadata, "example.com")
+
+	plan := brazeDashboardUserModel{
+		Email:              types.StringValue("jane@example.com"),
+		GivenName:          types.StringValue("Jane"),
+		FamilyName:         types.StringValue("Doe"),
+		Department:         types.StringValue("engineering"),
+		CompanyPermissions: NewTypedListNull[types.String](),
+	}
+
+	created, err := client.Create(t.Context(), plan)
+	require.NoError(t, err)
+
+	assert.False(t, created.CompanyPermissions.IsNull(), "Braze returns empty permission lists")
+	assert.NotNil(t, created.Workspaces, "Braze returns empty permission lists")
+
+	created = created.keepNullEmptyLists(plan)
+	assert.True(t, created.CompanyPermissions.IsNull())
+	assert.Nil(t, created.Workspaces)
+
+	plan.CompanyPermissions = NewTypedListFromStringSlice([]string{})
+	plan.Workspaces = []brazeDashboardUserWorkspaceModel{{
+		WorkspaceName: types.StringValue("Production"),
+		Permissions:   NewTypedListNull[types.String](),
+	}}
+	plan.ID = created.ID
+
+	updated, err := client.Update(t.Context(), plan)
+	require.NoError(t, err)
+
+	updated = updated.keepNullEmptyLists(plan)
+	assert.False(t, updated.CompanyPermissions.IsNull())
+	assert.Equal(t, 0, updated.CompanyPermissions.Len())
+	require.Len(t, updated.Workspaces, 1)
+	assert.True(t, updated.Workspaces[0].Permissions.IsNull())
+	assert.Nil(t, updated.Workspaces[0].Teams)
+}
+
+func TestGeneratedDashboardUserClientListLimit(t *testing.T) {
+	t.Parallel()
+
+	server, err := brazeclienttesting.NewBrazeServer()
+	require.NoError(t, err)
+
+	server.SetDashboardUser("du-1", "jane@example.com", "Jane", "Doe", "", brazeclient.DashboardUserPermissions{})
+	server.SetDashboardUser("du-2", "jane@example.com", "Janet", "Doe", "", brazeclient.DashboardUserPermissions{})
+	server.SetDashboardUser("du-3", "john@example.com", "John", "Doe", "", brazeclient.DashboardUserPermissions{})
+
+	client := newGeneratedDashboardUserClient(scimServerClient(t, server), brazeLogPayloadsMetadata, "example.com")
+
+	entries, err := client.List(t.Context(), brazeObjectListQuery{Limit: 1}, []string{"jane@example.com", "john@example.com"})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Jane Doe", entries[0].DisplayName)
+
+	entries, err = client.List(t.Context(), brazeObjectListQuery{Limit: 3}, []string{"jane@example.com", "john@example.com"})
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func scimServerClient(t *testing.T, server *brazeclienttesting.Server) *brazeclient.Client {
+	t.Helper()
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	client, err := brazeclient.NewClient(
+		httpServer.URL,
+		NewBrazeAPIKeySecuritySource("test").withSCIMToken("scim-token"),
+		brazeclient.WithClient(httpServer.Client()),
+	)
+	require.NoError(t, err)
+
+	return client
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//nolint:ireturn
+func NewBrazeDashboardUserListResource() list.ListResource {
+	return &brazeDashboardUserListResource{}
+}
+
+type brazeDashboardUserListResource struct {
+	providerData brazeProviderData
+}
+
+type brazeDashboardUserListResourceConfig struct {
+	Emails TypedList[types.String] `tfsdk:"emails"`
+}
+
+var (
+	_ list.ListResource              = (*brazeDashboardUserListResource)(nil)
+	_ list.ListResourceWithConfigure = (*brazeDashboardUserListResource)(nil)
+)
+
+func (r *brazeDashboardUserListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_user"
+}
+
+func (r *brazeDashboardUserListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.ListAttribute{
+				Description: "The emails of the dashboard users to look up. The Braze SCIM API can only search for dashboard users by email, so they cannot be listed without one.",
+				CustomType:  NewTypedListNull[types.String]().CustomType(ctx),
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *brazeDashboardUserListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazeDashboardUserListResource) List(ctx context.Context, req list.ListRequest, resp *list.ListResultsStream) {
+	config := brazeDashboardUserListResourceConfig{}
+
+	configDiags := req.Config.Get(ctx, &config)
+	if configDiags.HasError() {
+		resp.Results = list.ListResultsStreamDiagnostics(configDiags)
+
+		return
+	}
+
+	if req.Limit <= 0 {
+		resp.Results = emptyBrazeObjectListResults
+
+		return
+	}
+
+	query := brazeObjectListQuery{
+		Limit:           req.Limit,
+		IncludeResource: req.IncludeResource,
+	}
+	emails := TypedListToStringSlice(config.Emails)
+
+	resp.Results = func(yield func(list.ListResult) bool) {
+		ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_dashboard_user", "list")
+		defer span.End()
+
+		entries, listErr := r.providerData.dashboardUsers.List(ctx, query, emails)
+		if listErr != nil {
+			streamBrazeObjectListError(ctx, req, "Failed to list dashboard users", listErr, yield)
+
+			return
+		}
+
+		streamBrazeObjectListEntries(ctx, req, entries, "email", "Failed to get dashboard user", yield)
+	}
+}
@@ -0,0 +1,80 @@
+package provider_test
+
+import (
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBrazeDashboardUserList(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetDashboardUser("dashboard-user-id", "jane@example.com", "Jane", "Doe", "engineering", brazeclient.DashboardUserPermissions{
+		AppGroup: []brazeclient.DashboardUserAppGroupPermissions{
+			{AppGroupName: "Production", AppGroupPermissions: []string{"basic_access"}},
+		},
+	})
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: `
+				provider "braze" {
+					scim_token          = "scim-token"
+					scim_request_origin = "example.com"
+				}
+
+				list "braze_dashboard_user" "test" {
+					provider = braze
+					config {
+						emails = ["jane@example.com", "nobody@example.com"]
+					}
+					include_resource = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "id", "dashboard-user-id"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "email", "jane@example.com"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "workspaces.0.workspace_name", "Production"),
+				),
+			},
+			{
+				Query: true,
+				Config: `
+				provider "braze" {
+					scim_token          = "scim-token"
+					scim_request_origin = "example.com"
+				}
+
+				list "braze_dashboard_user" "test" {
+					provider = braze
+					config {
+						emails = ["jane@example.com", "nobody@example.com"]
+					}
+				}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("braze_dashboard_user.test", 1),
+					querycheck.ExpectResourceDisplayName(
+						"braze_dashboard_user.test",
+						queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+							"email": knownvalue.StringExact("jane@example.com"),
+						}),
+						knownvalue.StringExact("Jane Doe"),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const scimUserSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+
+type brazeDashboardUserModel struct {
+	ID                 types.String                       `tfsdk:"id"`
+	Email              types.String                       `tfsdk:"email"`
+	GivenName          types.String                       `tfsdk:"given_name"`
+	FamilyName         types.String                       `tfsdk:"family_name"`
+	Department         types.String                       `tfsdk:"department"`
+	CompanyPermissions TypedList[types.String]            `tfsdk:"company_permissions"`
+	Workspaces         []brazeDashboardUserWorkspaceModel `tfsdk:"workspaces"`
+}
+
+type brazeDashboardUserWorkspaceModel struct {
+	WorkspaceName types.String                  `tfsdk:"workspace_name"`
+	Permissions   TypedList[types.String]       `tfsdk:"permissions"`
+	Teams         []brazeDashboardUserTeamModel `tfsdk:"teams"`
+}
+
+type brazeDashboardUserTeamModel struct {
+	TeamName    types.String            `tfsdk:"team_name"`
+	Permissions TypedList[types.String] `tfsdk:"permissions"`
+}
+
+func (m brazeDashboardUserModel) toDashboardUserName() brazeclient.DashboardUserName {
+	return brazeclient.DashboardUserName{
+		GivenName:  m.GivenName.ValueString(),
+		FamilyName: m.FamilyName.ValueString(),
+	}
+}
+
+func (m brazeDashboardUserModel) toDashboardUserPermissions() brazeclient.DashboardUserPermissions {
+	permissions := brazeclient.DashboardUserPermissions{
+		CompanyPermissions: TypedListToStringSlice(m.CompanyPermissions),
+	}
+
+	if m.Workspaces == nil {
+		return permissions
+	}
+
+	permissions.AppGroup = make([]brazeclient.DashboardUserAppGroupPermissions, len(m.Workspaces))
+	for i, workspace := range m.Workspaces {
+		appGroup := brazeclient.DashboardUserAppGroupPermissions{
+			AppGroupName:        workspace.WorkspaceName.ValueString(),
+			AppGroupPermissions: TypedListToStringSlice(workspace.Permissions),
+		}
+
+		if workspace.Teams != nil {
+			appGroup.Team = make([]brazeclient.DashboardUserTeamPermissions, len(workspace.Teams))
+			for j, team := range workspace.Teams {
+				appGroup.Team[j] = brazeclient.DashboardUserTeamPermissions{
+					TeamName:        team.TeamName.ValueString(),
+					TeamPermissions: TypedListToStringSlice(team.Permissions),
+				}
+			}
+		}
+
+		permissions.AppGroup[i] = appGroup
+	}
+
+	return permissions
+}
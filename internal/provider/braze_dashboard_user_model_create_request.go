@@ -0,0 +1,15 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+func (m brazeDashboardUserModel) ToCreateDashboardUserRequest() brazeclient.CreateDashboardUserRequest {
+	return brazeclient.CreateDashboardUserRequest{
+		Schemas:     []string{scimUserSchema},
+		UserName:    m.Email.ValueString(),
+		Name:        m.toDashboardUserName(),
+		Department:  m.Department.ValueString(),
+		Permissions: m.toDashboardUserPermissions(),
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//revive:disable:unexported-return
+func NewBrazeDashboardUserModelFromDashboardUser(user brazeclient.DashboardUser) brazeDashboardUserModel {
+	name := user.GetName().Or(brazeclient.DashboardUserName{})
+	permissions := user.GetPermissions().Or(brazeclient.DashboardUserPermissions{})
+
+	model := brazeDashboardUserModel{
+		ID:                 types.StringValue(user.GetID()),
+		Email:              types.StringValue(user.GetUserName()),
+		GivenName:          types.StringValue(name.GetGivenName()),
+		FamilyName:         types.StringValue(name.GetFamilyName()),
+		Department:         types.StringPointerValue(user.GetDepartment().GetPointer()),
+		CompanyPermissions: NewTypedListFromStringSlice(permissions.GetCompanyPermissions()),
+	}
+
+	if appGroups := permissions.GetAppGroup(); appGroups != nil {
+		model.Workspaces = make([]brazeDashboardUserWorkspaceModel, len(appGroups))
+		for i, appGroup := range appGroups {
+			workspace := brazeDashboardUserWorkspaceModel{
+				WorkspaceName: types.StringValue(appGroup.GetAppGroupName()),
+				Permissions:   NewTypedListFromStringSlice(appGroup.GetAppGroupPermissions()),
+			}
+
+			if teams := appGroup.GetTeam(); teams != nil {
+				workspace.Teams = make([]brazeDashboardUserTeamModel, len(teams))
+				for j, team := range teams {
+					workspace.Teams[j] = brazeDashboardUserTeamModel{
+						TeamName:    types.StringValue(team.GetTeamName()),
+						Permissions: NewTypedListFromStringSlice(team.GetTeamPermissions()),
+					}
+				}
+			}
+
+			model.Workspaces[i] = workspace
+		}
+	}
+
+	return model
+}
+
+// keepNullEmptyLists returns the model with each permission list that Braze
+// returned empty set back to null where prior left it unset, as Braze returns
+// empty lists for permissions that were never set.
+func (m brazeDashboardUserModel) keepNullEmptyLists(prior brazeDashboardUserModel) brazeDashboardUserModel {
+	m.CompanyPermissions = keepNullEmptyTypedList(m.CompanyPermissions, prior.CompanyPermissions)
+
+	if prior.Workspaces == nil && len(m.Workspaces) == 0 {
+		m.Workspaces = nil
+	}
+
+	for i := range min(len(m.Workspaces), len(prior.Workspaces)) {
+		workspace := m.Workspaces[i]
+		priorWorkspace := prior.Workspaces[i]
+
+		workspace.Permissions = keepNullEmptyTypedList(workspace.Permissions, priorWorkspace.Permissions)
+
+		if priorWorkspace.Teams == nil && len(workspace.Teams) == 0 {
+			workspace.Teams = nil
+		}
+
+		for j := range min(len(workspace.Teams), len(priorWorkspace.Teams)) {
+			workspace.Teams[j].Permissions = keepNullEmptyTypedList(workspace.Teams[j].Permissions, priorWorkspace.Teams[j].Permissions)
+		}
+
+		m.Workspaces[i] = workspace
+	}
+
+	return m
+}
+
+func keepNullEmptyTypedList[T attr.Value](value, prior TypedList[T]) TypedList[T] {
+	if prior.IsNull() && value.Len() == 0 {
+		return NewTypedListNull[T]()
+	}
+
+	return value
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+func (m brazeDashboardUserModel) ToUpdateDashboardUserRequest() brazeclient.UpdateDashboardUserRequest {
+	return brazeclient.UpdateDashboardUserRequest{
+		Schemas:     []string{scimUserSchema},
+		Name:        m.toDashboardUserName(),
+		Department:  m.Department.ValueString(),
+		Permissions: m.toDashboardUserPermissions(),
+	}
+}
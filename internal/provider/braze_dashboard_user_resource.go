@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource                = (*brazeDashboardUserResource)(nil)
+	_ resource.ResourceWithConfigure   = (*brazeDashboardUserResource)(nil)
+	_ resource.ResourceWithIdentity    = (*brazeDashboardUserResource)(nil)
+	_ resource.ResourceWithImportState = (*brazeDashboardUserResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*brazeDashboardUserResource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeDashboardUserResource() resource.Resource {
+	return &brazeDashboardUserResource{}
+}
+
+type brazeDashboardUserResource struct {
+	providerData brazeProviderData
+}
+
+func (r *brazeDashboardUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_user"
+}
+
+func (r *brazeDashboardUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = BrazeDashboardUserResourceIdentitySchema()
+}
+
+func (r *brazeDashboardUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = BrazeDashboardUserResourceSchema(ctx)
+}
+
+func (r *brazeDashboardUserResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazeDashboardUserResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "dashboard user")
+}
+
+// ImportState imports a dashboard user by email. Read looks up its SCIM
+// identifier.
+func (r *brazeDashboardUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("email"), path.Root("email"), req, resp)
+}
+
+func (r *brazeDashboardUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_dashboard_user", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeDashboardUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.dashboardUsers.Create(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Dashboard User", detailFromError(err))
+
+		return
+	}
+
+	data = data.keepNullEmptyLists(plan)
+
+	resp.Diagnostics.Append(setEmailIdentityAndState(ctx, resp.Identity, &resp.State, data.Email.ValueString(), &data)...)
+}
+
+func (r *brazeDashboardUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_dashboard_user", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var state brazeDashboardUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		data brazeDashboardUserModel
+		err  error
+	)
+
+	if state.ID.IsNull() || state.ID.ValueString() == "" {
+		data, err = r.providerData.dashboardUsers.ReadByEmail(ctx, state.Email.ValueString())
+	} else {
+		data, err = r.providerData.dashboardUsers.Read(ctx, state.ID.ValueString())
+	}
+
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddWarning("Dashboard User not found", detailFromError(err))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to read Dashboard User", detailFromError(err))
+
+		return
+	}
+
+	data = data.keepNullEmptyLists(state)
+
+	resp.Diagnostics.Append(setEmailIdentityAndState(ctx, resp.Identity, &resp.State, data.Email.ValueString(), &data)...)
+}
+
+func (r *brazeDashboardUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_dashboard_user", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeDashboardUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.dashboardUsers.Update(ctx, plan)
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddError("Dashboard User not found", detailFromError(err))
+		} else {
+			resp.Diagnostics.AddError("Failed to update Dashboard User", detailFromError(err))
+		}
+
+		return
+	}
+
+	data = data.keepNullEmptyLists(plan)
+
+	resp.Diagnostics.Append(setEmailIdentityAndState(ctx, resp.Identity, &resp.State, data.Email.ValueString(), &data)...)
+}
+
+func (r *brazeDashboardUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_dashboard_user", "delete")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var state brazeDashboardUserModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.providerData.dashboardUsers.Delete(ctx, state.ID.ValueString())
+	if err != nil && !isBrazeObjectNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete Dashboard User", detailFromError(err))
+	}
+}
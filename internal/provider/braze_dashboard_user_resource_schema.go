@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func BrazeDashboardUserResourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"email": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func BrazeDashboardUserResourceSchema(ctx context.Context) schema.Schema {
+	permissionsType := NewTypedListNull[types.String]().CustomType(ctx)
+
+	return schema.Schema{
+		Description: "Manage Braze dashboard user accounts through the SCIM API. Requires the provider's scim_token and scim_request_origin.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The SCIM identifier of the dashboard user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address the dashboard user signs in with. Braze does not allow it to be changed, so changing it replaces the dashboard user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"given_name": schema.StringAttribute{
+				Description: "The first name of the dashboard user.",
+				Required:    true,
+			},
+			"family_name": schema.StringAttribute{
+				Description: "The last name of the dashboard user.",
+				Required:    true,
+			},
+			"department": schema.StringAttribute{
+				Description: "The department of the dashboard user, one of the department strings Braze accepts, for example `engineering`.",
+				Required:    true,
+			},
+			"company_permissions": schema.ListAttribute{
+				Description: "Company-wide permissions, for example `manage_company_settings`.",
+				CustomType:  permissionsType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"workspaces": schema.ListNestedAttribute{
+				Description: "The permissions of the dashboard user in each workspace it can access.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workspace_name": schema.StringAttribute{
+							Description: "The name of the workspace.",
+							Required:    true,
+						},
+						"permissions": schema.ListAttribute{
+							Description: "Permissions in the workspace, for example `basic_access`.",
+							CustomType:  permissionsType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"teams": schema.ListNestedAttribute{
+							Description: "Permissions in teams of the workspace.",
+							Optional:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"team_name": schema.StringAttribute{
+										Description: "The name of the team.",
+										Required:    true,
+									},
+									"permissions": schema.ListAttribute{
+										Description: "Permissions in the team, for example `admin`.",
+										CustomType:  permissionsType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,168 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func brazeDashboardUserConfig(resourceBody string) string {
+	return `
+provider "braze" {
+  scim_token          = "scim-token"
+  scim_request_origin = "example.com"
+}
+
+resource "braze_dashboard_user" "test" {
+` + resourceBody + `
+}
+`
+}
+
+func TestAccBrazeDashboardUser(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetSCIMToken("scim-token")
+
+	config1 := brazeDashboardUserConfig(`
+  email       = "jane@example.com"
+  given_name  = "Jane"
+  family_name = "Doe"
+  department  = "engineering"
+`)
+
+	config2 := brazeDashboardUserConfig(`
+  email               = "jane@example.com"
+  given_name          = "Janet"
+  family_name         = "Doe"
+  department          = "marketing"
+  company_permissions = ["manage_company_settings"]
+
+  workspaces = [{
+    workspace_name = "Production"
+    permissions    = ["basic_access", "send_campaigns_canvases"]
+    teams = [{
+      team_name   = "Lifecycle"
+      permissions = ["admin"]
+    }]
+  }]
+`)
+
+	config3 := brazeDashboardUserConfig(`
+  email       = "janet@example.com"
+  given_name  = "Jane"
+  family_name = "Doe"
+  department  = "engineering"
+`)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("braze_dashboard_user.test", "id"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "email", "jane@example.com"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "given_name", "Jane"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "family_name", "Doe"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "department", "engineering"),
+					resource.TestCheckNoResourceAttr("braze_dashboard_user.test", "company_permissions"),
+					resource.TestCheckNoResourceAttr("braze_dashboard_user.test", "workspaces"),
+				),
+			},
+			{
+				Config:            config1,
+				ImportState:       true,
+				ImportStateId:     "jane@example.com",
+				ImportStateVerify: true,
+				ResourceName:      "braze_dashboard_user.test",
+			},
+			{
+				Config: config2,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_dashboard_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "given_name", "Janet"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "department", "marketing"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "company_permissions.#", "1"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "workspaces.#", "1"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "workspaces.0.workspace_name", "Production"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "workspaces.0.permissions.#", "2"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "workspaces.0.teams.0.team_name", "Lifecycle"),
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "workspaces.0.teams.0.permissions.0", "admin"),
+				),
+			},
+			{
+				Config: config3,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_dashboard_user.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_dashboard_user.test", "email", "janet@example.com"),
+					resource.TestCheckNoResourceAttr("braze_dashboard_user.test", "workspaces"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if count := server.CallCount(brazeclient.DeleteDashboardUserOperation); count != 2 {
+				return fmt.Errorf("expected 2 dashboard user deletions, got %d", count)
+			}
+
+			return nil
+		},
+	})
+}
+
+func TestAccBrazeDashboardUserInvalidSCIMToken(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetSCIMToken("another-scim-token")
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: brazeDashboardUserConfig(`
+  email       = "jane@example.com"
+  given_name  = "Jane"
+  family_name = "Doe"
+  department  = "engineering"
+`),
+				ExpectError: regexp.MustCompile("Invalid SCIM token"),
+			},
+		},
+	})
+}
+
+func TestAccBrazeDashboardUserSCIMTokenNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "braze_dashboard_user" "test" {
+  email       = "jane@example.com"
+  given_name  = "Jane"
+  family_name = "Doe"
+  department  = "engineering"
+}
+`,
+				ExpectError: regexp.MustCompile("no Braze SCIM token is configured"),
+			},
+		},
+	})
+}
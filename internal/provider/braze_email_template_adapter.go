@@ -6,7 +6,6 @@ import (
 	"time"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type emailTemplateClient interface {
@@ -18,14 +17,18 @@ type emailTemplateClient interface {
 
 type generatedEmailTemplateClient struct {
 	client *brazeclient.Client
+	logger brazeAPILogger
 }
 
 type emailTemplateListItem struct {
 	item brazeclient.ListEmailTemplatesResponseTemplatesItem
 }
 
-func newGeneratedEmailTemplateClient(client *brazeclient.Client) generatedEmailTemplateClient {
-	return generatedEmailTemplateClient{client: client}
+func newGeneratedEmailTemplateClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedEmailTemplateClient {
+	return generatedEmailTemplateClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads, "body", "plaintext_body"),
+	}
 }
 
 func (c generatedEmailTemplateClient) Create(ctx context.Context, plan brazeEmailTemplateModel) (brazeEmailTemplateModel, error) {
@@ -33,12 +36,14 @@ func (c generatedEmailTemplateClient) Create(ctx context.Context, plan brazeEmai
 
 	createResponse, createErr := c.client.CreateEmailTemplate(ctx, &createRequest)
 
-	tflog.Info(ctx, "braze_email_template.create", map[string]any{
-		"request":  createRequest,
-		"response": createResponse,
-		"err":      createErr,
+	c.logger.Log(ctx, "braze_email_template.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
 	})
 
+	createErr = classifyBrazePermissionError(brazeclient.CreateEmailTemplateOperation, createErr)
+
 	if createErr != nil {
 		return brazeEmailTemplateModel{}, fmt.Errorf("create email template: %w", createErr)
 	}
@@ -57,12 +62,14 @@ func (c generatedEmailTemplateClient) Read(ctx context.Context, id string) (braz
 
 	getResponse, getErr := c.client.GetEmailTemplateInfo(ctx, getParams)
 
-	tflog.Info(ctx, "braze_email_template.read", map[string]any{
-		"params":   getParams,
-		"response": getResponse,
-		"err":      getErr,
+	c.logger.Log(ctx, "braze_email_template.read", brazeAPILogEntry{
+		Params:   getParams,
+		Response: getResponse,
+		Err:      getErr,
 	})
 
+	getErr = classifyBrazePermissionError(brazeclient.GetEmailTemplateInfoOperation, getErr)
+
 	if getErr != nil {
 		return brazeEmailTemplateModel{}, classifyBrazeObjectReadError(getErr)
 	}
@@ -79,12 +86,14 @@ func (c generatedEmailTemplateClient) Update(ctx context.Context, plan brazeEmai
 
 	updateResponse, updateErr := c.client.UpdateEmailTemplate(ctx, &updateRequest)
 
-	tflog.Info(ctx, "braze_email_template.update", map[string]any{
-		"request":  updateRequest,
-		"response": updateResponse,
-		"err":      updateErr,
+	c.logger.Log(ctx, "braze_email_template.update", brazeAPILogEntry{
+		Request:  &updateRequest,
+		Response: updateResponse,
+		Err:      updateErr,
 	})
 
+	updateErr = classifyBrazePermissionError(brazeclient.UpdateEmailTemplateOperation, updateErr)
+
 	if updateErr != nil {
 		return brazeEmailTemplateModel{}, fmt.Errorf("update email template: %w", updateErr)
 	}
@@ -120,12 +129,14 @@ func (c generatedEmailTemplateClient) listPage(ctx context.Context, query brazeO
 
 	listResponse, listErr := c.client.ListEmailTemplates(ctx, params)
 
-	tflog.Info(ctx, "braze_email_template.list", map[string]any{
-		"params":   params,
-		"response": listResponse,
-		"err":      listErr,
+	c.logger.Log(ctx, "braze_email_template.list", brazeAPILogEntry{
+		Params:   params,
+		Response: listResponse,
+		Err:      listErr,
 	})
 
+	listErr = classifyBrazePermissionError(brazeclient.ListEmailTemplatesOperation, listErr)
+
 	if listErr != nil {
 		return nil, fmt.Errorf("list email templates: %w", listErr)
 	}
@@ -73,6 +73,7 @@ func (r *brazeEmailTemplateListResource) List(ctx context.Context, req list.List
 	query := brazeObjectListQuery{
 		Limit:           req.Limit,
 		IncludeResource: req.IncludeResource,
+		Concurrency:     r.providerData.listConcurrency,
 	}
 	paramsDiags := diag.Diagnostics{}
 
@@ -97,6 +98,9 @@ func (r *brazeEmailTemplateListResource) List(ctx context.Context, req list.List
 	}
 
 	resp.Results = func(yield func(list.ListResult) bool) {
+		ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_email_template", "list")
+		defer span.End()
+
 		entries, listErr := r.providerData.emailTemplates.List(ctx, query)
 		if listErr != nil {
 			streamBrazeObjectListError(ctx, req, "Failed to list email templates", listErr, yield)
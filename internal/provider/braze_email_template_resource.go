@@ -12,6 +12,7 @@ var (
 	_ resource.ResourceWithConfigure   = (*brazeEmailTemplateResource)(nil)
 	_ resource.ResourceWithIdentity    = (*brazeEmailTemplateResource)(nil)
 	_ resource.ResourceWithImportState = (*brazeEmailTemplateResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*brazeEmailTemplateResource)(nil)
 )
 
 //nolint:ireturn
@@ -39,11 +40,18 @@ func (r *brazeEmailTemplateResource) Configure(_ context.Context, req resource.C
 	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
 }
 
+func (r *brazeEmailTemplateResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "email template")
+}
+
 func (r *brazeEmailTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 func (r *brazeEmailTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_email_template", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var plan brazeEmailTemplateModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -66,6 +74,9 @@ func (r *brazeEmailTemplateResource) Create(ctx context.Context, req resource.Cr
 }
 
 func (r *brazeEmailTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_email_template", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var state brazeEmailTemplateModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -92,6 +103,9 @@ func (r *brazeEmailTemplateResource) Read(ctx context.Context, req resource.Read
 }
 
 func (r *brazeEmailTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_email_template", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
 	var plan brazeEmailTemplateModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
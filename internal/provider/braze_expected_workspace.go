@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// brazeWorkspaceSentinelCatalogName is the catalog that identifies a Braze
+// workspace for expected_workspace. Its description is the workspace
+// identifier. Catalogs are used because reading one needs only the
+// catalogs.get permission.
+const brazeWorkspaceSentinelCatalogName = "terraform_workspace"
+
+var (
+	errBrazeWorkspaceUnidentified = errors.New("braze workspace has no " + brazeWorkspaceSentinelCatalogName + " catalog")
+	errBrazeWorkspaceMismatch     = errors.New("braze workspace does not match expected_workspace")
+)
+
+// verifyBrazeWorkspace checks that the workspace the API key belongs to is
+// identified as expected by its sentinel catalog. Braze has no API to read a
+// single catalog, so this lists the workspace's catalogs, following every page
+// of the listing.
+func verifyBrazeWorkspace(ctx context.Context, catalogs catalogClient, expected string) error {
+	sentinel, err := catalogs.Read(ctx, brazeWorkspaceSentinelCatalogName)
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			return errBrazeWorkspaceUnidentified
+		}
+
+		return fmt.Errorf("read %s catalog: %w", brazeWorkspaceSentinelCatalogName, err)
+	}
+
+	if actual := sentinel.Description.ValueString(); actual != expected {
+		return fmt.Errorf("%w: expected %q, found %q", errBrazeWorkspaceMismatch, expected, actual)
+	}
+
+	return nil
+}
+
+// brazeWorkspaceKey is an API key the provider sends, with the catalogs client
+// that reads the sentinel catalog with it.
+type brazeWorkspaceKey struct {
+	setting  string
+	catalogs catalogClient
+}
+
+// verifyBrazeWorkspaceKeys checks the workspace of each of keys, as with
+// api_keys each family's key may belong to a different workspace.
+func verifyBrazeWorkspaceKeys(ctx context.Context, keys []brazeWorkspaceKey, expected string) error {
+	for _, key := range keys {
+		err := verifyBrazeWorkspace(ctx, key.catalogs, expected)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key.setting, err)
+		}
+	}
+
+	return nil
+}
+
+// brazeWorkspaceKeys returns each distinct API key the provider sends, by the
+// setting it comes from. The key of the catalogs family is read with catalogs,
+// the provider's own client, and newCatalogs creates a client for each other
+// key.
+func brazeWorkspaceKeys(
+	ctx context.Context,
+	apiKeySource func(ctx context.Context) (string, error),
+	familyTokens map[string]string,
+	catalogs catalogClient,
+	newCatalogs func(apiKey string) (catalogClient, error),
+) ([]brazeWorkspaceKey, error) {
+	apiKey, err := apiKeySource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := map[string]string{"api_key": apiKey}
+	for family, token := range familyTokens {
+		settings["api_keys."+family] = token
+	}
+
+	catalogsSetting := "api_key"
+	if _, found := familyTokens[brazeAPIKeyFamilyCatalogs]; found {
+		catalogsSetting = "api_keys." + brazeAPIKeyFamilyCatalogs
+	}
+
+	keys := []brazeWorkspaceKey{{setting: catalogsSetting, catalogs: catalogs}}
+	seen := map[string]bool{settings[catalogsSetting]: true}
+
+	for _, setting := range slices.Sorted(maps.Keys(settings)) {
+		token := settings[setting]
+		if token == "" || seen[token] {
+			continue
+		}
+
+		seen[token] = true
+
+		client, err := newCatalogs(token)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, brazeWorkspaceKey{setting: setting, catalogs: client})
+	}
+
+	return keys, nil
+}
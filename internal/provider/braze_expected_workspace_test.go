@@ -0,0 +1,144 @@
+//nolint:testpackage
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBrazeWorkspace(t *testing.T) {
+	t.Parallel()
+
+	sentinelFields := []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}}
+
+	t.Run("match", func(t *testing.T) {
+		t.Parallel()
+
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+			server.SetCatalog(brazeWorkspaceSentinelCatalogName, "staging", sentinelFields)
+		}), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
+
+		require.NoError(t, verifyBrazeWorkspace(t.Context(), client, "staging"))
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+			server.SetCatalog(brazeWorkspaceSentinelCatalogName, "production", sentinelFields)
+		}), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
+
+		err := verifyBrazeWorkspace(t.Context(), client, "staging")
+		require.ErrorIs(t, err, errBrazeWorkspaceMismatch)
+		assert.Contains(t, err.Error(), `found "production"`)
+	})
+
+	t.Run("unidentified", func(t *testing.T) {
+		t.Parallel()
+
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
+
+		err := verifyBrazeWorkspace(t.Context(), client, "staging")
+		require.ErrorIs(t, err, errBrazeWorkspaceUnidentified)
+		assert.Contains(t, detailFromError(err), "Create a catalog named terraform_workspace")
+	})
+}
+
+func TestVerifyBrazeWorkspaceKeys(t *testing.T) {
+	t.Parallel()
+
+	sentinelFields := []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}}
+
+	production, err := brazeclienttesting.NewBrazeServer()
+	require.NoError(t, err)
+	production.SetCatalog(brazeWorkspaceSentinelCatalogName, "production", sentinelFields)
+
+	staging, err := brazeclienttesting.NewBrazeServer()
+	require.NoError(t, err)
+	staging.SetCatalog(brazeWorkspaceSentinelCatalogName, "staging", sentinelFields)
+
+	httpServer := httptest.NewServer(brazeclienttesting.NewWorkspacesByAPIKey(map[string]http.Handler{
+		"production-key":        production,
+		"production-blocks-key": production,
+		"staging-key":           staging,
+	}))
+	t.Cleanup(httpServer.Close)
+
+	newCatalogs := func(apiKey string) (catalogClient, error) {
+		client, err := brazeclient.NewClient(httpServer.URL, NewBrazeAPIKeySecuritySource(apiKey), brazeclient.WithClient(httpServer.Client()))
+		if err != nil {
+			return nil, err
+		}
+
+		return newGeneratedCatalogClient(client, brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL)), nil
+	}
+
+	tests := map[string]struct {
+		familyTokens     map[string]string
+		expectedSettings []string
+		expectedErr      string
+	}{
+		"api_key only": {
+			expectedSettings: []string{"api_key"},
+		},
+		"family keys in the same workspace": {
+			familyTokens: map[string]string{
+				brazeAPIKeyFamilyCampaigns:     "production-key",
+				brazeAPIKeyFamilyContentBlocks: "production-blocks-key",
+			},
+			expectedSettings: []string{"api_key", "api_keys.content_blocks"},
+		},
+		"family key in another workspace": {
+			familyTokens: map[string]string{
+				brazeAPIKeyFamilyContentBlocks: "staging-key",
+			},
+			expectedSettings: []string{"api_key", "api_keys.content_blocks"},
+			expectedErr:      `api_keys.content_blocks: braze workspace does not match expected_workspace: expected "production", found "staging"`,
+		},
+		"catalogs key in another workspace": {
+			familyTokens: map[string]string{
+				brazeAPIKeyFamilyCatalogs: "staging-key",
+			},
+			expectedSettings: []string{"api_keys.catalogs", "api_key"},
+			expectedErr:      `api_keys.catalogs: braze workspace does not match expected_workspace`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			catalogsKey := "production-key"
+			if token, found := test.familyTokens[brazeAPIKeyFamilyCatalogs]; found {
+				catalogsKey = token
+			}
+
+			catalogs, err := newCatalogs(catalogsKey)
+			require.NoError(t, err)
+
+			keys, err := brazeWorkspaceKeys(t.Context(), brazeStaticAPIKey("production-key"), test.familyTokens, catalogs, newCatalogs)
+			require.NoError(t, err)
+
+			settings := make([]string, len(keys))
+			for i, key := range keys {
+				settings[i] = key.setting
+			}
+
+			assert.Equal(t, test.expectedSettings, settings)
+
+			err = verifyBrazeWorkspaceKeys(t.Context(), keys, "production")
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errBrazeWorkspaceMismatch)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// brazeAPITriggeredScheduleType is the schedule type Braze reports for
+// campaigns and Canvases with API-triggered delivery.
+const brazeAPITriggeredScheduleType = "api_triggered"
+
+// brazeMessagingObject is a campaign or Canvas, which Braze lists and
+// describes through the same shape of export endpoints.
+type brazeMessagingObject struct {
+	ID           string
+	Name         string
+	Tags         []string
+	LastEdited   time.Time
+	APITriggered bool
+	Archived     bool
+}
+
+// brazeMessagingObjectFilter selects the campaigns or Canvases to return.
+// Archived is nil to return both archived and unarchived objects.
+type brazeMessagingObjectFilter struct {
+	Name        string
+	NameRegex   *regexp.Regexp
+	Tag         string
+	Archived    *bool
+	Concurrency int
+}
+
+func (f brazeMessagingObjectFilter) matchesListItem(object brazeMessagingObject) bool {
+	if f.Name != "" && object.Name != f.Name {
+		return false
+	}
+
+	if f.NameRegex != nil && !f.NameRegex.MatchString(object.Name) {
+		return false
+	}
+
+	if f.Tag != "" && !slices.Contains(object.Tags, f.Tag) {
+		return false
+	}
+
+	return true
+}
+
+// listBrazeMessagingObjects reads every page of a campaign or Canvas list,
+// then reads the details of the objects that match the filter, with up to
+// filter.Concurrency reads in flight at once, as only the details report
+// whether an object is archived or API-triggered.
+func listBrazeMessagingObjects(
+	filter brazeMessagingObjectFilter,
+	fetchPage func(page int, includeArchived bool) ([]brazeMessagingObject, error),
+	fetchDetails func(object *brazeMessagingObject) error,
+) ([]brazeMessagingObject, error) {
+	includeArchived := filter.Archived == nil || *filter.Archived
+
+	objects := []brazeMessagingObject{}
+
+	for page := 0; ; page++ {
+		items, err := fetchPage(page, includeArchived)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if filter.matchesListItem(item) {
+				objects = append(objects, item)
+			}
+		}
+
+		if len(items) < brazeExportListPageLimit {
+			break
+		}
+	}
+
+	err := readBrazeObjectsConcurrently(filter.Concurrency, len(objects), func(i int) error {
+		return fetchDetails(&objects[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Archived != nil {
+		objects = slices.DeleteFunc(objects, func(object brazeMessagingObject) bool {
+			return object.Archived != *filter.Archived
+		})
+	}
+
+	return objects, nil
+}
+
+type brazeMessagingObjectsFilterModel struct {
+	Name      types.String `tfsdk:"name"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Tag       types.String `tfsdk:"tag"`
+	Archived  types.Bool   `tfsdk:"archived"`
+}
+
+type brazeMessagingObjectModel struct {
+	ID           types.String            `tfsdk:"id"`
+	Name         types.String            `tfsdk:"name"`
+	Tags         TypedList[types.String] `tfsdk:"tags"`
+	LastEdited   types.String            `tfsdk:"last_edited"`
+	APITriggered types.Bool              `tfsdk:"api_triggered"`
+	Archived     types.Bool              `tfsdk:"archived"`
+}
+
+func (m brazeMessagingObjectsFilterModel) toFilter(concurrency int) (brazeMessagingObjectFilter, diag.Diagnostics) {
+	filter := brazeMessagingObjectFilter{
+		Name:        m.Name.ValueString(),
+		Tag:         m.Tag.ValueString(),
+		Archived:    m.Archived.ValueBoolPointer(),
+		Concurrency: concurrency,
+	}
+
+	nameRegex, diags := compileBrazeNameRegex(m.NameRegex)
+	filter.NameRegex = nameRegex
+
+	return filter, diags
+}
+
+// compileBrazeNameRegex compiles the name_regex filter of a data source. The
+// result is nil when the filter is not set.
+func compileBrazeNameRegex(nameRegex types.String) (*regexp.Regexp, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return nil, diags
+	}
+
+	compiled, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+
+		return nil, diags
+	}
+
+	return compiled, diags
+}
+
+func newBrazeMessagingObjectModels(objects []brazeMessagingObject) []brazeMessagingObjectModel {
+	models := make([]brazeMessagingObjectModel, len(objects))
+
+	for i, object := range objects {
+		lastEdited := types.StringNull()
+		if !object.LastEdited.IsZero() {
+			lastEdited = types.StringValue(object.LastEdited.UTC().Format(time.RFC3339))
+		}
+
+		models[i] = brazeMessagingObjectModel{
+			ID:           types.StringValue(object.ID),
+			Name:         types.StringValue(object.Name),
+			Tags:         NewTypedListFromStringSlice(object.Tags),
+			LastEdited:   lastEdited,
+			APITriggered: types.BoolValue(object.APITriggered),
+			Archived:     types.BoolValue(object.Archived),
+		}
+	}
+
+	return models
+}
+
+// brazeMessagingObjectsDataSourceSchema returns the schema of the campaigns
+// and Canvases data sources, which differ only in the kind of object they
+// read. noun and plural name the kind of object and attribute is the name of
+// the attribute that holds the results.
+func brazeMessagingObjectsDataSourceSchema(ctx context.Context, description, noun, plural, attribute string) schema.Schema {
+	return schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return " + plural + " with exactly this name.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return " + plural + " whose name matches this regular expression, in Go RE2 syntax.",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Only return " + plural + " with this tag.",
+				Optional:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Only return archived " + plural + " when true, or only unarchived " + plural + " when false. Both are returned when not set.",
+				Optional:    true,
+			},
+			attribute: schema.ListNestedAttribute{
+				Description: "The matching " + plural + ", in the order Braze lists them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The API identifier of the " + noun + ".",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the " + noun + ".",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "The tags of the " + noun + ".",
+							CustomType:  NewTypedListNull[types.String]().CustomType(ctx),
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"last_edited": schema.StringAttribute{
+							Description: "When the " + noun + " was last edited, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"api_triggered": schema.BoolAttribute{
+							Description: "Whether the " + noun + " is sent by API requests rather than on a schedule or in response to user actions.",
+							Computed:    true,
+						},
+						"archived": schema.BoolAttribute{
+							Description: "Whether the " + noun + " is archived.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,10 +2,23 @@ package provider
 
 import (
 	"errors"
+	"net/http"
+	"strings"
+	"sync"
 	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
 )
 
-const brazeObjectListPageLimit = 100
+const (
+	brazeObjectListPageLimit = 100
+	// brazeExportListPageLimit is the number of campaigns, canvases or
+	// segments Braze returns on each page of an export list.
+	brazeExportListPageLimit = 100
+	// defaultBrazeListConcurrency is the number of resources read at once
+	// when a list request includes resources.
+	defaultBrazeListConcurrency = 4
+)
 
 var errBrazeObjectEmptyResponse = errors.New("empty Braze object response")
 
@@ -14,6 +27,7 @@ type brazeObjectListQuery struct {
 	ModifiedAfter   *time.Time
 	ModifiedBefore  *time.Time
 	IncludeResource bool
+	Concurrency     int
 }
 
 type brazeObjectListEntry[Model any] struct {
@@ -46,6 +60,46 @@ func isBrazeObjectNotFound(err error) bool {
 	return errors.As(err, &notFound)
 }
 
+// brazePermissionError is returned when Braze rejects a request because the
+// API key is invalid or lacks the permission the operation requires.
+type brazePermissionError struct {
+	operation   brazeclient.OperationName
+	permissions []string
+	err         error
+}
+
+func (e brazePermissionError) Error() string {
+	return e.err.Error()
+}
+
+func (e brazePermissionError) Unwrap() error {
+	return e.err
+}
+
+// Detail explains which permission the API key needs.
+func (e brazePermissionError) Detail() string {
+	if len(e.permissions) == 0 {
+		return "The Braze API key was rejected for " + e.operation + "."
+	}
+
+	return "The Braze API key was rejected. Check that the key is valid and has the " + strings.Join(e.permissions, ", ") + " permission."
+}
+
+func classifyBrazePermissionError(operation brazeclient.OperationName, err error) error {
+	var ersc *brazeclient.ErrorResponseStatusCode
+	if !errors.As(err, &ersc) || (ersc.StatusCode != http.StatusUnauthorized && ersc.StatusCode != http.StatusForbidden) {
+		return err
+	}
+
+	permissions := brazeclient.GetRolesForBrazeApiKey(operation)
+
+	return brazePermissionError{
+		operation:   operation,
+		permissions: permissions,
+		err:         err,
+	}
+}
+
 func collectBrazeObjectPages[Item any](query brazeObjectListQuery, fetch func(offset, limit int) ([]Item, error)) ([]Item, error) {
 	if query.Limit <= 0 {
 		return nil, nil
@@ -78,30 +132,71 @@ func collectBrazeObjectPages[Item any](query brazeObjectListQuery, fetch func(of
 	}
 }
 
+// buildBrazeObjectListEntries converts list items to entries and, when the
+// query includes resources, reads them with up to query.Concurrency reads in
+// flight at once. Entries keep the order of items.
 func buildBrazeObjectListEntries[Item brazeObjectListItem[Model], Model any](
 	query brazeObjectListQuery,
 	items []Item,
 	read func(id string) (Model, error),
 ) []brazeObjectListEntry[Model] {
-	entries := make([]brazeObjectListEntry[Model], 0, len(items))
-	for _, item := range items {
-		entry := item.ListEntry()
-
-		if query.IncludeResource {
-			resource, err := read(entry.ID)
-			if err != nil {
-				entry.ResourceErr = err
-			} else {
-				entry.Resource = &resource
-			}
-		}
+	entries := make([]brazeObjectListEntry[Model], len(items))
+	for i, item := range items {
+		entries[i] = item.ListEntry()
+	}
 
-		entries = append(entries, entry)
+	if !query.IncludeResource || len(entries) == 0 {
+		return entries
 	}
 
+	// Read errors are kept on their entries rather than failing the list, so
+	// no read returns an error.
+	_ = readBrazeObjectsConcurrently(query.Concurrency, len(entries), func(i int) error {
+		resource, err := read(entries[i].ID)
+		if err != nil {
+			entries[i].ResourceErr = err
+		} else {
+			entries[i].Resource = &resource
+		}
+
+		return nil
+	})
+
 	return entries
 }
 
+// readBrazeObjectsConcurrently calls read for each index below count, with up
+// to concurrency calls in flight at once, and returns the error of the first
+// index whose read failed.
+func readBrazeObjectsConcurrently(concurrency int, count int, read func(i int) error) error {
+	errs := make([]error, count)
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for range max(1, min(concurrency, count)) {
+		wg.Go(func() {
+			for i := range indexes {
+				errs[i] = read(i)
+			}
+		})
+	}
+
+	for i := range count {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func listBrazeObjectEntries[Item brazeObjectListItem[Model], Model any](
 	query brazeObjectListQuery,
 	fetch func(offset, limit int) ([]Item, error),
@@ -6,8 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http/httptest"
+	"regexp"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
 	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
@@ -98,6 +102,72 @@ func TestCollectBrazeObjectPages(t *testing.T) {
 	}
 }
 
+type testBrazeObjectListItem string
+
+func (i testBrazeObjectListItem) ListEntry() brazeObjectListEntry[string] {
+	return brazeObjectListEntry[string]{ID: string(i), DisplayName: string(i)}
+}
+
+func TestBuildBrazeObjectListEntriesReadsConcurrently(t *testing.T) {
+	t.Parallel()
+
+	items := make([]testBrazeObjectListItem, 20)
+	for i := range items {
+		items[i] = testBrazeObjectListItem(strconv.Itoa(i))
+	}
+
+	var inFlight, maxInFlight atomic.Int64
+
+	entries := buildBrazeObjectListEntries(brazeObjectListQuery{IncludeResource: true, Concurrency: 3}, items, func(id string) (string, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+
+		if id == "7" {
+			return "", errTestBrazeObjectFetch
+		}
+
+		return "resource " + id, nil
+	})
+
+	require.Len(t, entries, 20)
+	assert.LessOrEqual(t, maxInFlight.Load(), int64(3))
+
+	for i, entry := range entries {
+		assert.Equal(t, strconv.Itoa(i), entry.ID)
+
+		if i == 7 {
+			require.ErrorIs(t, entry.ResourceErr, errTestBrazeObjectFetch)
+			assert.Nil(t, entry.Resource)
+		} else {
+			require.NoError(t, entry.ResourceErr)
+			assert.Equal(t, "resource "+strconv.Itoa(i), *entry.Resource)
+		}
+	}
+}
+
+func TestBuildBrazeObjectListEntriesWithoutResources(t *testing.T) {
+	t.Parallel()
+
+	entries := buildBrazeObjectListEntries(brazeObjectListQuery{}, []testBrazeObjectListItem{"a", "b"}, func(string) (string, error) {
+		t.Fatal("read should not be called")
+
+		return "", nil
+	})
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "a", entries[0].ID)
+	assert.Nil(t, entries[0].Resource)
+}
+
 func TestGeneratedContentBlockClient(t *testing.T) {
 	t.Parallel()
 
@@ -146,7 +216,7 @@ func TestGeneratedContentBlockClient(t *testing.T) {
 	t.Run("read maps not found", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedContentBlockClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}))
+		client := newGeneratedContentBlockClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}), brazeLogPayloadsMetadata)
 
 		_, err := client.Read(t.Context(), "missing-content-block")
 
@@ -154,13 +224,32 @@ func TestGeneratedContentBlockClient(t *testing.T) {
 		assert.True(t, isBrazeObjectNotFound(err))
 	})
 
+	t.Run("update names missing permission", func(t *testing.T) {
+		t.Parallel()
+
+		client := newGeneratedContentBlockClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+			server.SetContentBlock("existing-content-block", "existing-content-block", "<p>Existing</p>", "", nil)
+			server.SetAPIKeyPermissions("test", "content_blocks.info")
+		}), brazeLogPayloadsMetadata)
+
+		_, err := client.Update(t.Context(), brazeContentBlockModel{
+			IDIdentityModel: IDIdentityModel{ID: types.StringValue("existing-content-block")},
+			Name:            types.StringValue("updated-content-block"),
+			Content:         types.StringValue("<p>Updated</p>"),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, detailFromError(err), "has the content_blocks.update permission")
+		assert.False(t, isBrazeObjectNotFound(err))
+	})
+
 	t.Run("create returns hydrated model", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedContentBlockClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}))
+		client := newGeneratedContentBlockClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}), brazeLogPayloadsMetadata)
 
 		actual, err := client.Create(t.Context(), brazeContentBlockModel{
-			Name:        types.StringValue("Created content block"),
+			Name:        types.StringValue("created-content-block"),
 			Description: types.StringValue("created description"),
 			Content:     types.StringValue("<p>Created</p>"),
 			Tags:        NewTypedListFromStringSlice([]string{"tag2"}),
@@ -168,7 +257,7 @@ func TestGeneratedContentBlockClient(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, actual.ID.ValueString())
-		assert.Equal(t, "Created content block", actual.Name.ValueString())
+		assert.Equal(t, "created-content-block", actual.Name.ValueString())
 		assert.Equal(t, "created description", actual.Description.ValueString())
 		assert.Equal(t, "<p>Created</p>", actual.Content.ValueString())
 		assert.Equal(t, []string{"tag2"}, TypedListToStringSlice(actual.Tags))
@@ -178,14 +267,14 @@ func TestGeneratedContentBlockClient(t *testing.T) {
 		t.Parallel()
 
 		client := newGeneratedContentBlockClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
-			server.SetContentBlock("existing-content-block", "Existing content block", "<p>Existing</p>", "description", []string{"tag1"})
-		}))
+			server.SetContentBlock("existing-content-block", "existing-content-block", "<p>Existing</p>", "description", []string{"tag1"})
+		}), brazeLogPayloadsMetadata)
 
 		actual, err := client.Update(t.Context(), brazeContentBlockModel{
 			IDIdentityModel: IDIdentityModel{
 				ID: types.StringValue("existing-content-block"),
 			},
-			Name:        types.StringValue("Updated content block"),
+			Name:        types.StringValue("updated-content-block"),
 			Description: types.StringValue("updated description"),
 			Content:     types.StringValue("<p>Updated</p>"),
 			Tags:        NewTypedListFromStringSlice([]string{"tag2"}),
@@ -193,7 +282,7 @@ func TestGeneratedContentBlockClient(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, "existing-content-block", actual.ID.ValueString())
-		assert.Equal(t, "Updated content block", actual.Name.ValueString())
+		assert.Equal(t, "updated-content-block", actual.Name.ValueString())
 		assert.Equal(t, "updated description", actual.Description.ValueString())
 		assert.Equal(t, "<p>Updated</p>", actual.Content.ValueString())
 		assert.Equal(t, []string{"tag2"}, TypedListToStringSlice(actual.Tags))
@@ -203,8 +292,8 @@ func TestGeneratedContentBlockClient(t *testing.T) {
 		t.Parallel()
 
 		client := newGeneratedContentBlockClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
-			server.SetContentBlock("existing-content-block", "Existing content block", "<p>Existing</p>", "description", []string{"tag1"})
-		}))
+			server.SetContentBlock("existing-content-block", "existing-content-block", "<p>Existing</p>", "description", []string{"tag1"})
+		}), brazeLogPayloadsMetadata)
 
 		entries, err := client.List(t.Context(), brazeObjectListQuery{
 			Limit:           1,
@@ -214,7 +303,7 @@ func TestGeneratedContentBlockClient(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, entries, 1)
 		assert.Equal(t, "existing-content-block", entries[0].ID)
-		assert.Equal(t, "Existing content block", entries[0].DisplayName)
+		assert.Equal(t, "existing-content-block", entries[0].DisplayName)
 		require.NotNil(t, entries[0].Resource)
 		assert.Equal(t, "<p>Existing</p>", entries[0].Resource.Content.ValueString())
 		assert.NoError(t, entries[0].ResourceErr)
@@ -227,7 +316,7 @@ func TestGeneratedEmailTemplateClient(t *testing.T) {
 	t.Run("read maps not found", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedEmailTemplateClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}))
+		client := newGeneratedEmailTemplateClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}), brazeLogPayloadsMetadata)
 
 		_, err := client.Read(t.Context(), "missing-email-template")
 
@@ -238,7 +327,7 @@ func TestGeneratedEmailTemplateClient(t *testing.T) {
 	t.Run("create returns hydrated model", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedEmailTemplateClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}))
+		client := newGeneratedEmailTemplateClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}), brazeLogPayloadsMetadata)
 
 		actual, err := client.Create(t.Context(), brazeEmailTemplateModel{
 			TemplateName:    types.StringValue("Created email template"),
@@ -267,7 +356,7 @@ func TestGeneratedEmailTemplateClient(t *testing.T) {
 		shouldInlineCSS := true
 		client := newGeneratedEmailTemplateClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
 			server.SetEmailTemplate("existing-email-template", "Existing email template", "Subject", "<p>Body</p>", "Body", "Preview", []string{"tag1"}, &shouldInlineCSS)
-		}))
+		}), brazeLogPayloadsMetadata)
 
 		actual, err := client.Update(t.Context(), brazeEmailTemplateModel{
 			IDIdentityModel: IDIdentityModel{
@@ -299,7 +388,7 @@ func TestGeneratedEmailTemplateClient(t *testing.T) {
 		shouldInlineCSS := true
 		client := newGeneratedEmailTemplateClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
 			server.SetEmailTemplate("existing-email-template", "Existing email template", "Subject", "<p>Body</p>", "Body", "Preview", []string{"tag1"}, &shouldInlineCSS)
-		}))
+		}), brazeLogPayloadsMetadata)
 
 		entries, err := client.List(t.Context(), brazeObjectListQuery{
 			Limit:           1,
@@ -322,7 +411,7 @@ func TestGeneratedCatalogClient(t *testing.T) {
 	t.Run("read returns hydrated model", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedCatalogClient(newTestBrazeClient(t, withTestCatalog(t)))
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, withTestCatalog(t)), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
 
 		actual, err := client.Read(t.Context(), "centres")
 
@@ -336,7 +425,7 @@ func TestGeneratedCatalogClient(t *testing.T) {
 	t.Run("read missing maps not found", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedCatalogClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}))
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, func(*brazeclienttesting.Server) {}), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
 
 		_, err := client.Read(t.Context(), "missing-catalog")
 
@@ -347,7 +436,7 @@ func TestGeneratedCatalogClient(t *testing.T) {
 	t.Run("delete removes catalog", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedCatalogClient(newTestBrazeClient(t, withTestCatalog(t)))
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, withTestCatalog(t)), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
 
 		err := client.Delete(t.Context(), "centres")
 		require.NoError(t, err)
@@ -360,7 +449,7 @@ func TestGeneratedCatalogClient(t *testing.T) {
 	t.Run("list returns resource entries", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedCatalogClient(newTestBrazeClient(t, withTestCatalog(t)))
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, withTestCatalog(t)), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
 
 		entries, err := client.List(t.Context())
 
@@ -372,6 +461,64 @@ func TestGeneratedCatalogClient(t *testing.T) {
 		assert.Equal(t, "Centre metadata", entries[0].Resource.Description.ValueString())
 		assert.NoError(t, entries[0].ResourceErr)
 	})
+
+	t.Run("read and list follow catalog pagination", func(t *testing.T) {
+		t.Parallel()
+
+		var server *brazeclienttesting.Server
+
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, func(s *brazeclienttesting.Server) {
+			server = s
+
+			for i := range 120 {
+				server.SetCatalog(fmt.Sprintf("catalog%03d", i), "", []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}})
+			}
+		}), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
+
+		actual, err := client.Read(t.Context(), "catalog119")
+
+		require.NoError(t, err)
+		assert.Equal(t, "catalog119", actual.Name.ValueString())
+		assert.Equal(t, 3, server.CallCount(brazeclient.ListCatalogsOperation))
+
+		entries, err := client.List(t.Context())
+
+		require.NoError(t, err)
+		require.Len(t, entries, 120)
+		assert.Equal(t, "catalog000", entries[0].ID)
+		assert.Equal(t, "catalog119", entries[119].ID)
+	})
+
+	t.Run("reads share cached catalog list until invalidated", func(t *testing.T) {
+		t.Parallel()
+
+		var server *brazeclienttesting.Server
+
+		client := newGeneratedCatalogClient(newTestBrazeClient(t, func(s *brazeclienttesting.Server) {
+			server = s
+			server.SetCatalog("centres", "Centre metadata", []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}})
+			server.SetCatalog("regions", "Region metadata", []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}})
+		}), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
+
+		var wg sync.WaitGroup
+		for _, name := range []string{"centres", "regions", "centres", "regions", "centres"} {
+			wg.Go(func() {
+				_, err := client.Read(t.Context(), name)
+				assert.NoError(t, err)
+			})
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, 1, server.CallCount(brazeclient.ListCatalogsOperation))
+
+		require.NoError(t, client.Delete(t.Context(), "regions"))
+
+		_, err := client.Read(t.Context(), "regions")
+		require.Error(t, err)
+		assert.True(t, isBrazeObjectNotFound(err))
+		assert.Equal(t, 2, server.CallCount(brazeclient.ListCatalogsOperation))
+	})
 }
 
 func TestGeneratedCatalogItemClient(t *testing.T) {
@@ -380,7 +527,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 	t.Run("create uses item-addressed endpoint", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedCatalogItemClient(newTestBrazeClient(t, withTestCatalog(t)))
+		client := newGeneratedCatalogItemClient(newTestBrazeClient(t, withTestCatalog(t)), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 		actual, err := client.Create(t.Context(), brazeCatalogItemModel{
 			CatalogName: types.StringValue("centres"),
@@ -396,7 +543,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 	t.Run("read maps not found", func(t *testing.T) {
 		t.Parallel()
 
-		client := newGeneratedCatalogItemClient(newTestBrazeClient(t, withTestCatalog(t)))
+		client := newGeneratedCatalogItemClient(newTestBrazeClient(t, withTestCatalog(t)), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 		_, err := client.Read(t.Context(), "centres", "missing-centre")
 
@@ -412,7 +559,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 			server.SetCatalogItem("centres", "airportwest", map[string]json.RawMessage{
 				"name": json.RawMessage(`"Airport West"`),
 			})
-		}))
+		}), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 		actual, err := client.Update(t.Context(), brazeCatalogItemModel{
 			CatalogName: types.StringValue("centres"),
@@ -433,7 +580,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 			server.SetCatalogItem("centres", "airportwest", map[string]json.RawMessage{
 				"name": json.RawMessage(`"Airport West"`),
 			})
-		}))
+		}), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 		err := client.Delete(t.Context(), "centres", "airportwest")
 		require.NoError(t, err)
@@ -460,7 +607,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 
 		client := newGeneratedCatalogItemClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
 			createTestCatalog(t, server)
-			itemClient := newGeneratedCatalogItemClient(serverClient(t, server))
+			itemClient := newGeneratedCatalogItemClient(serverClient(t, server), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 			for i := range 55 {
 				id := fmt.Sprintf("centre%02d", i)
@@ -471,7 +618,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 				})
 				require.NoError(t, err)
 			}
-		}))
+		}), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 		entries, err := client.List(t.Context(), "centres", 55)
 
@@ -486,7 +633,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 
 		client := newGeneratedCatalogItemClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
 			createTestCatalog(t, server)
-			itemClient := newGeneratedCatalogItemClient(serverClient(t, server))
+			itemClient := newGeneratedCatalogItemClient(serverClient(t, server), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 			for i := range 55 {
 				id := fmt.Sprintf("centre%02d", i)
@@ -497,7 +644,7 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 				})
 				require.NoError(t, err)
 			}
-		}))
+		}), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
 
 		entries, err := client.List(t.Context(), "centres", 51)
 
@@ -505,6 +652,303 @@ func TestGeneratedCatalogItemClient(t *testing.T) {
 		require.Len(t, entries, 51)
 		assert.Equal(t, "centres/centre50", entries[50].ID)
 	})
+
+	t.Run("repeated reads are served from a catalog snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		var server *brazeclienttesting.Server
+
+		client := newGeneratedCatalogItemClient(newTestBrazeClient(t, func(s *brazeclienttesting.Server) {
+			server = s
+			createTestCatalog(t, server)
+
+			for i := range 60 {
+				id := fmt.Sprintf("centre%02d", i)
+				server.SetCatalogItem("centres", id, map[string]json.RawMessage{"name": json.RawMessage(strconv.Quote(id))})
+			}
+		}), brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), newCatalogListCache(catalogListCacheTTL))
+
+		for i := range 60 {
+			id := fmt.Sprintf("centre%02d", i)
+
+			actual, err := client.Read(t.Context(), "centres", id)
+			require.NoError(t, err)
+			assert.JSONEq(t, fmt.Sprintf(`{"name":%q}`, id), actual.ValuesJSON.ValueString())
+		}
+
+		assert.Equal(t, 1, server.CallCount(brazeclient.GetCatalogItemOperation))
+		assert.Equal(t, 2, server.CallCount(brazeclient.ListCatalogItemsOperation))
+
+		server.SetCatalogItem("centres", "latecomer", map[string]json.RawMessage{"name": json.RawMessage(`"Latecomer"`)})
+
+		actual, err := client.Read(t.Context(), "centres", "latecomer")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Latecomer"}`, actual.ValuesJSON.ValueString())
+		assert.Equal(t, 2, server.CallCount(brazeclient.GetCatalogItemOperation))
+
+		_, err = client.Update(t.Context(), brazeCatalogItemModel{
+			CatalogName: types.StringValue("centres"),
+			ItemID:      types.StringValue("centre00"),
+			ValuesJSON:  jsontypes.NewNormalizedValue(`{"name":"Updated"}`),
+		})
+		require.NoError(t, err)
+
+		actual, err = client.Read(t.Context(), "centres", "centre00")
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Updated"}`, actual.ValuesJSON.ValueString())
+		assert.Equal(t, 4, server.CallCount(brazeclient.ListCatalogItemsOperation))
+	})
+
+	t.Run("create and delete invalidate cached catalog sizes", func(t *testing.T) {
+		t.Parallel()
+
+		catalogListCache := newCatalogListCache(catalogListCacheTTL)
+		brazeClient := newTestBrazeClient(t, withTestCatalog(t))
+		client := newGeneratedCatalogItemClient(brazeClient, brazeLogPayloadsMetadata, newCatalogItemSnapshots(catalogItemSnapshotTTL), catalogListCache)
+		catalogs := newGeneratedCatalogClient(brazeClient, brazeLogPayloadsMetadata, catalogListCache)
+
+		catalog, err := catalogs.Read(t.Context(), "centres")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), catalog.NumItems.ValueInt64())
+
+		_, err = client.Create(t.Context(), brazeCatalogItemModel{
+			CatalogName: types.StringValue("centres"),
+			ItemID:      types.StringValue("airportwest"),
+			ValuesJSON:  jsontypes.NewNormalizedValue(`{"name":"Airport West"}`),
+		})
+		require.NoError(t, err)
+
+		catalog, err = catalogs.Read(t.Context(), "centres")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), catalog.NumItems.ValueInt64())
+
+		require.NoError(t, client.Delete(t.Context(), "centres", "airportwest"))
+
+		catalog, err = catalogs.Read(t.Context(), "centres")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), catalog.NumItems.ValueInt64())
+	})
+}
+
+func TestGeneratedCampaignClient(t *testing.T) {
+	t.Parallel()
+
+	client := newGeneratedCampaignClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+		server.SetCampaign("campaign-password-reset", "Password reset", []string{"transactional"}, "api_triggered", false)
+		server.SetCampaign("campaign-receipt", "Receipt", []string{"transactional"}, "time_based", true)
+
+		for i := range 150 {
+			server.SetCampaign(fmt.Sprintf("campaign-bulk-%03d", i), fmt.Sprintf("Bulk %03d", i), []string{"bulk"}, "time_based", false)
+		}
+	}), brazeLogPayloadsMetadata)
+
+	archived := true
+	unarchived := false
+
+	tests := map[string]struct {
+		filter      brazeMessagingObjectFilter
+		expectedIDs []string
+	}{
+		"tag across pages": {
+			filter:      brazeMessagingObjectFilter{Tag: "bulk", NameRegex: regexp.MustCompile(`^Bulk 14[5-9]$`)},
+			expectedIDs: []string{"campaign-bulk-145", "campaign-bulk-146", "campaign-bulk-147", "campaign-bulk-148", "campaign-bulk-149"},
+		},
+		"name": {
+			filter:      brazeMessagingObjectFilter{Name: "Receipt"},
+			expectedIDs: []string{"campaign-receipt"},
+		},
+		"archived": {
+			filter:      brazeMessagingObjectFilter{Tag: "transactional", Archived: &archived},
+			expectedIDs: []string{"campaign-receipt"},
+		},
+		"unarchived": {
+			filter:      brazeMessagingObjectFilter{Tag: "transactional", Archived: &unarchived},
+			expectedIDs: []string{"campaign-password-reset"},
+		},
+		"no match": {
+			filter:      brazeMessagingObjectFilter{Tag: "missing"},
+			expectedIDs: []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			test.filter.Concurrency = 4
+
+			actual, err := client.List(t.Context(), test.filter)
+			require.NoError(t, err)
+
+			ids := make([]string, len(actual))
+			for i, object := range actual {
+				ids[i] = object.ID
+			}
+
+			assert.Equal(t, test.expectedIDs, ids)
+		})
+	}
+
+	t.Run("reads details", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := client.List(t.Context(), brazeMessagingObjectFilter{Tag: "transactional"})
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, "Password reset", actual[0].Name)
+		assert.Equal(t, []string{"transactional"}, actual[0].Tags)
+		assert.True(t, actual[0].APITriggered)
+		assert.False(t, actual[0].Archived)
+		assert.False(t, actual[0].LastEdited.IsZero())
+		assert.False(t, actual[1].APITriggered)
+		assert.True(t, actual[1].Archived)
+	})
+}
+
+func TestGeneratedCanvasClient(t *testing.T) {
+	t.Parallel()
+
+	client := newGeneratedCanvasClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+		server.SetCanvas("canvas-onboarding", "Onboarding", []string{"lifecycle"}, "action_based", false)
+		server.SetCanvas("canvas-order-updates", "Order updates", nil, "api_triggered", false)
+	}), brazeLogPayloadsMetadata)
+
+	actual, err := client.List(t.Context(), brazeMessagingObjectFilter{})
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+
+	assert.Equal(t, "canvas-onboarding", actual[0].ID)
+	assert.False(t, actual[0].APITriggered)
+	assert.Equal(t, "canvas-order-updates", actual[1].ID)
+	assert.True(t, actual[1].APITriggered)
+}
+
+func TestGeneratedSegmentClient(t *testing.T) {
+	t.Parallel()
+
+	client := newGeneratedSegmentClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+		server.SetSegment("segment-lapsed", "Lapsed users", "Users who have not opened the app in 30 days", []string{"retention"}, true)
+		server.SetSegment("segment-vip", "VIP", "", []string{"retention", "vip"}, false)
+
+		for i := range 120 {
+			server.SetSegment(fmt.Sprintf("segment-test-%03d", i), fmt.Sprintf("Test %03d", i), "", nil, false)
+		}
+	}), brazeLogPayloadsMetadata)
+
+	tracked := true
+
+	t.Run("filters", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := client.List(t.Context(), brazeSegmentFilter{Tag: "retention", AnalyticsTrackingEnabled: &tracked, Concurrency: 2})
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, "segment-lapsed", actual[0].ID)
+		assert.Equal(t, "Lapsed users", actual[0].Name)
+		require.NotNil(t, actual[0].Description)
+		assert.Equal(t, "Users who have not opened the app in 30 days", *actual[0].Description)
+		assert.True(t, actual[0].AnalyticsTrackingEnabled)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := client.List(t.Context(), brazeSegmentFilter{NameRegex: regexp.MustCompile(`^Test 11[0-9]$`), Concurrency: 4})
+		require.NoError(t, err)
+		require.Len(t, actual, 10)
+
+		assert.Equal(t, "segment-test-110", actual[0].ID)
+		assert.Nil(t, actual[0].Description)
+	})
+
+	t.Run("name", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := client.List(t.Context(), brazeSegmentFilter{Name: "VIP"})
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, []string{"retention", "vip"}, actual[0].Tags)
+	})
+}
+
+func TestGeneratedCustomAttributeClient(t *testing.T) {
+	t.Parallel()
+
+	client := newGeneratedCustomAttributeClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+		server.SetCustomAttribute("favorite_color", "String", "The colour the user picked in onboarding", []string{"onboarding"}, "Active")
+		server.SetCustomAttribute("legacy_score", "Number", "", nil, "Blocklisted")
+
+		for i := range 60 {
+			server.SetCustomAttribute(fmt.Sprintf("test_%03d", i), "Boolean", "", nil, "Active")
+		}
+	}), brazeLogPayloadsMetadata)
+
+	actual, err := client.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, actual, 62)
+
+	assert.Equal(t, "favorite_color", actual[0].Name)
+	require.NotNil(t, actual[0].DataType)
+	assert.Equal(t, "String", *actual[0].DataType)
+	require.NotNil(t, actual[0].Description)
+	assert.Equal(t, "The colour the user picked in onboarding", *actual[0].Description)
+	assert.Equal(t, []string{"onboarding"}, actual[0].Tags)
+
+	assert.Equal(t, "legacy_score", actual[1].Name)
+	assert.Nil(t, actual[1].Description)
+	require.NotNil(t, actual[1].Status)
+	assert.Equal(t, "Blocklisted", *actual[1].Status)
+
+	assert.Equal(t, "test_059", actual[61].Name)
+}
+
+func TestGeneratedCustomEventClient(t *testing.T) {
+	t.Parallel()
+
+	client := newGeneratedCustomEventClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+		server.SetCustomEvent("completed_checkout", "Fired when an order is placed", []string{"commerce"}, "Active")
+		server.SetCustomEvent("legacy_login", "", nil, "Blocklisted")
+
+		for i := range 60 {
+			server.SetCustomEvent(fmt.Sprintf("test_%03d", i), "", nil, "Active")
+		}
+	}), brazeLogPayloadsMetadata)
+
+	actual, err := client.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, actual, 62)
+
+	assert.Equal(t, "completed_checkout", actual[0].Name)
+	require.NotNil(t, actual[0].Description)
+	assert.Equal(t, "Fired when an order is placed", *actual[0].Description)
+	assert.Equal(t, []string{"commerce"}, actual[0].Tags)
+
+	assert.Equal(t, "legacy_login", actual[1].Name)
+	assert.Nil(t, actual[1].Description)
+	require.NotNil(t, actual[1].Status)
+	assert.Equal(t, "Blocklisted", *actual[1].Status)
+
+	assert.Equal(t, "test_059", actual[61].Name)
+}
+
+func TestGeneratedProductClient(t *testing.T) {
+	t.Parallel()
+
+	client := newGeneratedProductClient(newTestBrazeClient(t, func(server *brazeclienttesting.Server) {
+		for i := range brazeProductListPageLimit + 10 {
+			server.SetProduct(fmt.Sprintf("sku-%03d", i))
+		}
+	}), brazeLogPayloadsMetadata)
+
+	actual, err := client.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, actual, brazeProductListPageLimit+10)
+
+	assert.Equal(t, "sku-000", actual[0])
+	assert.Equal(t, "sku-259", actual[len(actual)-1])
 }
 
 func newTestBrazeClient(t *testing.T, configure func(*brazeclienttesting.Server)) *brazeclient.Client {
@@ -547,7 +991,7 @@ func serverClient(t *testing.T, server *brazeclienttesting.Server) *brazeclient.
 func createTestCatalog(t *testing.T, server *brazeclienttesting.Server) {
 	t.Helper()
 
-	client := newGeneratedCatalogClient(serverClient(t, server))
+	client := newGeneratedCatalogClient(serverClient(t, server), brazeLogPayloadsMetadata, newCatalogListCache(catalogListCacheTTL))
 	_, err := client.Create(t.Context(), brazeCatalogModel{
 		Name:        types.StringValue("centres"),
 		Description: types.StringValue("Centre metadata"),
@@ -560,6 +1004,10 @@ func createTestCatalog(t *testing.T, server *brazeclienttesting.Server) {
 				"name": types.StringValue("name"),
 				"type": types.StringValue("string"),
 			}),
+			types.ObjectValueMust(BrazeCatalogFieldObjectType().AttrTypes, map[string]attr.Value{
+				"name": types.StringValue("active"),
+				"type": types.StringValue("boolean"),
+			}),
 		}),
 	})
 	require.NoError(t, err)
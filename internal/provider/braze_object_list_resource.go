@@ -6,11 +6,17 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func emptyBrazeObjectListResults(_ func(list.ListResult) bool) {}
 
 func streamBrazeObjectListError(ctx context.Context, req list.ListRequest, summary string, err error, yield func(list.ListResult) bool) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, summary)
+
 	result := req.NewListResult(ctx)
 	result.Diagnostics.AddError(summary, detailFromError(err))
 
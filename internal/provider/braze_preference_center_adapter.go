@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+type preferenceCenterClient interface {
+	Create(ctx context.Context, plan brazePreferenceCenterModel) (brazePreferenceCenterModel, error)
+	Read(ctx context.Context, id string) (brazePreferenceCenterModel, error)
+	Update(ctx context.Context, plan brazePreferenceCenterModel) (brazePreferenceCenterModel, error)
+	List(ctx context.Context, query brazeObjectListQuery) ([]brazeObjectListEntry[brazePreferenceCenterModel], error)
+}
+
+type generatedPreferenceCenterClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+type preferenceCenterListItem struct {
+	item brazeclient.ListPreferenceCentersResponsePreferenceCentersItem
+}
+
+func newGeneratedPreferenceCenterClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedPreferenceCenterClient {
+	return generatedPreferenceCenterClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads, "preference_center_page_html", "confirmation_page_html", "redirect_page_html"),
+	}
+}
+
+func (c generatedPreferenceCenterClient) Create(ctx context.Context, plan brazePreferenceCenterModel) (brazePreferenceCenterModel, error) {
+	createRequest := plan.ToCreatePreferenceCenterRequest()
+
+	createResponse, createErr := c.client.CreatePreferenceCenter(ctx, &createRequest)
+
+	c.logger.Log(ctx, "braze_preference_center.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
+	})
+
+	createErr = classifyBrazePermissionError(brazeclient.CreatePreferenceCenterOperation, createErr)
+
+	if createErr != nil {
+		return brazePreferenceCenterModel{}, fmt.Errorf("create preference center: %w", createErr)
+	}
+
+	if createResponse == nil {
+		return brazePreferenceCenterModel{}, errBrazeObjectEmptyResponse
+	}
+
+	return c.Read(ctx, createResponse.GetPreferenceCenterAPIID())
+}
+
+func (c generatedPreferenceCenterClient) Read(ctx context.Context, id string) (brazePreferenceCenterModel, error) {
+	getParams := brazeclient.GetPreferenceCenterParams{
+		PreferenceCenterExternalID: id,
+	}
+
+	getResponse, getErr := c.client.GetPreferenceCenter(ctx, getParams)
+
+	c.logger.Log(ctx, "braze_preference_center.read", brazeAPILogEntry{
+		Params:   getParams,
+		Response: getResponse,
+		Err:      getErr,
+	})
+
+	getErr = classifyBrazePermissionError(brazeclient.GetPreferenceCenterOperation, getErr)
+
+	if getErr != nil {
+		return brazePreferenceCenterModel{}, classifyBrazeObjectReadError(getErr)
+	}
+
+	if getResponse == nil {
+		return brazePreferenceCenterModel{}, errBrazeObjectEmptyResponse
+	}
+
+	return NewBrazePreferenceCenterModelFromGetPreferenceCenterResponse(*getResponse), nil
+}
+
+func (c generatedPreferenceCenterClient) Update(ctx context.Context, plan brazePreferenceCenterModel) (brazePreferenceCenterModel, error) {
+	updateRequest := plan.ToUpdatePreferenceCenterRequest()
+	updateParams := brazeclient.UpdatePreferenceCenterParams{
+		PreferenceCenterExternalID: plan.ID.ValueString(),
+	}
+
+	updateResponse, updateErr := c.client.UpdatePreferenceCenter(ctx, &updateRequest, updateParams)
+
+	c.logger.Log(ctx, "braze_preference_center.update", brazeAPILogEntry{
+		Params:   updateParams,
+		Request:  &updateRequest,
+		Response: updateResponse,
+		Err:      updateErr,
+	})
+
+	updateErr = classifyBrazePermissionError(brazeclient.UpdatePreferenceCenterOperation, updateErr)
+
+	if updateErr != nil {
+		return brazePreferenceCenterModel{}, fmt.Errorf("update preference center: %w", classifyBrazeObjectReadError(updateErr))
+	}
+
+	if updateResponse == nil {
+		return brazePreferenceCenterModel{}, errBrazeObjectEmptyResponse
+	}
+
+	return c.Read(ctx, updateResponse.GetPreferenceCenterAPIID())
+}
+
+// List reads every preference center, as Braze does not paginate or filter
+// them, and returns at most query.Limit entries.
+func (c generatedPreferenceCenterClient) List(ctx context.Context, query brazeObjectListQuery) ([]brazeObjectListEntry[brazePreferenceCenterModel], error) {
+	listResponse, listErr := c.client.ListPreferenceCenters(ctx)
+
+	c.logger.Log(ctx, "braze_preference_center.list", brazeAPILogEntry{
+		Response: listResponse,
+		Err:      listErr,
+	})
+
+	listErr = classifyBrazePermissionError(brazeclient.ListPreferenceCentersOperation, listErr)
+
+	if listErr != nil {
+		return nil, fmt.Errorf("list preference centers: %w", listErr)
+	}
+
+	if listResponse == nil {
+		return nil, errBrazeObjectEmptyResponse
+	}
+
+	preferenceCenters := listResponse.GetPreferenceCenters()
+	if int64(len(preferenceCenters)) > query.Limit {
+		preferenceCenters = preferenceCenters[:max(query.Limit, 0)]
+	}
+
+	items := make([]preferenceCenterListItem, len(preferenceCenters))
+	for i, preferenceCenter := range preferenceCenters {
+		items[i] = preferenceCenterListItem{item: preferenceCenter}
+	}
+
+	return buildBrazeObjectListEntries(query, items, func(id string) (brazePreferenceCenterModel, error) {
+		return c.Read(ctx, id)
+	}), nil
+}
+
+func (i preferenceCenterListItem) ListEntry() brazeObjectListEntry[brazePreferenceCenterModel] {
+	return brazeObjectListEntry[brazePreferenceCenterModel]{
+		ID:          i.item.GetPreferenceCenterAPIID(),
+		DisplayName: i.item.GetName(),
+	}
+}
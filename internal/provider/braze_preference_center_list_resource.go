@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+//nolint:ireturn
+func NewBrazePreferenceCenterListResource() list.ListResource {
+	return &brazePreferenceCenterListResource{}
+}
+
+type brazePreferenceCenterListResource struct {
+	providerData brazeProviderData
+}
+
+var (
+	_ list.ListResource              = (*brazePreferenceCenterListResource)(nil)
+	_ list.ListResourceWithConfigure = (*brazePreferenceCenterListResource)(nil)
+)
+
+func (r *brazePreferenceCenterListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preference_center"
+}
+
+func (r *brazePreferenceCenterListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{}
+}
+
+func (r *brazePreferenceCenterListResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazePreferenceCenterListResource) List(ctx context.Context, req list.ListRequest, resp *list.ListResultsStream) {
+	if req.Limit <= 0 {
+		resp.Results = emptyBrazeObjectListResults
+
+		return
+	}
+
+	query := brazeObjectListQuery{
+		Limit:           req.Limit,
+		IncludeResource: req.IncludeResource,
+		Concurrency:     r.providerData.listConcurrency,
+	}
+
+	resp.Results = func(yield func(list.ListResult) bool) {
+		ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_preference_center", "list")
+		defer span.End()
+
+		entries, listErr := r.providerData.preferenceCenters.List(ctx, query)
+		if listErr != nil {
+			streamBrazeObjectListError(ctx, req, "Failed to list preference centers", listErr, yield)
+
+			return
+		}
+
+		streamBrazeObjectListEntries(ctx, req, entries, "id", "Failed to get preference center", yield)
+	}
+}
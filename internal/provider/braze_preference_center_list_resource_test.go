@@ -0,0 +1,83 @@
+package provider_test
+
+import (
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBrazePreferenceCenterList(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetPreferenceCenter("preference-center-id", "test_preference_center", "Preferences", "<p>{{ preference_center_form }}</p>", "<p>Saved</p>", brazeclient.PreferenceCenterStateActive, brazeclient.OptPreferenceCenterOptions{})
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: `
+				provider "braze" {}
+
+				list "braze_preference_center" "test" {
+					provider = braze
+
+					include_resource = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_preference_center.test", "id", "preference-center-id"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "name", "test_preference_center"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "preference_center_title", "Preferences"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "state", "active"),
+				),
+			},
+			{
+				Query: true,
+				Config: `
+				provider "braze" {}
+
+				list "braze_preference_center" "test" {
+					provider = braze
+
+					limit = 1
+				}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("braze_preference_center.test", 1),
+					querycheck.ExpectResourceDisplayName(
+						"braze_preference_center.test",
+						queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+							"id": knownvalue.StringExact("preference-center-id"),
+						}),
+						knownvalue.StringExact("test_preference_center"),
+					),
+				},
+			},
+			{
+				Query: true,
+				Config: `
+				provider "braze" {}
+
+				list "braze_preference_center" "test" {
+					provider = braze
+
+					limit = 0
+				}
+				`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("braze_preference_center.test", 0),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type brazePreferenceCenterModel struct {
+	IDIdentityModel
+
+	Name                     types.String                       `tfsdk:"name"`
+	PreferenceCenterTitle    types.String                       `tfsdk:"preference_center_title"`
+	PreferenceCenterPageHTML types.String                       `tfsdk:"preference_center_page_html"`
+	ConfirmationPageHTML     types.String                       `tfsdk:"confirmation_page_html"`
+	State                    types.String                       `tfsdk:"state"`
+	Options                  *brazePreferenceCenterOptionsModel `tfsdk:"options"`
+}
+
+type brazePreferenceCenterOptionsModel struct {
+	MetaViewportContent types.String                        `tfsdk:"meta_viewport_content"`
+	LinksTags           []brazePreferenceCenterLinkTagModel `tfsdk:"links_tags"`
+}
+
+type brazePreferenceCenterLinkTagModel struct {
+	Rel  types.String `tfsdk:"rel"`
+	Type types.String `tfsdk:"type"`
+	Href types.String `tfsdk:"href"`
+}
+
+func (m *brazePreferenceCenterOptionsModel) toPreferenceCenterOptions() brazeclient.OptPreferenceCenterOptions {
+	if m == nil {
+		return brazeclient.OptPreferenceCenterOptions{}
+	}
+
+	options := brazeclient.PreferenceCenterOptions{
+		MetaViewportContent: brazeclient.NewOptPointerString(m.MetaViewportContent.ValueStringPointer()),
+	}
+
+	for _, tag := range m.LinksTags {
+		options.LinksTags = append(options.LinksTags, brazeclient.PreferenceCenterLinkTag{
+			Rel:  tag.Rel.ValueString(),
+			Type: brazeclient.NewOptPointerString(tag.Type.ValueStringPointer()),
+			Href: tag.Href.ValueString(),
+		})
+	}
+
+	return brazeclient.NewOptPreferenceCenterOptions(options)
+}
+
+func preferenceCenterStateValues() []string {
+	return []string{
+		string(brazeclient.PreferenceCenterStateActive),
+		string(brazeclient.PreferenceCenterStateDraft),
+	}
+}
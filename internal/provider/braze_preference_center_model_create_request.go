@@ -0,0 +1,21 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+func (m brazePreferenceCenterModel) ToCreatePreferenceCenterRequest() brazeclient.CreatePreferenceCenterRequest {
+	req := brazeclient.CreatePreferenceCenterRequest{
+		Name:                     m.Name.ValueString(),
+		PreferenceCenterTitle:    brazeclient.NewOptPointerString(m.PreferenceCenterTitle.ValueStringPointer()),
+		PreferenceCenterPageHTML: m.PreferenceCenterPageHTML.ValueString(),
+		ConfirmationPageHTML:     m.ConfirmationPageHTML.ValueString(),
+		Options:                  m.Options.toPreferenceCenterOptions(),
+	}
+
+	if !m.State.IsNull() && !m.State.IsUnknown() {
+		req.State.SetTo(brazeclient.PreferenceCenterState(m.State.ValueString()))
+	}
+
+	return req
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+//revive:disable:unexported-return
+func NewBrazePreferenceCenterModelFromGetPreferenceCenterResponse(response brazeclient.GetPreferenceCenterResponse) brazePreferenceCenterModel {
+	model := brazePreferenceCenterModel{
+		IDIdentityModel: IDIdentityModel{
+			ID: types.StringValue(response.GetPreferenceCenterAPIID()),
+		},
+
+		Name:                     types.StringValue(response.GetName()),
+		PreferenceCenterTitle:    types.StringPointerValue(response.GetPreferenceCenterTitle().GetPointer()),
+		PreferenceCenterPageHTML: types.StringPointerValue(response.GetPreferenceCenterPageHTML().GetPointer()),
+		ConfirmationPageHTML:     types.StringPointerValue(response.GetConfirmationPageHTML().GetPointer()),
+		State:                    types.StringNull(),
+	}
+
+	if state, ok := response.GetState().Get(); ok {
+		model.State = types.StringValue(string(state))
+	}
+
+	if options, ok := response.GetPreferenceCenterOptions().Get(); ok {
+		model.Options = &brazePreferenceCenterOptionsModel{
+			MetaViewportContent: types.StringPointerValue(options.GetMetaViewportContent().GetPointer()),
+		}
+
+		if options.LinksTags != nil {
+			model.Options.LinksTags = make([]brazePreferenceCenterLinkTagModel, len(options.LinksTags))
+			for i, tag := range options.LinksTags {
+				model.Options.LinksTags[i] = brazePreferenceCenterLinkTagModel{
+					Rel:  types.StringValue(tag.GetRel()),
+					Type: types.StringPointerValue(tag.GetType().GetPointer()),
+					Href: types.StringValue(tag.GetHref()),
+				}
+			}
+		}
+	}
+
+	return model
+}
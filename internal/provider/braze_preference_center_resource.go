@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = (*brazePreferenceCenterResource)(nil)
+	_ resource.ResourceWithConfigure      = (*brazePreferenceCenterResource)(nil)
+	_ resource.ResourceWithIdentity       = (*brazePreferenceCenterResource)(nil)
+	_ resource.ResourceWithImportState    = (*brazePreferenceCenterResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*brazePreferenceCenterResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*brazePreferenceCenterResource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazePreferenceCenterResource() resource.Resource {
+	return &brazePreferenceCenterResource{}
+}
+
+type brazePreferenceCenterResource struct {
+	providerData brazeProviderData
+}
+
+func (r *brazePreferenceCenterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preference_center"
+}
+
+func (r *brazePreferenceCenterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = BrazePreferenceCenterResourceIdentitySchema()
+}
+
+func (r *brazePreferenceCenterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = BrazePreferenceCenterResourceSchema()
+}
+
+func (r *brazePreferenceCenterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazePreferenceCenterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var state types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("state"), &state)...)
+
+	if resp.Diagnostics.HasError() || state.IsNull() || state.IsUnknown() {
+		return
+	}
+
+	if brazeclient.PreferenceCenterState(state.ValueString()).Validate() != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Invalid preference center state",
+			fmt.Sprintf("Braze preference center state must be one of %s. Got %q.", strings.Join(preferenceCenterStateValues(), ", "), state.ValueString()),
+		)
+	}
+}
+
+func (r *brazePreferenceCenterResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "preference center")
+}
+
+func (r *brazePreferenceCenterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *brazePreferenceCenterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_preference_center", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazePreferenceCenterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.preferenceCenters.Create(ctx, plan)
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddError("Preference Center not found after creation", detailFromError(err))
+		} else {
+			resp.Diagnostics.AddError("Failed to create Preference Center", detailFromError(err))
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityAndState(ctx, resp.Identity, &resp.State, data.ID.ValueString(), &data)...)
+}
+
+func (r *brazePreferenceCenterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_preference_center", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var state brazePreferenceCenterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.preferenceCenters.Read(ctx, state.ID.ValueString())
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddWarning("Preference Center not found", detailFromError(err))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Failed to read Preference Center", detailFromError(err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityAndState(ctx, resp.Identity, &resp.State, data.ID.ValueString(), &data)...)
+}
+
+func (r *brazePreferenceCenterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_preference_center", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazePreferenceCenterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.preferenceCenters.Update(ctx, plan)
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddError("Preference Center not found after update", detailFromError(err))
+		} else {
+			resp.Diagnostics.AddError("Failed to update Preference Center", detailFromError(err))
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityAndState(ctx, resp.Identity, &resp.State, data.ID.ValueString(), &data)...)
+}
+
+func (r *brazePreferenceCenterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state brazePreferenceCenterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Preference Center not deleted", fmt.Sprintf("Braze does not provide a delete API for preference centers; resource removed from Terraform state only. The preference center %q remains in Braze.", state.Name.ValueString()))
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func BrazePreferenceCenterResourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func BrazePreferenceCenterResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manage Braze Preference Centers, the pages where users manage their messaging subscriptions. Braze has no API to delete preference centers, so destroying this resource, or replacing it by changing `name`, only removes it from Terraform state and leaves the preference center in Braze.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the preference center. Braze does not allow preference centers to be renamed, so changing it creates a new preference center and leaves the old one in Braze.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preference_center_title": schema.StringAttribute{
+				Description: "The title of the preference center and confirmation pages.",
+				Optional:    true,
+			},
+			"preference_center_page_html": schema.StringAttribute{
+				Description: "The HTML of the preference center page.",
+				Required:    true,
+			},
+			"confirmation_page_html": schema.StringAttribute{
+				Description: "The HTML of the confirmation page shown after preferences are saved.",
+				Required:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Whether the preference center is `active` or a `draft`. When unset, Braze makes new preference centers active.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"options": schema.SingleNestedAttribute{
+				Description: "Options for the HTML head of the preference center page.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"meta_viewport_content": schema.StringAttribute{
+						Description: "The content of the viewport meta tag.",
+						Optional:    true,
+					},
+					"links_tags": schema.ListNestedAttribute{
+						Description: "Link tags to add to the page, for example to load fonts.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"rel": schema.StringAttribute{
+									Description: "The rel attribute of the link tag.",
+									Required:    true,
+								},
+								"type": schema.StringAttribute{
+									Description: "The type attribute of the link tag.",
+									Optional:    true,
+								},
+								"href": schema.StringAttribute{
+									Description: "The href attribute of the link tag.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
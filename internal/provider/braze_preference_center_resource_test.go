@@ -0,0 +1,167 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBrazePreferenceCenter(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	configVariables1 := config.Variables{
+		"preference_center_name": config.StringVariable("test_preference_center"),
+	}
+
+	configVariables2 := config.Variables{
+		"preference_center_name":      config.StringVariable("test_preference_center"),
+		"preference_center_title":     config.StringVariable("Preferences"),
+		"preference_center_page_html": config.StringVariable("<div>{{ preference_center_form }}</div>"),
+		"preference_center_state":     config.StringVariable("draft"),
+		"preference_center_options": config.ObjectVariable(map[string]config.Variable{
+			"meta_viewport_content": config.StringVariable("width=device-width, initial-scale=1"),
+			"links_tags": config.ListVariable(config.ObjectVariable(map[string]config.Variable{
+				"rel":  config.StringVariable("stylesheet"),
+				"type": config.StringVariable("text/css"),
+				"href": config.StringVariable("https://example.com/style.css"),
+			})),
+		}),
+	}
+
+	configVariables3 := config.Variables{
+		"preference_center_name":  config.StringVariable("renamed_preference_center"),
+		"preference_center_state": config.StringVariable("active"),
+	}
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: configVariables1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("braze_preference_center.test", "id"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "name", "test_preference_center"),
+					resource.TestCheckNoResourceAttr("braze_preference_center.test", "preference_center_title"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "preference_center_page_html", "<p>{{ preference_center_form }}</p>"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "confirmation_page_html", "<p>Saved</p>"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "state", "active"),
+					resource.TestCheckNoResourceAttr("braze_preference_center.test", "options"),
+				),
+			},
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: configVariables1,
+				ImportState:     true,
+				ResourceName:    "braze_preference_center.test",
+			},
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: configVariables1,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_preference_center.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: configVariables2,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_preference_center.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_preference_center.test", "preference_center_title", "Preferences"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "preference_center_page_html", "<div>{{ preference_center_form }}</div>"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "state", "draft"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "options.meta_viewport_content", "width=device-width, initial-scale=1"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "options.links_tags.#", "1"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "options.links_tags.0.rel", "stylesheet"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "options.links_tags.0.type", "text/css"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "options.links_tags.0.href", "https://example.com/style.css"),
+				),
+			},
+			{
+				ConfigDirectory: config.TestNameDirectory(),
+				ConfigVariables: configVariables3,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_preference_center.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_preference_center.test", "name", "renamed_preference_center"),
+					resource.TestCheckResourceAttr("braze_preference_center.test", "state", "active"),
+					resource.TestCheckNoResourceAttr("braze_preference_center.test", "options"),
+					expectPreferenceCenterNames(server, "renamed_preference_center", "test_preference_center"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBrazePreferenceCenterInvalidState(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazePreferenceCenter"),
+				ConfigVariables: config.Variables{
+					"preference_center_state": config.StringVariable("archived"),
+				},
+				ExpectError: regexp.MustCompile("Invalid preference center state"),
+			},
+		},
+	})
+}
+
+func TestAccBrazePreferenceCenterCreateNameInvalid(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazePreferenceCenter"),
+				ConfigVariables: config.Variables{
+					"preference_center_name": config.StringVariable("not a valid name"),
+				},
+				ExpectError: regexp.MustCompile("Failed to create Preference Center"),
+			},
+		},
+	})
+}
+
+// expectPreferenceCenterNames checks the preference centers held by the
+// server, which keeps those that were only removed from Terraform state.
+func expectPreferenceCenterNames(server *brazeclienttesting.Server, expected ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		names := []string{}
+		for _, preferenceCenter := range server.Fixture().PreferenceCenters {
+			names = append(names, preferenceCenter.Name)
+		}
+
+		slices.Sort(names)
+
+		if !slices.Equal(names, expected) {
+			//nolint:err113
+			return fmt.Errorf("expected preference centers %v, got %v", expected, names)
+		}
+
+		return nil
+	}
+}
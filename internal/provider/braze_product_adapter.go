@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+// brazeProductListPageLimit is the number of products Braze returns on each
+// page of the product list.
+const brazeProductListPageLimit = 250
+
+type productClient interface {
+	List(ctx context.Context) ([]string, error)
+}
+
+type generatedProductClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedProductClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedProductClient {
+	return generatedProductClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+	}
+}
+
+// List reads every page of product IDs. The product list is paged by number
+// rather than by cursor, so a short page marks the end of the list.
+func (c generatedProductClient) List(ctx context.Context) ([]string, error) {
+	products := []string{}
+
+	for page := 0; ; page++ {
+		params := brazeclient.ListProductsParams{
+			Page: brazeclient.NewOptInt(page),
+		}
+
+		listResponse, listErr := c.client.ListProducts(ctx, params)
+
+		c.logger.Log(ctx, "braze_products.list", brazeAPILogEntry{
+			Params:   params,
+			Response: listResponse,
+			Err:      listErr,
+		})
+
+		listErr = classifyBrazePermissionError(brazeclient.ListProductsOperation, listErr)
+
+		if listErr != nil {
+			return nil, fmt.Errorf("list products: %w", listErr)
+		}
+
+		if listResponse == nil {
+			return nil, errBrazeObjectEmptyResponse
+		}
+
+		items := listResponse.GetProducts()
+		products = append(products, items...)
+
+		if len(items) < brazeProductListPageLimit {
+			return products, nil
+		}
+	}
+}
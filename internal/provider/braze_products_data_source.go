@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = (*brazeProductsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*brazeProductsDataSource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeProductsDataSource() datasource.DataSource {
+	return &brazeProductsDataSource{}
+}
+
+type brazeProductsDataSource struct {
+	providerData brazeProviderData
+}
+
+type brazeProductsDataSourceModel struct {
+	Products []brazeProductModel `tfsdk:"products"`
+}
+
+type brazeProductModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *brazeProductsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_products"
+}
+
+func (d *brazeProductsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the products that purchases have been logged for in the workspace. Braze records only the product ID of each product, so products have no type, description, tags or status.",
+		Attributes: map[string]schema.Attribute{
+			"products": schema.ListNestedAttribute{
+				Description: "The products, in the order Braze lists them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The product ID logged with purchases of the product.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *brazeProductsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(SetProviderDataFromDataSourceConfigureRequest(req, &d.providerData)...)
+}
+
+func (d *brazeProductsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, d.providerData.tracer, "braze_products", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var config brazeProductsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	products, err := d.providerData.products.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list products", detailFromError(err))
+
+		return
+	}
+
+	config.Products = make([]brazeProductModel, len(products))
+	for i, product := range products {
+		config.Products[i] = brazeProductModel{
+			Name: types.StringValue(product),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
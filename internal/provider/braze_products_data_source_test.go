@@ -0,0 +1,31 @@
+package provider_test
+
+import (
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeProductsDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetProduct("sku-gift-card")
+	server.SetProduct("sku-annual-plan")
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_products" "test" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braze_products.test", "products.#", "2"),
+					resource.TestCheckResourceAttr("data.braze_products.test", "products.0.name", "sku-annual-plan"),
+					resource.TestCheckResourceAttr("data.braze_products.test", "products.1.name", "sku-gift-card"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,26 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeProviderMissingPermission(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetAPIKeyPermissions("12345", "content_blocks.info")
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazeContentBlock"),
+				ExpectError:     regexp.MustCompile(`content_blocks\.create permission`),
+			},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type brazeProviderAPIKeysModel struct {
+	Campaigns      types.String `tfsdk:"campaigns"`
+	Canvases       types.String `tfsdk:"canvases"`
+	Catalogs       types.String `tfsdk:"catalogs"`
+	ContentBlocks  types.String `tfsdk:"content_blocks"`
+	EmailTemplates types.String `tfsdk:"email_templates"`
+	Events         types.String `tfsdk:"events"`
+	Messages       types.String `tfsdk:"messages"`
+
+	CustomAttributes  types.String `tfsdk:"custom_attributes"`
+	PreferenceCenters types.String `tfsdk:"preference_centers"`
+	Purchases         types.String `tfsdk:"purchases"`
+	Segments          types.String `tfsdk:"segments"`
+	Sends             types.String `tfsdk:"sends"`
+}
+
+func brazeProviderAPIKeysSchemaBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "REST API keys to use for individual families of Braze operations, for keys issued with only the permissions of one family. Operations whose family has no key here use api_key.",
+		Attributes: map[string]schema.Attribute{
+			brazeAPIKeyFamilyCampaigns: schema.StringAttribute{
+				Description: "The REST API key to use for campaigns. If not provided, it will default to the value of the BRAZE_CAMPAIGNS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyCanvases: schema.StringAttribute{
+				Description: "The REST API key to use for Canvases. If not provided, it will default to the value of the BRAZE_CANVASES_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyCatalogs: schema.StringAttribute{
+				Description: "The REST API key to use for catalogs and catalog items. If not provided, it will default to the value of the BRAZE_CATALOGS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyContentBlocks: schema.StringAttribute{
+				Description: "The REST API key to use for content blocks. If not provided, it will default to the value of the BRAZE_CONTENT_BLOCKS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyCustomAttributes: schema.StringAttribute{
+				Description: "The REST API key to use for custom attributes. If not provided, it will default to the value of the BRAZE_CUSTOM_ATTRIBUTES_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyEmailTemplates: schema.StringAttribute{
+				Description: "The REST API key to use for email templates. If not provided, it will default to the value of the BRAZE_EMAIL_TEMPLATES_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyEvents: schema.StringAttribute{
+				Description: "The REST API key to use for custom events. If not provided, it will default to the value of the BRAZE_EVENTS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyMessages: schema.StringAttribute{
+				Description: "The REST API key to use for sending and scheduling messages. If not provided, it will default to the value of the BRAZE_MESSAGES_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyPreferenceCenters: schema.StringAttribute{
+				Description: "The REST API key to use for preference centers. If not provided, it will default to the value of the BRAZE_PREFERENCE_CENTERS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilyPurchases: schema.StringAttribute{
+				Description: "The REST API key to use for products. If not provided, it will default to the value of the BRAZE_PURCHASES_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilySegments: schema.StringAttribute{
+				Description: "The REST API key to use for segments. If not provided, it will default to the value of the BRAZE_SEGMENTS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			brazeAPIKeyFamilySends: schema.StringAttribute{
+				Description: "The REST API key to use for send identifiers. If not provided, it will default to the value of the BRAZE_SENDS_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// familyTokens returns the configured key for each family, falling back to
+// the family's environment variable. m may be nil when the block is absent.
+func (m *brazeProviderAPIKeysModel) familyTokens() map[string]string {
+	var model brazeProviderAPIKeysModel
+	if m != nil {
+		model = *m
+	}
+
+	families := []struct {
+		family     string
+		configured types.String
+		envVar     string
+	}{
+		{brazeAPIKeyFamilyCampaigns, model.Campaigns, "BRAZE_CAMPAIGNS_API_KEY"},
+		{brazeAPIKeyFamilyCanvases, model.Canvases, "BRAZE_CANVASES_API_KEY"},
+		{brazeAPIKeyFamilyCatalogs, model.Catalogs, "BRAZE_CATALOGS_API_KEY"},
+		{brazeAPIKeyFamilyContentBlocks, model.ContentBlocks, "BRAZE_CONTENT_BLOCKS_API_KEY"},
+		{brazeAPIKeyFamilyCustomAttributes, model.CustomAttributes, "BRAZE_CUSTOM_ATTRIBUTES_API_KEY"},
+		{brazeAPIKeyFamilyEmailTemplates, model.EmailTemplates, "BRAZE_EMAIL_TEMPLATES_API_KEY"},
+		{brazeAPIKeyFamilyEvents, model.Events, "BRAZE_EVENTS_API_KEY"},
+		{brazeAPIKeyFamilyMessages, model.Messages, "BRAZE_MESSAGES_API_KEY"},
+		{brazeAPIKeyFamilyPreferenceCenters, model.PreferenceCenters, "BRAZE_PREFERENCE_CENTERS_API_KEY"},
+		{brazeAPIKeyFamilyPurchases, model.Purchases, "BRAZE_PURCHASES_API_KEY"},
+		{brazeAPIKeyFamilySegments, model.Segments, "BRAZE_SEGMENTS_API_KEY"},
+		{brazeAPIKeyFamilySends, model.Sends, "BRAZE_SENDS_API_KEY"},
+	}
+
+	tokens := map[string]string{}
+
+	for _, f := range families {
+		token := f.configured.ValueString()
+		if f.configured.IsNull() {
+			token = os.Getenv(f.envVar)
+		}
+
+		if token != "" {
+			tokens[f.family] = token
+		}
+	}
+
+	return tokens
+}
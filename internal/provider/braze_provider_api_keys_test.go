@@ -0,0 +1,36 @@
+package provider_test
+
+import (
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeProviderScopedAPIKey(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetAPIKeyPermissions("12345")
+	server.SetAPIKeyPermissions("content-blocks-key", "content_blocks.list", "content_blocks.info", "content_blocks.create", "content_blocks.update")
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "braze" {
+  api_keys {
+    content_blocks = "content-blocks-key"
+  }
+}
+
+resource "braze_content_block" "test" {
+  name    = "test-content-block"
+  content = "lorem ipsum"
+}
+`,
+				Check: resource.TestCheckResourceAttr("braze_content_block.test", "name", "test-content-block"),
+			},
+		},
+	})
+}
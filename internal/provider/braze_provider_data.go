@@ -1,8 +1,34 @@
 package provider
 
+import "go.opentelemetry.io/otel/trace"
+
 type brazeProviderData struct {
 	contentBlocks  contentBlockClient
 	emailTemplates emailTemplateClient
 	catalogs       catalogClient
 	catalogItems   catalogItemClient
+
+	preferenceCenters preferenceCenterClient
+	dashboardUsers    dashboardUserClient
+
+	campaigns campaignClient
+	canvases  canvasClient
+	segments  segmentClient
+
+	customAttributes customAttributeClient
+	customEvents     customEventClient
+	products         productClient
+
+	scheduledMessages        scheduledMessageClient
+	campaignTriggerSchedules campaignTriggerScheduleClient
+	canvasTriggerSchedules   canvasTriggerScheduleClient
+	sendIDs                  sendIDClient
+
+	tracer trace.Tracer
+
+	listConcurrency int
+	readOnly        bool
+
+	catalogListCache     *catalogListCache
+	catalogItemSnapshots *catalogItemSnapshots
 }
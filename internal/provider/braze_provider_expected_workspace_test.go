@@ -0,0 +1,92 @@
+package provider_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeProviderExpectedWorkspace(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCatalog("terraform_workspace", "production", []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}})
+
+	configForWorkspace := func(workspace string) string {
+		return `
+provider "braze" {
+  expected_workspace = "` + workspace + `"
+}
+
+resource "braze_content_block" "test" {
+  name    = "test-content-block"
+  content = "lorem ipsum"
+}
+`
+	}
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      configForWorkspace("staging"),
+				ExpectError: regexp.MustCompile(`Unexpected Braze workspace`),
+			},
+			{
+				Config: configForWorkspace("production"),
+				Check:  resource.TestCheckResourceAttr("braze_content_block.test", "name", "test-content-block"),
+			},
+		},
+	})
+}
+
+func TestAccBrazeProviderExpectedWorkspaceAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	sentinelFields := []brazeclient.CatalogField{{Name: "id", Type: brazeclient.CatalogFieldTypeString}}
+
+	production, _ := brazeclienttesting.NewBrazeServer()
+	production.SetCatalog("terraform_workspace", "production", sentinelFields)
+
+	staging, _ := brazeclienttesting.NewBrazeServer()
+	staging.SetCatalog("terraform_workspace", "staging", sentinelFields)
+
+	workspaces := brazeclienttesting.NewWorkspacesByAPIKey(map[string]http.Handler{
+		"12345":                 production,
+		"production-blocks-key": production,
+		"staging-blocks-key":    staging,
+	})
+
+	configForContentBlocksKey := func(apiKey string) string {
+		return `
+provider "braze" {
+  expected_workspace = "production"
+
+  api_keys {
+    content_blocks = "` + apiKey + `"
+  }
+}
+
+resource "braze_content_block" "test" {
+  name    = "test-content-block"
+  content = "lorem ipsum"
+}
+`
+	}
+
+	BrazeProviderMockedResourceTest(t, workspaces, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      configForContentBlocksKey("staging-blocks-key"),
+				ExpectError: regexp.MustCompile(`api_keys\.content_blocks: braze workspace does not match`),
+			},
+			{
+				Config: configForContentBlocksKey("production-blocks-key"),
+				Check:  resource.TestCheckResourceAttr("braze_content_block.test", "name", "test-content-block"),
+			},
+		},
+	})
+}
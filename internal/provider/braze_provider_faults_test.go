@@ -0,0 +1,130 @@
+package provider_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBrazeContentBlockRetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	server.AddFault(brazeclient.CreateContentBlockOperation, brazeclienttesting.Fault{Times: 2, StatusCode: http.StatusServiceUnavailable})
+	server.AddFault(brazeclient.GetContentBlockInfoOperation, brazeclienttesting.Fault{Times: 1, StatusCode: http.StatusInternalServerError})
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazeContentBlock"),
+				ConfigVariables: config.Variables{
+					"content_block_name": config.StringVariable("test-content-block"),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_content_block.test", "name", "test-content-block"),
+					expectFaultsConsumed(server, brazeclient.CreateContentBlockOperation),
+					expectFaultsConsumed(server, brazeclient.GetContentBlockInfoOperation),
+					expectCallCount(server, brazeclient.CreateContentBlockOperation, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBrazeContentBlockRetriesRateLimitedRequests(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	server.SetRateLimit(2, time.Second)
+	server.AddFault(brazeclient.UpdateContentBlockOperation, brazeclienttesting.Fault{Times: 1, StatusCode: http.StatusTooManyRequests, RetryAfter: time.Second})
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazeContentBlock"),
+				ConfigVariables: config.Variables{
+					"content_block_content": config.StringVariable("lorem ipsum"),
+				},
+				Check: resource.TestCheckResourceAttr("braze_content_block.test", "content", "lorem ipsum"),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazeContentBlock"),
+				ConfigVariables: config.Variables{
+					"content_block_content": config.StringVariable("dolor sit amet"),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_content_block.test", "content", "dolor sit amet"),
+					expectFaultsConsumed(server, brazeclient.UpdateContentBlockOperation),
+					expectCallCount(server, brazeclient.UpdateContentBlockOperation, 1),
+				),
+			},
+		},
+	})
+}
+
+// An update whose response is lost after Braze has applied it is retried, and
+// the retry is harmless because updates are idempotent.
+func TestAccBrazeContentBlockUpdateRetriedAfterDroppedResponse(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazeContentBlock"),
+				ConfigVariables: config.Variables{
+					"content_block_content": config.StringVariable("lorem ipsum"),
+				},
+			},
+			{
+				PreConfig: func() {
+					server.AddFault(brazeclient.UpdateContentBlockOperation, brazeclienttesting.Fault{Times: 1, DropAfterHandle: true})
+				},
+				ConfigDirectory: config.StaticDirectory("testdata/TestAccBrazeContentBlock"),
+				ConfigVariables: config.Variables{
+					"content_block_content": config.StringVariable("dolor sit amet"),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_content_block.test", "content", "dolor sit amet"),
+					expectFaultsConsumed(server, brazeclient.UpdateContentBlockOperation),
+					expectCallCount(server, brazeclient.UpdateContentBlockOperation, 2),
+					expectCallCount(server, brazeclient.CreateContentBlockOperation, 1),
+				),
+			},
+		},
+	})
+}
+
+func expectCallCount(server *brazeclienttesting.Server, operation brazeclient.OperationName, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if count := server.CallCount(operation); count != expected {
+			//nolint:err113
+			return fmt.Errorf("expected %d %s calls, got %d", expected, operation, count)
+		}
+
+		return nil
+	}
+}
+
+// expectFaultsConsumed checks that every fault added for the operation was
+// applied, so that a passing test shows the provider retried through them.
+func expectFaultsConsumed(server *brazeclienttesting.Server, operation brazeclient.OperationName) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if pending := server.PendingFaults(operation); pending != 0 {
+			//nolint:err113
+			return fmt.Errorf("expected all %s faults to be applied, %d pending", operation, pending)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,33 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeProviderReadOnly(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "braze" {
+  read_only = true
+}
+
+resource "braze_content_block" "test" {
+  name    = "test-content-block"
+  content = "lorem ipsum"
+}
+`,
+				ExpectError: regexp.MustCompile(`Braze provider is read-only`),
+			},
+		},
+	})
+}
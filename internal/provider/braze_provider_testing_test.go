@@ -26,6 +26,20 @@ func brazeProviderMockableResourceTest(t *testing.T, handler http.Handler, alway
 	t.Helper()
 
 	switch {
+	case !alwaysMock && (os.Getenv("TF_ACC_RECORD") != "" || os.Getenv("TF_ACC_REPLAY") != ""):
+		if testcase.ProtoV6ProviderFactories != nil {
+			t.Fatal("tc.ProtoV6ProviderFactories must be nil")
+		}
+
+		transport, baseURL, apiKey, _ := BrazeCassetteTransport(t)
+
+		testcase.ProtoV6ProviderFactories = makeTestAccProtoV6ProviderFactories(
+			WithBaseURL(baseURL),
+			WithAPIKey(apiKey),
+			WithHTTPClient(&http.Client{Transport: transport}),
+		)
+		resource.Test(t, testcase)
+
 	case alwaysMock || os.Getenv("TF_ACC_MOCKED") != "":
 		if testcase.ProtoV6ProviderFactories != nil {
 			t.Fatal("tc.ProtoV6ProviderFactories must be nil")
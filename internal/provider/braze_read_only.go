@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// addBrazeReadOnlyPlanError reports a planned change to a resource as an
+// error when the provider is read-only, so that the change is refused at plan
+// time rather than part way through an apply.
+func addBrazeReadOnlyPlanError(req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, readOnly bool, typeName string) {
+	if !readOnly {
+		return
+	}
+
+	var action string
+
+	switch {
+	case req.Plan.Raw.IsNull():
+		action = "destroy"
+	case req.State.Raw.IsNull():
+		action = "create"
+	case !req.Plan.Raw.Equal(req.State.Raw):
+		action = "update"
+	default:
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Braze provider is read-only",
+		"The plan would "+action+" this "+typeName+", but the provider is configured with read_only, so changes to Braze are refused.",
+	)
+}
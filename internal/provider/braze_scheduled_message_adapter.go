@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type scheduledMessageClient interface {
+	Create(ctx context.Context, plan brazeScheduledMessageModel) (brazeScheduledMessageModel, error)
+	Update(ctx context.Context, plan brazeScheduledMessageModel) (brazeScheduledMessageModel, error)
+	Delete(ctx context.Context, scheduleID string) error
+}
+
+type generatedScheduledMessageClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedScheduledMessageClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedScheduledMessageClient {
+	return generatedScheduledMessageClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads, "external_user_ids", "messages"),
+	}
+}
+
+// Create schedules the message. Braze cannot read scheduled messages back, so
+// the result is the plan with the identifiers Braze assigned.
+func (c generatedScheduledMessageClient) Create(ctx context.Context, plan brazeScheduledMessageModel) (brazeScheduledMessageModel, error) {
+	createRequest, err := plan.ToCreateScheduledMessageRequest()
+	if err != nil {
+		return brazeScheduledMessageModel{}, err
+	}
+
+	createResponse, createErr := c.client.CreateScheduledMessage(ctx, &createRequest)
+
+	c.logger.Log(ctx, "braze_scheduled_message.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
+	})
+
+	createErr = classifyBrazePermissionError(brazeclient.CreateScheduledMessageOperation, createErr)
+
+	if createErr != nil {
+		return brazeScheduledMessageModel{}, fmt.Errorf("create scheduled message: %w", createErr)
+	}
+
+	if createResponse == nil {
+		return brazeScheduledMessageModel{}, errBrazeObjectEmptyResponse
+	}
+
+	plan.ScheduleID = types.StringValue(createResponse.GetScheduleID())
+	plan.DispatchID = types.StringPointerValue(createResponse.GetDispatchID().GetPointer())
+
+	return plan, nil
+}
+
+func (c generatedScheduledMessageClient) Update(ctx context.Context, plan brazeScheduledMessageModel) (brazeScheduledMessageModel, error) {
+	updateRequest, err := plan.ToUpdateScheduledMessageRequest()
+	if err != nil {
+		return brazeScheduledMessageModel{}, err
+	}
+
+	updateResponse, updateErr := c.client.UpdateScheduledMessage(ctx, &updateRequest)
+
+	c.logger.Log(ctx, "braze_scheduled_message.update", brazeAPILogEntry{
+		Request:  &updateRequest,
+		Response: updateResponse,
+		Err:      updateErr,
+	})
+
+	updateErr = classifyBrazePermissionError(brazeclient.UpdateScheduledMessageOperation, updateErr)
+
+	if updateErr != nil {
+		return brazeScheduledMessageModel{}, fmt.Errorf("update scheduled message: %w", classifyBrazeObjectReadError(updateErr))
+	}
+
+	return plan, nil
+}
+
+func (c generatedScheduledMessageClient) Delete(ctx context.Context, scheduleID string) error {
+	deleteRequest := brazeclient.DeleteScheduledMessageRequest{
+		ScheduleID: scheduleID,
+	}
+
+	deleteResponse, deleteErr := c.client.DeleteScheduledMessage(ctx, &deleteRequest)
+
+	c.logger.Log(ctx, "braze_scheduled_message.delete", brazeAPILogEntry{
+		Request:  &deleteRequest,
+		Response: deleteResponse,
+		Err:      deleteErr,
+	})
+
+	deleteErr = classifyBrazePermissionError(brazeclient.DeleteScheduledMessageOperation, deleteErr)
+
+	if deleteErr != nil {
+		return fmt.Errorf("delete scheduled message: %w", classifyBrazeObjectReadError(deleteErr))
+	}
+
+	return nil
+}
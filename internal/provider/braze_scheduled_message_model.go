@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/go-faster/jx"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// brazeEarliestUTCOffset is the offset of the earliest time zone, in which
+// Braze starts sending messages scheduled in local time.
+const brazeEarliestUTCOffset = 14 * time.Hour
+
+var errScheduledMessageJSONNotObject = errors.New("must be a JSON object")
+
+type brazeScheduledMessageModel struct {
+	ScheduleID      types.String                `tfsdk:"schedule_id"`
+	DispatchID      types.String                `tfsdk:"dispatch_id"`
+	Broadcast       types.Bool                  `tfsdk:"broadcast"`
+	ExternalUserIDs TypedList[types.String]     `tfsdk:"external_user_ids"`
+	SegmentID       types.String                `tfsdk:"segment_id"`
+	AudienceJSON    jsontypes.Normalized        `tfsdk:"audience_json"`
+	CampaignID      types.String                `tfsdk:"campaign_id"`
+	Schedule        brazeMessageScheduleModel   `tfsdk:"schedule"`
+	Messages        brazeScheduledMessagesModel `tfsdk:"messages"`
+}
+
+type brazeMessageScheduleModel struct {
+	Time          timetypes.RFC3339 `tfsdk:"time"`
+	InLocalTime   types.Bool        `tfsdk:"in_local_time"`
+	AtOptimalTime types.Bool        `tfsdk:"at_optimal_time"`
+}
+
+type brazeScheduledMessagesModel struct {
+	AndroidPushJSON jsontypes.Normalized `tfsdk:"android_push_json"`
+	ApplePushJSON   jsontypes.Normalized `tfsdk:"apple_push_json"`
+	ContentCardJSON jsontypes.Normalized `tfsdk:"content_card_json"`
+	EmailJSON       jsontypes.Normalized `tfsdk:"email_json"`
+	SMSJSON         jsontypes.Normalized `tfsdk:"sms_json"`
+	WebPushJSON     jsontypes.Normalized `tfsdk:"web_push_json"`
+	WebhookJSON     jsontypes.Normalized `tfsdk:"webhook_json"`
+}
+
+func (m brazeScheduledMessageModel) ToCreateScheduledMessageRequest() (brazeclient.CreateScheduledMessageRequest, error) {
+	schedule, err := m.Schedule.toMessageSchedule()
+	if err != nil {
+		return brazeclient.CreateScheduledMessageRequest{}, err
+	}
+
+	messages, err := m.Messages.toScheduledMessages()
+	if err != nil {
+		return brazeclient.CreateScheduledMessageRequest{}, err
+	}
+
+	request := brazeclient.CreateScheduledMessageRequest{
+		Broadcast:       brazeclient.NewOptPointerBool(m.Broadcast.ValueBoolPointer()),
+		ExternalUserIds: TypedListToStringSlice(m.ExternalUserIDs),
+		SegmentID:       brazeclient.NewOptPointerString(m.SegmentID.ValueStringPointer()),
+		CampaignID:      brazeclient.NewOptPointerString(m.CampaignID.ValueStringPointer()),
+		Schedule:        schedule,
+		Messages:        messages,
+	}
+
+	audience, err := jsonObjectFromNormalized(m.AudienceJSON, "audience_json")
+	if err != nil {
+		return brazeclient.CreateScheduledMessageRequest{}, err
+	}
+
+	if audience != nil {
+		request.Audience = brazeclient.NewOptConnectedAudience(audience)
+	}
+
+	return request, nil
+}
+
+func (m brazeScheduledMessageModel) ToUpdateScheduledMessageRequest() (brazeclient.UpdateScheduledMessageRequest, error) {
+	schedule, err := m.Schedule.toMessageSchedule()
+	if err != nil {
+		return brazeclient.UpdateScheduledMessageRequest{}, err
+	}
+
+	messages, err := m.Messages.toScheduledMessages()
+	if err != nil {
+		return brazeclient.UpdateScheduledMessageRequest{}, err
+	}
+
+	return brazeclient.UpdateScheduledMessageRequest{
+		ScheduleID: m.ScheduleID.ValueString(),
+		Schedule:   brazeclient.NewOptMessageSchedule(schedule),
+		Messages:   brazeclient.NewOptScheduledMessages(messages),
+	}, nil
+}
+
+func (m brazeMessageScheduleModel) toMessageSchedule() (brazeclient.MessageSchedule, error) {
+	scheduleTime, err := time.Parse(time.RFC3339, m.Time.ValueString())
+	if err != nil {
+		return brazeclient.MessageSchedule{}, fmt.Errorf("parse schedule time: %w", err)
+	}
+
+	return brazeclient.MessageSchedule{
+		Time:          scheduleTime,
+		InLocalTime:   brazeclient.NewOptPointerBool(m.InLocalTime.ValueBoolPointer()),
+		AtOptimalTime: brazeclient.NewOptPointerBool(m.AtOptimalTime.ValueBoolPointer()),
+	}, nil
+}
+
+// sendsFrom returns when Braze starts sending the message. Messages sent in
+// local time or at each user's optimal time start sending when their time,
+// or for optimal time the start of their day, is reached in the earliest
+// time zone.
+func (m brazeMessageScheduleModel) sendsFrom() (time.Time, bool) {
+	if m.Time.IsNull() || m.Time.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	scheduleTime, err := time.Parse(time.RFC3339, m.Time.ValueString())
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	switch {
+	case m.AtOptimalTime.ValueBool():
+		year, month, day := scheduleTime.Date()
+
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Add(-brazeEarliestUTCOffset), true
+	case m.InLocalTime.ValueBool():
+		year, month, day := scheduleTime.Date()
+		hour, minute, second := scheduleTime.Clock()
+
+		return time.Date(year, month, day, hour, minute, second, 0, time.UTC).Add(-brazeEarliestUTCOffset), true
+	default:
+		return scheduleTime, true
+	}
+}
+
+// sent reports whether Braze has started sending the message by now, after
+// which it can no longer be updated or cancelled.
+func (m brazeMessageScheduleModel) sent(now time.Time) bool {
+	sendsFrom, ok := m.sendsFrom()
+
+	return ok && !now.Before(sendsFrom)
+}
+
+func (m brazeScheduledMessagesModel) toScheduledMessages() (brazeclient.ScheduledMessages, error) {
+	messages := brazeclient.ScheduledMessages{}
+
+	for _, channel := range []struct {
+		attribute string
+		value     jsontypes.Normalized
+		payload   *brazeclient.OptScheduledMessagePayload
+	}{
+		{"android_push_json", m.AndroidPushJSON, &messages.AndroidPush},
+		{"apple_push_json", m.ApplePushJSON, &messages.ApplePush},
+		{"content_card_json", m.ContentCardJSON, &messages.ContentCard},
+		{"email_json", m.EmailJSON, &messages.Email},
+		{"sms_json", m.SMSJSON, &messages.SMS},
+		{"web_push_json", m.WebPushJSON, &messages.WebPush},
+		{"webhook_json", m.WebhookJSON, &messages.Webhook},
+	} {
+		payload, err := jsonObjectFromNormalized(channel.value, channel.attribute)
+		if err != nil {
+			return brazeclient.ScheduledMessages{}, err
+		}
+
+		if payload != nil {
+			channel.payload.SetTo(payload)
+		}
+	}
+
+	return messages, nil
+}
+
+func (m brazeScheduledMessagesModel) empty() bool {
+	for _, value := range []jsontypes.Normalized{
+		m.AndroidPushJSON,
+		m.ApplePushJSON,
+		m.ContentCardJSON,
+		m.EmailJSON,
+		m.SMSJSON,
+		m.WebPushJSON,
+		m.WebhookJSON,
+	} {
+		if !value.IsNull() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// jsonObjectFromNormalized parses a JSON object attribute, returning nil when
+// the attribute is null.
+func jsonObjectFromNormalized(value jsontypes.Normalized, attribute string) (map[string]jx.Raw, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil //nolint:nilnil
+	}
+
+	var fields map[string]json.RawMessage
+
+	err := json.Unmarshal([]byte(value.ValueString()), &fields)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", attribute, err)
+	}
+
+	if fields == nil {
+		return nil, fmt.Errorf("%s %w", attribute, errScheduledMessageJSONNotObject)
+	}
+
+	object := make(map[string]jx.Raw, len(fields))
+	for key, field := range fields {
+		object[key] = jx.Raw(field)
+	}
+
+	return object, nil
+}
@@ -0,0 +1,112 @@
+//nolint:testpackage
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrazeMessageScheduleSent(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2030, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		schedule brazeMessageScheduleModel
+		expected bool
+	}{
+		"future": {
+			schedule: brazeMessageScheduleModel{Time: timetypes.NewRFC3339ValueMust("2030-03-10T13:00:00Z")},
+			expected: false,
+		},
+		"past": {
+			schedule: brazeMessageScheduleModel{Time: timetypes.NewRFC3339ValueMust("2030-03-10T11:00:00Z")},
+			expected: true,
+		},
+		"past with offset": {
+			schedule: brazeMessageScheduleModel{Time: timetypes.NewRFC3339ValueMust("2030-03-10T13:00:00+02:00")},
+			expected: true,
+		},
+		"local time reached in earliest time zone": {
+			schedule: brazeMessageScheduleModel{
+				Time:        timetypes.NewRFC3339ValueMust("2030-03-11T01:00:00Z"),
+				InLocalTime: types.BoolValue(true),
+			},
+			expected: true,
+		},
+		"local time not yet reached anywhere": {
+			schedule: brazeMessageScheduleModel{
+				Time:        timetypes.NewRFC3339ValueMust("2030-03-11T03:00:00-08:00"),
+				InLocalTime: types.BoolValue(true),
+			},
+			expected: false,
+		},
+		"optimal time day started in earliest time zone": {
+			schedule: brazeMessageScheduleModel{
+				Time:          timetypes.NewRFC3339ValueMust("2030-03-11T20:00:00Z"),
+				AtOptimalTime: types.BoolValue(true),
+			},
+			expected: true,
+		},
+		"optimal time day not yet started": {
+			schedule: brazeMessageScheduleModel{
+				Time:          timetypes.NewRFC3339ValueMust("2030-03-12T00:00:00Z"),
+				AtOptimalTime: types.BoolValue(true),
+			},
+			expected: false,
+		},
+		"unknown": {
+			schedule: brazeMessageScheduleModel{Time: timetypes.NewRFC3339Unknown()},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.schedule.sent(now))
+		})
+	}
+}
+
+func TestBrazeScheduledMessageModelToCreateRequest(t *testing.T) {
+	t.Parallel()
+
+	model := brazeScheduledMessageModel{
+		Broadcast:    types.BoolValue(true),
+		SegmentID:    types.StringValue("segment-1"),
+		AudienceJSON: jsontypes.NewNormalizedNull(),
+		Schedule: brazeMessageScheduleModel{
+			Time:        timetypes.NewRFC3339ValueMust("2030-03-10T09:00:00Z"),
+			InLocalTime: types.BoolValue(true),
+		},
+		Messages: brazeScheduledMessagesModel{
+			EmailJSON: jsontypes.NewNormalizedValue(`{"app_id":"app-1","subject":"Maintenance"}`),
+			SMSJSON:   jsontypes.NewNormalizedNull(),
+		},
+	}
+
+	request, err := model.ToCreateScheduledMessageRequest()
+	require.NoError(t, err)
+
+	assert.True(t, request.Broadcast.Or(false))
+	assert.Nil(t, request.ExternalUserIds)
+	assert.Equal(t, "segment-1", request.SegmentID.Or(""))
+	assert.False(t, request.Audience.IsSet())
+	assert.True(t, request.Schedule.InLocalTime.Or(false))
+	assert.False(t, request.Schedule.AtOptimalTime.IsSet())
+	assert.Equal(t, `"Maintenance"`, string(request.Messages.Email.Value["subject"]))
+	assert.False(t, request.Messages.SMS.IsSet())
+
+	model.Messages.EmailJSON = jsontypes.NewNormalizedValue(`["not", "an", "object"]`)
+
+	_, err = model.ToCreateScheduledMessageRequest()
+	require.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = (*brazeScheduledMessageResource)(nil)
+	_ resource.ResourceWithConfigure      = (*brazeScheduledMessageResource)(nil)
+	_ resource.ResourceWithIdentity       = (*brazeScheduledMessageResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*brazeScheduledMessageResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*brazeScheduledMessageResource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeScheduledMessageResource() resource.Resource {
+	return &brazeScheduledMessageResource{}
+}
+
+type brazeScheduledMessageResource struct {
+	providerData brazeProviderData
+}
+
+func (r *brazeScheduledMessageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_message"
+}
+
+func (r *brazeScheduledMessageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = BrazeScheduledMessageResourceIdentitySchema()
+}
+
+func (r *brazeScheduledMessageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = BrazeScheduledMessageResourceSchema(ctx)
+}
+
+func (r *brazeScheduledMessageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazeScheduledMessageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		broadcast       types.Bool
+		externalUserIDs TypedList[types.String]
+		segmentID       types.String
+		audienceJSON    types.String
+		inLocalTime     types.Bool
+		atOptimalTime   types.Bool
+		messages        types.Object
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("broadcast"), &broadcast)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("external_user_ids"), &externalUserIDs)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("segment_id"), &segmentID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("audience_json"), &audienceJSON)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule").AtName("in_local_time"), &inLocalTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule").AtName("at_optimal_time"), &atOptimalTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("messages"), &messages)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !broadcast.IsUnknown() && !externalUserIDs.IsUnknown() {
+		switch {
+		case broadcast.ValueBool() && !externalUserIDs.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("external_user_ids"), "Invalid scheduled message audience", "external_user_ids cannot be set when broadcast is true.")
+		case !broadcast.ValueBool() && externalUserIDs.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("broadcast"), "Invalid scheduled message audience", "Either set broadcast to true to send to segment_id or audience_json, or set external_user_ids.")
+		}
+	}
+
+	if broadcast.ValueBool() && segmentID.IsNull() && audienceJSON.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("broadcast"), "Invalid scheduled message audience", "A broadcast must set segment_id or audience_json.")
+	}
+
+	if inLocalTime.ValueBool() && atOptimalTime.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule").AtName("at_optimal_time"), "Invalid scheduled message schedule", "in_local_time and at_optimal_time cannot both be true.")
+	}
+
+	if !messages.IsNull() && !messages.IsUnknown() {
+		for _, value := range messages.Attributes() {
+			if !value.IsNull() {
+				return
+			}
+		}
+
+		resp.Diagnostics.AddAttributeError(path.Root("messages"), "Invalid scheduled message messages", "At least one channel must be set in messages.")
+	}
+}
+
+func (r *brazeScheduledMessageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "scheduled message")
+	addRequiresReplaceIfScheduleSent(ctx, req, resp)
+}
+
+func (r *brazeScheduledMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_scheduled_message", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeScheduledMessageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.scheduledMessages.Create(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Scheduled Message", detailFromError(err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(setScheduleIdentityAndState(ctx, resp.Identity, &resp.State, data.ScheduleID.ValueString(), &data)...)
+}
+
+// Read keeps the prior state, as Braze has no API to read scheduled messages.
+func (r *brazeScheduledMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state brazeScheduledMessageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setScheduleIdentityAndState(ctx, resp.Identity, &resp.State, state.ScheduleID.ValueString(), &state)...)
+}
+
+func (r *brazeScheduledMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_scheduled_message", "update")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeScheduledMessageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.scheduledMessages.Update(ctx, plan)
+	if err != nil {
+		if isBrazeObjectNotFound(err) {
+			resp.Diagnostics.AddError("Scheduled Message not found", detailFromError(err))
+		} else {
+			resp.Diagnostics.AddError("Failed to update Scheduled Message", detailFromError(err))
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(setScheduleIdentityAndState(ctx, resp.Identity, &resp.State, data.ScheduleID.ValueString(), &data)...)
+}
+
+// Delete cancels the scheduled message. A message Braze has started sending
+// cannot be cancelled, so it is only removed from state.
+func (r *brazeScheduledMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_scheduled_message", "delete")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var state brazeScheduledMessageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || state.Schedule.sent(time.Now()) {
+		return
+	}
+
+	err := r.providerData.scheduledMessages.Delete(ctx, state.ScheduleID.ValueString())
+	if err != nil && !isBrazeObjectNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete Scheduled Message", detailFromError(err))
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func BrazeScheduledMessageResourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"schedule_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func BrazeScheduledMessageResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Manage messages scheduled through the Braze API, for example recurring system notices sent on a calendar. Braze has no API to read scheduled messages, so changes made outside Terraform are not detected. Once Braze has started sending a message it can no longer be updated, so changing its schedule or messages replaces it with a new scheduled message.",
+		Attributes: map[string]schema.Attribute{
+			"schedule_id": schema.StringAttribute{
+				Description: "The identifier Braze assigned to the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dispatch_id": schema.StringAttribute{
+				Description: "The identifier of the send, for matching message events to the schedule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcast": schema.BoolAttribute{
+				Description: "Whether to send to every user in segment_id or audience_json. Either broadcast must be true or external_user_ids must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_user_ids": schema.ListAttribute{
+				Description: "The external user IDs of the users to send to, further filtered by segment_id or audience_json when they are set.",
+				CustomType:  NewTypedListNull[types.String]().CustomType(ctx),
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"segment_id": schema.StringAttribute{
+				Description: "The identifier of the segment to send to.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"audience_json": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "A JSON Connected Audience object that selects the users to send to.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"campaign_id": schema.StringAttribute{
+				Description: "The identifier of an API campaign to attribute message analytics to.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": messageScheduleAttribute("message"),
+			"messages": schema.SingleNestedAttribute{
+				Description: "The message to send through each channel, as the JSON message objects of the Braze messaging API. At least one must be set.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"android_push_json": scheduledMessagePayloadAttribute("Android push"),
+					"apple_push_json":   scheduledMessagePayloadAttribute("Apple push"),
+					"content_card_json": scheduledMessagePayloadAttribute("Content Card"),
+					"email_json":        scheduledMessagePayloadAttribute("email"),
+					"sms_json":          scheduledMessagePayloadAttribute("SMS"),
+					"web_push_json":     scheduledMessagePayloadAttribute("web push"),
+					"webhook_json":      scheduledMessagePayloadAttribute("webhook"),
+				},
+			},
+		},
+	}
+}
+
+func scheduledMessagePayloadAttribute(channel string) schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType:  jsontypes.NormalizedType{},
+		Description: "The JSON " + channel + " message object.",
+		Optional:    true,
+	}
+}
+
+// messageScheduleAttribute describes when Braze sends a message, for the
+// resources that schedule messages through the API. noun names what is sent.
+func messageScheduleAttribute(noun string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "When to send the " + noun + ".",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"time": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "When to send the " + noun + ", in RFC 3339 format. When in_local_time is true the offset is ignored and the " + noun + " is sent at this date and time in each user's time zone; when at_optimal_time is true only the date is used.",
+				Required:    true,
+			},
+			"in_local_time": schema.BoolAttribute{
+				Description: "Whether to send the " + noun + " at time in each user's time zone.",
+				Optional:    true,
+			},
+			"at_optimal_time": schema.BoolAttribute{
+				Description: "Whether to send the " + noun + " on the date of time at the time each user is most likely to engage.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// addRequiresReplaceIfScheduleSent replaces a schedule whose attributes change
+// after Braze has started sending it, as Braze refuses to update it. It
+// checks every attribute, as any change would otherwise be planned as an
+// update.
+func addRequiresReplaceIfScheduleSent(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var schedule brazeMessageScheduleModel
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schedule"), &schedule)...)
+
+	if resp.Diagnostics.HasError() || !schedule.sent(time.Now()) {
+		return
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(req.Plan.Schema.GetAttributes())) {
+		var planValue, stateValue attr.Value
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &stateValue)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !planValue.Equal(stateValue) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root(name))
+		}
+	}
+}
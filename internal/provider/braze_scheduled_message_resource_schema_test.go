@@ -0,0 +1,108 @@
+//nolint:testpackage
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRequiresReplaceIfScheduleSent(t *testing.T) {
+	t.Parallel()
+
+	sentTime := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	futureTime := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	tests := map[string]struct {
+		stateTime time.Time
+		modify    func(plan *brazeScheduledMessageModel)
+		expected  []path.Path
+	}{
+		"sent with only messages changed": {
+			stateTime: sentTime,
+			modify: func(plan *brazeScheduledMessageModel) {
+				plan.Messages.EmailJSON = jsontypes.NewNormalizedValue(`{"subject":"Maintenance tonight"}`)
+			},
+			expected: []path.Path{path.Root("messages")},
+		},
+		"sent with schedule and segment changed": {
+			stateTime: sentTime,
+			modify: func(plan *brazeScheduledMessageModel) {
+				plan.Schedule.Time = timetypes.NewRFC3339TimeValue(futureTime)
+				plan.SegmentID = types.StringValue("segment-2")
+			},
+			expected: []path.Path{path.Root("schedule"), path.Root("segment_id")},
+		},
+		"sent without changes": {
+			stateTime: sentTime,
+			modify:    func(*brazeScheduledMessageModel) {},
+		},
+		"not sent with messages changed": {
+			stateTime: futureTime,
+			modify: func(plan *brazeScheduledMessageModel) {
+				plan.Messages.EmailJSON = jsontypes.NewNormalizedValue(`{"subject":"Maintenance tonight"}`)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			schema := BrazeScheduledMessageResourceSchema(ctx)
+
+			stateModel := testBrazeScheduledMessageModel(test.stateTime)
+			planModel := testBrazeScheduledMessageModel(test.stateTime)
+			test.modify(&planModel)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schema},
+				Plan:  tfsdk.Plan{Schema: schema},
+			}
+			require.False(t, req.State.Set(ctx, &stateModel).HasError())
+			require.False(t, req.Plan.Set(ctx, &planModel).HasError())
+
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			addRequiresReplaceIfScheduleSent(ctx, req, &resp)
+
+			require.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, test.expected, []path.Path(resp.RequiresReplace))
+		})
+	}
+}
+
+func testBrazeScheduledMessageModel(scheduleTime time.Time) brazeScheduledMessageModel {
+	return brazeScheduledMessageModel{
+		ScheduleID:      types.StringValue("schedule-1"),
+		DispatchID:      types.StringValue("dispatch-1"),
+		Broadcast:       types.BoolValue(true),
+		ExternalUserIDs: NewTypedListNull[types.String](),
+		SegmentID:       types.StringValue("segment-1"),
+		AudienceJSON:    jsontypes.NewNormalizedNull(),
+		CampaignID:      types.StringNull(),
+		Schedule: brazeMessageScheduleModel{
+			Time:          timetypes.NewRFC3339TimeValue(scheduleTime),
+			InLocalTime:   types.BoolNull(),
+			AtOptimalTime: types.BoolNull(),
+		},
+		Messages: brazeScheduledMessagesModel{
+			AndroidPushJSON: jsontypes.NewNormalizedNull(),
+			ApplePushJSON:   jsontypes.NewNormalizedNull(),
+			ContentCardJSON: jsontypes.NewNormalizedNull(),
+			EmailJSON:       jsontypes.NewNormalizedValue(`{"subject":"Maintenance"}`),
+			SMSJSON:         jsontypes.NewNormalizedNull(),
+			WebPushJSON:     jsontypes.NewNormalizedNull(),
+			WebhookJSON:     jsontypes.NewNormalizedNull(),
+		},
+	}
+}
@@ -0,0 +1,146 @@
+package provider_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var (
+	errScheduledMessageNotScheduled = errors.New("scheduled message not scheduled")
+	errScheduledMessageSubject      = errors.New("unexpected scheduled message subject")
+)
+
+func brazeScheduledMessageConfig(scheduleTime time.Time, subject string) string {
+	return fmt.Sprintf(`
+resource "braze_scheduled_message" "test" {
+  broadcast  = true
+  segment_id = "segment-1"
+
+  schedule = {
+    time = %q
+  }
+
+  messages = {
+    email_json = jsonencode({
+      app_id  = "app-1"
+      subject = %q
+      body    = "<p>Scheduled maintenance tonight.</p>"
+    })
+  }
+}
+`, scheduleTime.UTC().Format(time.RFC3339), subject)
+}
+
+func testCheckBrazeScheduledMessageSubject(server *brazeclienttesting.Server, subject string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		scheduleID := state.RootModule().Resources["braze_scheduled_message.test"].Primary.Attributes["schedule_id"]
+
+		request, exists := server.ScheduledMessage(scheduleID)
+		if !exists {
+			return fmt.Errorf("%w: %s", errScheduledMessageNotScheduled, scheduleID)
+		}
+
+		actual := string(request.Messages.Email.Value["subject"])
+		if actual != fmt.Sprintf("%q", subject) {
+			return fmt.Errorf("%w: expected %q, got %s", errScheduledMessageSubject, subject, actual)
+		}
+
+		return nil
+	}
+}
+
+func TestAccBrazeScheduledMessage(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	scheduleTime := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: brazeScheduledMessageConfig(scheduleTime, "Maintenance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("braze_scheduled_message.test", "schedule_id"),
+					resource.TestCheckResourceAttrSet("braze_scheduled_message.test", "dispatch_id"),
+					resource.TestCheckResourceAttr("braze_scheduled_message.test", "segment_id", "segment-1"),
+					testCheckBrazeScheduledMessageSubject(server, "Maintenance"),
+				),
+			},
+			{
+				Config: brazeScheduledMessageConfig(scheduleTime.Add(time.Hour), "Rescheduled maintenance"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_scheduled_message.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testCheckBrazeScheduledMessageSubject(server, "Rescheduled maintenance"),
+			},
+		},
+	})
+}
+
+func TestAccBrazeScheduledMessageSent(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	sentTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: brazeScheduledMessageConfig(sentTime, "Maintenance"),
+			},
+			{
+				Config: brazeScheduledMessageConfig(sentTime, "Maintenance tonight"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_scheduled_message.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: testCheckBrazeScheduledMessageSubject(server, "Maintenance tonight"),
+			},
+			{
+				Config: brazeScheduledMessageConfig(time.Now().Add(48*time.Hour).Truncate(time.Second), "Next maintenance"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("braze_scheduled_message.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: testCheckBrazeScheduledMessageSubject(server, "Next maintenance"),
+			},
+		},
+	})
+}
+
+func TestAccBrazeScheduledMessageInvalidAudience(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "braze_scheduled_message" "test" {
+					schedule = {
+						time = "2030-01-01T09:00:00Z"
+					}
+
+					messages = {
+						sms_json = jsonencode({ subscription_group_id = "group-1", body = "Hello" })
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid scheduled message audience`),
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+type segmentClient interface {
+	List(ctx context.Context, filter brazeSegmentFilter) ([]brazeSegment, error)
+}
+
+type brazeSegment struct {
+	ID                       string
+	Name                     string
+	Description              *string
+	Tags                     []string
+	AnalyticsTrackingEnabled bool
+}
+
+// brazeSegmentFilter selects the segments to return. AnalyticsTrackingEnabled
+// is nil to return segments whether or not analytics tracking is enabled.
+type brazeSegmentFilter struct {
+	Name                     string
+	NameRegex                *regexp.Regexp
+	Tag                      string
+	AnalyticsTrackingEnabled *bool
+	Concurrency              int
+}
+
+func (f brazeSegmentFilter) matches(segment brazeSegment) bool {
+	if f.Name != "" && segment.Name != f.Name {
+		return false
+	}
+
+	if f.NameRegex != nil && !f.NameRegex.MatchString(segment.Name) {
+		return false
+	}
+
+	if f.Tag != "" && !slices.Contains(segment.Tags, f.Tag) {
+		return false
+	}
+
+	if f.AnalyticsTrackingEnabled != nil && segment.AnalyticsTrackingEnabled != *f.AnalyticsTrackingEnabled {
+		return false
+	}
+
+	return true
+}
+
+type generatedSegmentClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedSegmentClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedSegmentClient {
+	return generatedSegmentClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+	}
+}
+
+// List reads every page of segments and then the details of the segments
+// that match the filter, as only the details include the description.
+func (c generatedSegmentClient) List(ctx context.Context, filter brazeSegmentFilter) ([]brazeSegment, error) {
+	segments := []brazeSegment{}
+
+	for page := 0; ; page++ {
+		items, err := c.listPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if filter.matches(item) {
+				segments = append(segments, item)
+			}
+		}
+
+		if len(items) < brazeExportListPageLimit {
+			break
+		}
+	}
+
+	err := readBrazeObjectsConcurrently(filter.Concurrency, len(segments), func(i int) error {
+		return c.readDetails(ctx, &segments[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return segments, nil
+}
+
+func (c generatedSegmentClient) listPage(ctx context.Context, page int) ([]brazeSegment, error) {
+	listParams := brazeclient.ListSegmentsParams{
+		Page: brazeclient.NewOptInt(page),
+	}
+
+	listResponse, listErr := c.client.ListSegments(ctx, listParams)
+
+	c.logger.Log(ctx, "braze_segments.list", brazeAPILogEntry{
+		Params:   listParams,
+		Response: listResponse,
+		Err:      listErr,
+	})
+
+	listErr = classifyBrazePermissionError(brazeclient.ListSegmentsOperation, listErr)
+
+	if listErr != nil {
+		return nil, fmt.Errorf("list segments: %w", listErr)
+	}
+
+	if listResponse == nil {
+		return nil, errBrazeObjectEmptyResponse
+	}
+
+	items := listResponse.GetSegments()
+	segments := make([]brazeSegment, len(items))
+
+	for i, item := range items {
+		segments[i] = brazeSegment{
+			ID:                       item.GetID(),
+			Name:                     item.GetName(),
+			Tags:                     item.GetTags(),
+			AnalyticsTrackingEnabled: item.GetAnalyticsTrackingEnabled().Or(false),
+		}
+	}
+
+	return segments, nil
+}
+
+func (c generatedSegmentClient) readDetails(ctx context.Context, segment *brazeSegment) error {
+	detailsParams := brazeclient.GetSegmentDetailsParams{
+		SegmentID: segment.ID,
+	}
+
+	detailsResponse, detailsErr := c.client.GetSegmentDetails(ctx, detailsParams)
+
+	c.logger.Log(ctx, "braze_segments.details", brazeAPILogEntry{
+		Params:   detailsParams,
+		Response: detailsResponse,
+		Err:      detailsErr,
+	})
+
+	detailsErr = classifyBrazePermissionError(brazeclient.GetSegmentDetailsOperation, detailsErr)
+
+	if detailsErr != nil {
+		return fmt.Errorf("read segment %s: %w", segment.ID, detailsErr)
+	}
+
+	if detailsResponse == nil {
+		return errBrazeObjectEmptyResponse
+	}
+
+	if description, ok := detailsResponse.GetDescription().Get(); ok && description != "" {
+		segment.Description = &description
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = (*brazeSegmentsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*brazeSegmentsDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*brazeSegmentsDataSource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeSegmentsDataSource() datasource.DataSource {
+	return &brazeSegmentsDataSource{}
+}
+
+type brazeSegmentsDataSource struct {
+	providerData brazeProviderData
+}
+
+type brazeSegmentsDataSourceModel struct {
+	Name                     types.String        `tfsdk:"name"`
+	NameRegex                types.String        `tfsdk:"name_regex"`
+	Tag                      types.String        `tfsdk:"tag"`
+	AnalyticsTrackingEnabled types.Bool          `tfsdk:"analytics_tracking_enabled"`
+	Segments                 []brazeSegmentModel `tfsdk:"segments"`
+}
+
+type brazeSegmentModel struct {
+	ID                       types.String            `tfsdk:"id"`
+	Name                     types.String            `tfsdk:"name"`
+	Description              types.String            `tfsdk:"description"`
+	Tags                     TypedList[types.String] `tfsdk:"tags"`
+	AnalyticsTrackingEnabled types.Bool              `tfsdk:"analytics_tracking_enabled"`
+}
+
+func (d *brazeSegmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segments"
+}
+
+func (d *brazeSegmentsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the segments in the workspace, for example to look up segment identifiers by name for exports and Connected Audiences.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return segments with exactly this name.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return segments whose name matches this regular expression, in Go RE2 syntax.",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Only return segments with this tag.",
+				Optional:    true,
+			},
+			"analytics_tracking_enabled": schema.BoolAttribute{
+				Description: "Only return segments with analytics tracking enabled when true, or disabled when false. Both are returned when not set.",
+				Optional:    true,
+			},
+			"segments": schema.ListNestedAttribute{
+				Description: "The matching segments, in the order Braze lists them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The API identifier of the segment.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the segment.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the segment.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "The tags of the segment.",
+							CustomType:  NewTypedListNull[types.String]().CustomType(ctx),
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"analytics_tracking_enabled": schema.BoolAttribute{
+							Description: "Whether Braze tracks analytics for the segment over time.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *brazeSegmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(SetProviderDataFromDataSourceConfigureRequest(req, &d.providerData)...)
+}
+
+func (d *brazeSegmentsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config brazeSegmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, filterDiags := config.toFilter(0)
+	resp.Diagnostics.Append(filterDiags...)
+}
+
+func (d *brazeSegmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, d.providerData.tracer, "braze_segments", "read")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var config brazeSegmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, filterDiags := config.toFilter(d.providerData.listConcurrency)
+	resp.Diagnostics.Append(filterDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segments, err := d.providerData.segments.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list segments", detailFromError(err))
+
+		return
+	}
+
+	config.Segments = make([]brazeSegmentModel, len(segments))
+	for i, segment := range segments {
+		config.Segments[i] = brazeSegmentModel{
+			ID:                       types.StringValue(segment.ID),
+			Name:                     types.StringValue(segment.Name),
+			Description:              types.StringPointerValue(segment.Description),
+			Tags:                     NewTypedListFromStringSlice(segment.Tags),
+			AnalyticsTrackingEnabled: types.BoolValue(segment.AnalyticsTrackingEnabled),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (m brazeSegmentsDataSourceModel) toFilter(concurrency int) (brazeSegmentFilter, diag.Diagnostics) {
+	filter := brazeSegmentFilter{
+		Name:                     m.Name.ValueString(),
+		Tag:                      m.Tag.ValueString(),
+		AnalyticsTrackingEnabled: m.AnalyticsTrackingEnabled.ValueBoolPointer(),
+		Concurrency:              concurrency,
+	}
+
+	nameRegex, diags := compileBrazeNameRegex(m.NameRegex)
+	filter.NameRegex = nameRegex
+
+	return filter, diags
+}
@@ -0,0 +1,68 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBrazeSegmentsDataSource(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetSegment("segment-lapsed", "Lapsed users", "Users who have not opened the app in 30 days", []string{"retention"}, true)
+	server.SetSegment("segment-vip", "VIP", "", []string{"retention", "vip"}, false)
+	server.SetSegment("segment-all", "All users", "", nil, false)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_segments" "all" {}
+
+				data "braze_segments" "retention_tracked" {
+					tag                        = "retention"
+					analytics_tracking_enabled = true
+				}
+
+				data "braze_segments" "vip" {
+					name = "VIP"
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.braze_segments.all", "segments.#", "3"),
+					resource.TestCheckResourceAttr("data.braze_segments.retention_tracked", "segments.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_segments.retention_tracked", "segments.0.id", "segment-lapsed"),
+					resource.TestCheckResourceAttr("data.braze_segments.retention_tracked", "segments.0.name", "Lapsed users"),
+					resource.TestCheckResourceAttr("data.braze_segments.retention_tracked", "segments.0.description", "Users who have not opened the app in 30 days"),
+					resource.TestCheckResourceAttr("data.braze_segments.retention_tracked", "segments.0.analytics_tracking_enabled", "true"),
+					resource.TestCheckResourceAttr("data.braze_segments.vip", "segments.#", "1"),
+					resource.TestCheckResourceAttr("data.braze_segments.vip", "segments.0.id", "segment-vip"),
+					resource.TestCheckNoResourceAttr("data.braze_segments.vip", "segments.0.description"),
+					resource.TestCheckResourceAttr("data.braze_segments.vip", "segments.0.tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBrazeSegmentsDataSourceInvalidNameRegex(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "braze_segments" "test" {
+					name_regex = "["
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid name_regex`),
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+)
+
+type sendIDClient interface {
+	Create(ctx context.Context, plan brazeSendIDModel) (brazeSendIDModel, error)
+}
+
+type generatedSendIDClient struct {
+	client *brazeclient.Client
+	logger brazeAPILogger
+}
+
+func newGeneratedSendIDClient(client *brazeclient.Client, logPayloads brazeLogPayloads) generatedSendIDClient {
+	return generatedSendIDClient{
+		client: client,
+		logger: newBrazeAPILogger(logPayloads),
+	}
+}
+
+// Create creates the send identifier. Braze has no API to read or delete send
+// identifiers, so the result is the plan.
+func (c generatedSendIDClient) Create(ctx context.Context, plan brazeSendIDModel) (brazeSendIDModel, error) {
+	createRequest := plan.ToCreateSendIDRequest()
+
+	createResponse, createErr := c.client.CreateSendID(ctx, &createRequest)
+
+	c.logger.Log(ctx, "braze_send_id.create", brazeAPILogEntry{
+		Request:  &createRequest,
+		Response: createResponse,
+		Err:      createErr,
+	})
+
+	createErr = classifyBrazePermissionError(brazeclient.CreateSendIDOperation, createErr)
+
+	if createErr != nil {
+		return brazeSendIDModel{}, fmt.Errorf("create send id: %w", createErr)
+	}
+
+	if createResponse == nil {
+		return brazeSendIDModel{}, errBrazeObjectEmptyResponse
+	}
+
+	return plan, nil
+}
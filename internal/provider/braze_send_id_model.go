@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// brazeSendIDPattern matches the send identifiers Braze accepts: up to 64
+// alphanumeric characters, dashes and underscores.
+var brazeSendIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+type brazeSendIDModel struct {
+	CampaignID types.String `tfsdk:"campaign_id"`
+	SendID     types.String `tfsdk:"send_id"`
+}
+
+func (m brazeSendIDModel) ToCreateSendIDRequest() brazeclient.CreateSendIDRequest {
+	return brazeclient.CreateSendIDRequest{
+		CampaignID: m.CampaignID.ValueString(),
+		SendID:     m.SendID.ValueString(),
+	}
+}
+
+// validateBrazeSendID reports a send identifier at attribute that Braze would
+// refuse. Null and unknown values are not checked.
+func validateBrazeSendID(attribute path.Path, sendID types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if sendID.IsNull() || sendID.IsUnknown() || brazeSendIDPattern.MatchString(sendID.ValueString()) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		attribute,
+		"Invalid send ID",
+		fmt.Sprintf("Braze send IDs must be 1 to 64 characters long and can only contain alphanumeric characters, dashes and underscores. Got %q.", sendID.ValueString()),
+	)
+
+	return diags
+}
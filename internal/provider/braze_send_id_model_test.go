@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBrazeSendID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		sendID        types.String
+		expectedValid bool
+	}{
+		"valid":              {sendID: types.StringValue("spring-launch_2030"), expectedValid: true},
+		"max length":         {sendID: types.StringValue(strings.Repeat("a", 64)), expectedValid: true},
+		"null":               {sendID: types.StringNull(), expectedValid: true},
+		"unknown":            {sendID: types.StringUnknown(), expectedValid: true},
+		"empty":              {sendID: types.StringValue(""), expectedValid: false},
+		"too long":           {sendID: types.StringValue(strings.Repeat("a", 65)), expectedValid: false},
+		"invalid characters": {sendID: types.StringValue("spring launch!"), expectedValid: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateBrazeSendID(path.Root("send_id"), test.sendID)
+
+			assert.Equal(t, !test.expectedValid, diags.HasError())
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = (*brazeSendIDResource)(nil)
+	_ resource.ResourceWithConfigure      = (*brazeSendIDResource)(nil)
+	_ resource.ResourceWithIdentity       = (*brazeSendIDResource)(nil)
+	_ resource.ResourceWithImportState    = (*brazeSendIDResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*brazeSendIDResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*brazeSendIDResource)(nil)
+)
+
+//nolint:ireturn
+func NewBrazeSendIDResource() resource.Resource {
+	return &brazeSendIDResource{}
+}
+
+type brazeSendIDResource struct {
+	providerData brazeProviderData
+}
+
+func (r *brazeSendIDResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_send_id"
+}
+
+func (r *brazeSendIDResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = BrazeSendIDResourceIdentitySchema()
+}
+
+func (r *brazeSendIDResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = BrazeSendIDResourceSchema()
+}
+
+func (r *brazeSendIDResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	SetProviderDataFromResourceConfigureRequest(req, &r.providerData)
+}
+
+func (r *brazeSendIDResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var sendID types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("send_id"), &sendID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateBrazeSendID(path.Root("send_id"), sendID)...)
+}
+
+func (r *brazeSendIDResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addBrazeReadOnlyPlanError(req, resp, r.providerData.readOnly, "send ID")
+}
+
+// ImportState adopts an existing send identifier. Braze cannot read send
+// identifiers back, so the identity is taken as given.
+func (r *brazeSendIDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" || req.Identity == nil {
+		resp.Diagnostics.AddError(
+			"Invalid import identity",
+			"Import send IDs with an identity import block containing `campaign_id` and `send_id`.",
+		)
+
+		return
+	}
+
+	var data brazeSendIDModel
+
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("campaign_id"), &data.CampaignID)...)
+	resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("send_id"), &data.SendID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CampaignID.ValueString() == "" || data.SendID.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identity",
+			"Import send IDs with an identity import block containing non-empty `campaign_id` and `send_id`.",
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(setSendIDIdentityAndState(ctx, resp.Identity, &resp.State, &data)...)
+}
+
+func (r *brazeSendIDResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startBrazeOperationSpan(ctx, r.providerData.tracer, "braze_send_id", "create")
+	defer endBrazeOperationSpan(span, &resp.Diagnostics)
+
+	var plan brazeSendIDModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.providerData.sendIDs.Create(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Send ID", detailFromError(err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(setSendIDIdentityAndState(ctx, resp.Identity, &resp.State, &data)...)
+}
+
+// Read keeps the prior state, as Braze has no API to read send identifiers.
+func (r *brazeSendIDResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state brazeSendIDModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setSendIDIdentityAndState(ctx, resp.Identity, &resp.State, &state)...)
+}
+
+func (r *brazeSendIDResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Send ID update is not supported", "Braze send IDs cannot be changed; changes require replacement.")
+}
+
+// Delete only removes the send identifier from state, as Braze has no API to
+// delete send identifiers.
+func (r *brazeSendIDResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func BrazeSendIDResourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"campaign_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"send_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func BrazeSendIDResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Manage a send identifier of a campaign, for tracking the analytics of individual sends. Braze has no API to read or delete send identifiers, so destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"campaign_id": schema.StringAttribute{
+				Description: "The identifier of the campaign the send identifier belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"send_id": schema.StringAttribute{
+				Description: "The send identifier, of up to 64 alphanumeric characters, dashes and underscores.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
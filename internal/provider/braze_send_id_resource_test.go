@@ -0,0 +1,83 @@
+package provider_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var errSendIDNotCreated = errors.New("send id not created")
+
+func brazeSendIDConfig(campaignID, sendID string) string {
+	return fmt.Sprintf(`
+resource "braze_send_id" "test" {
+  campaign_id = %q
+  send_id     = %q
+}
+`, campaignID, sendID)
+}
+
+func testCheckBrazeSendIDCreated(server *brazeclienttesting.Server, campaignID, sendID string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if !server.HasSendID(campaignID, sendID) {
+			return fmt.Errorf("%w: %s/%s", errSendIDNotCreated, campaignID, sendID)
+		}
+
+		return nil
+	}
+}
+
+func TestAccBrazeSendID(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCampaign("campaign-1", "Spring launch", nil, "api_triggered", false)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: brazeSendIDConfig("campaign-1", "spring-launch_1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("braze_send_id.test", "campaign_id", "campaign-1"),
+					resource.TestCheckResourceAttr("braze_send_id.test", "send_id", "spring-launch_1"),
+					testCheckBrazeSendIDCreated(server, "campaign-1", "spring-launch_1"),
+				),
+			},
+			{
+				Config:          brazeSendIDConfig("campaign-1", "spring-launch_1"),
+				ResourceName:    "braze_send_id.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Config: brazeSendIDConfig("campaign-1", "spring-launch_2"),
+				Check:  testCheckBrazeSendIDCreated(server, "campaign-1", "spring-launch_2"),
+			},
+		},
+	})
+}
+
+func TestAccBrazeSendIDInvalid(t *testing.T) {
+	t.Parallel()
+
+	server, _ := brazeclienttesting.NewBrazeServer()
+	server.SetCampaign("campaign-1", "Spring launch", nil, "api_triggered", false)
+
+	BrazeProviderMockedResourceTest(t, server, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      brazeSendIDConfig("campaign-1", "spring launch"),
+				ExpectError: regexp.MustCompile(`Invalid send ID`),
+			},
+			{
+				Config:      brazeSendIDConfig("campaign-2", "spring-launch"),
+				ExpectError: regexp.MustCompile(`Failed to create Send ID`),
+			},
+		},
+	})
+}
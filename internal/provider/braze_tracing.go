@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	brazeTracerName    = "github.com/cysp/terraform-provider-braze"
+	brazeTraceFileMode = 0o600
+)
+
+// newBrazeTracerProvider builds the tracer provider used for the Braze client
+// and resource operations. Spans are exported over OTLP/HTTP when one of the
+// standard OTEL_EXPORTER_OTLP_*ENDPOINT environment variables is set, and
+// appended to traceFile as JSON when it is not empty. When neither is
+// configured a no-op provider is returned.
+//
+// Spans are exported synchronously as they end because Terraform stops the
+// provider process without giving it a chance to flush a batch or shut the
+// tracer provider down. For the same reason the trace file is not held open.
+//
+//nolint:ireturn
+func newBrazeTracerProvider(ctx context.Context, version string, traceFile string) (trace.TracerProvider, error) {
+	options := []sdktrace.TracerProviderOption{}
+
+	if otlpTraceExportConfigured() {
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("create otlp trace exporter: %w", err)
+		}
+
+		options = append(options, sdktrace.WithSyncer(exporter))
+	}
+
+	if traceFile != "" {
+		writer := brazeTraceFileWriter{path: traceFile}
+
+		_, err := writer.Write(nil)
+		if err != nil {
+			return nil, fmt.Errorf("open trace file: %w", err)
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(writer))
+		if err != nil {
+			return nil, fmt.Errorf("create file trace exporter: %w", err)
+		}
+
+		options = append(options, sdktrace.WithSyncer(exporter))
+	}
+
+	if len(options) == 0 {
+		return noop.NewTracerProvider(), nil
+	}
+
+	resource, err := sdkresource.New(ctx,
+		sdkresource.WithAttributes(
+			attribute.String("service.name", "terraform-provider-braze"),
+			attribute.String("service.version", version),
+		),
+		sdkresource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create trace resource: %w", err)
+	}
+
+	options = append(options, sdktrace.WithResource(resource))
+
+	return sdktrace.NewTracerProvider(options...), nil
+}
+
+// brazeTraceFileWriter appends each write to the file at path, opening and
+// closing the file every time. The file exporter encodes each span with a
+// single write, so spans are never split between writes.
+type brazeTraceFileWriter struct {
+	path string
+}
+
+func (w brazeTraceFileWriter) Write(p []byte) (int, error) {
+	file, err := os.OpenFile(w.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, brazeTraceFileMode)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	n, err := file.Write(p)
+
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	return n, err //nolint:wrapcheck
+}
+
+func otlpTraceExportConfigured() bool {
+	if os.Getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return false
+	}
+
+	for _, name := range []string{"OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"} {
+		if os.Getenv(name) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func startBrazeOperationSpan(ctx context.Context, tracer trace.Tracer, typeName string, operation string) (context.Context, trace.Span) {
+	if tracer == nil {
+		tracer = noop.NewTracerProvider().Tracer(brazeTracerName)
+	}
+
+	//nolint:spancheck // Callers end the span with endBrazeOperationSpan.
+	return tracer.Start(ctx, typeName+"."+operation, trace.WithAttributes(
+		attribute.String("terraform.type_name", typeName),
+		attribute.String("terraform.operation", operation),
+	))
+}
+
+func endBrazeOperationSpan(span trace.Span, diags *diag.Diagnostics) {
+	if diags.HasError() {
+		for _, d := range diags.Errors() {
+			span.AddEvent("diagnostic", trace.WithAttributes(
+				attribute.String("summary", d.Summary()),
+				attribute.String("detail", d.Detail()),
+			))
+		}
+
+		span.SetStatus(codes.Error, diags.Errors()[0].Summary())
+	}
+
+	span.End()
+}
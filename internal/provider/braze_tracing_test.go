@@ -0,0 +1,81 @@
+//nolint:testpackage
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestNewBrazeTracerProviderWithoutExporters(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	tracerProvider, err := newBrazeTracerProvider(t.Context(), "test", "")
+
+	require.NoError(t, err)
+	assert.IsType(t, noop.TracerProvider{}, tracerProvider)
+}
+
+func TestNewBrazeTracerProviderWithTraceFile(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	traceFile := filepath.Join(t.TempDir(), "traces.json")
+
+	tracerProvider, err := newBrazeTracerProvider(t.Context(), "test", traceFile)
+	require.NoError(t, err)
+
+	diags := diag.Diagnostics{}
+	diags.AddError("Failed to read Content Block", "not found")
+
+	_, span := startBrazeOperationSpan(t.Context(), tracerProvider.Tracer(brazeTracerName), "braze_content_block", "read")
+	endBrazeOperationSpan(span, &diags)
+
+	contents, err := os.ReadFile(traceFile)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(contents), `"Name":"braze_content_block.read"`)
+	assert.Contains(t, string(contents), `"Description":"Failed to read Content Block"`)
+	assert.Contains(t, string(contents), `"service.name"`)
+}
+
+func TestNewBrazeTracerProviderReopensTraceFile(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	traceFile := filepath.Join(t.TempDir(), "traces.json")
+
+	tracerProvider, err := newBrazeTracerProvider(t.Context(), "test", traceFile)
+	require.NoError(t, err)
+
+	tracer := tracerProvider.Tracer(brazeTracerName)
+
+	_, span := startBrazeOperationSpan(t.Context(), tracer, "braze_content_block", "read")
+	endBrazeOperationSpan(span, &diag.Diagnostics{})
+
+	require.NoError(t, os.Remove(traceFile))
+
+	_, span = startBrazeOperationSpan(t.Context(), tracer, "braze_email_template", "read")
+	endBrazeOperationSpan(span, &diag.Diagnostics{})
+
+	contents, err := os.ReadFile(traceFile)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(contents), `"Name":"braze_content_block.read"`)
+	assert.Contains(t, string(contents), `"Name":"braze_email_template.read"`)
+}
+
+func TestNewBrazeTracerProviderWithUnwritableTraceFile(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	_, err := newBrazeTracerProvider(t.Context(), "test", filepath.Join(t.TempDir(), "missing", "traces.json"))
+
+	require.ErrorContains(t, err, "open trace file")
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func triggerScheduleBroadcastAttribute(noun string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Whether to send to the " + noun + "'s whole segment, narrowed by audience_json when it is set.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func triggerScheduleAudienceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType:  jsontypes.NormalizedType{},
+		Description: "A JSON Connected Audience object that narrows the users to send to.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// validateTriggerScheduleConfig checks the attributes shared by the campaign
+// and Canvas trigger schedule resources: a schedule is sent either to its
+// recipients or, as a broadcast, to the whole segment.
+func validateTriggerScheduleConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var (
+		diags         diag.Diagnostics
+		broadcast     types.Bool
+		recipients    types.List
+		inLocalTime   types.Bool
+		atOptimalTime types.Bool
+	)
+
+	diags.Append(config.GetAttribute(ctx, path.Root("broadcast"), &broadcast)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("recipients"), &recipients)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("schedule").AtName("in_local_time"), &inLocalTime)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("schedule").AtName("at_optimal_time"), &atOptimalTime)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if !broadcast.IsUnknown() && !recipients.IsUnknown() {
+		switch {
+		case broadcast.ValueBool() && !recipients.IsNull():
+			diags.AddAttributeError(path.Root("recipients"), "Invalid trigger schedule audience", "recipients cannot be set when broadcast is true.")
+		case !broadcast.ValueBool() && recipients.IsNull():
+			diags.AddAttributeError(path.Root("broadcast"), "Invalid trigger schedule audience", "Either set broadcast to true to send to the whole segment, or set recipients.")
+		}
+	}
+
+	if inLocalTime.ValueBool() && atOptimalTime.ValueBool() {
+		diags.AddAttributeError(path.Root("schedule").AtName("at_optimal_time"), "Invalid trigger schedule", "in_local_time and at_optimal_time cannot both be true.")
+	}
+
+	return diags
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// cachedFetch caches the result of a fetch for a short time and coalesces
+// concurrent fetches so that only one is in flight at once.
+type cachedFetch[T any] struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	now func() time.Time
+
+	value     T
+	fetchedAt time.Time
+	valid     bool
+
+	inflight *cachedFetchCall[T]
+}
+
+type cachedFetchCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func newCachedFetch[T any](ttl time.Duration) *cachedFetch[T] {
+	return &cachedFetch[T]{
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// cachedFetchTimeout bounds a shared fetch, which is not cancelled with the
+// context of the caller that started it.
+const cachedFetchTimeout = 2 * time.Minute
+
+// Get returns the cached value if it is still fresh. Otherwise it joins an
+// in-flight fetch or starts a new one using fetch. The fetch is shared by
+// every caller, so it runs detached from ctx: a caller whose ctx is done
+// stops waiting, but the fetch continues for the others.
+func (c *cachedFetch[T]) Get(ctx context.Context, fetch func(ctx context.Context) (T, error)) (T, error) {
+	c.mu.Lock()
+
+	if c.valid && c.now().Sub(c.fetchedAt) < c.ttl {
+		value := c.value
+		c.mu.Unlock()
+
+		return value, nil
+	}
+
+	call := c.inflight
+	if call == nil {
+		call = &cachedFetchCall[T]{done: make(chan struct{})}
+		c.inflight = call
+
+		go c.run(context.WithoutCancel(ctx), call, fetch)
+	}
+
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		var zero T
+
+		return zero, ctx.Err()
+	}
+}
+
+func (c *cachedFetch[T]) run(ctx context.Context, call *cachedFetchCall[T], fetch func(ctx context.Context) (T, error)) {
+	ctx, cancel := context.WithTimeout(ctx, cachedFetchTimeout)
+	defer cancel()
+
+	call.value, call.err = fetch(ctx)
+
+	c.mu.Lock()
+	if c.inflight == call {
+		c.inflight = nil
+
+		if call.err == nil {
+			c.value = call.value
+			c.fetchedAt = c.now()
+			c.valid = true
+		}
+	}
+	c.mu.Unlock()
+
+	close(call.done)
+}
+
+// Invalidate discards the cached value. A fetch that is in flight when the
+// cache is invalidated still completes for its callers, but its result is not
+// cached and later callers start a new fetch.
+func (c *cachedFetch[T]) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+
+	c.value = zero
+	c.valid = false
+	c.inflight = nil
+}
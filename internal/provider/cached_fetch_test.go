@@ -0,0 +1,165 @@
+//nolint:testpackage
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestCachedFetch = errors.New("list failed")
+
+func TestCachedFetchCoalescesConcurrentFetches(t *testing.T) {
+	t.Parallel()
+
+	cache := newCachedFetch[[]brazeclient.Catalog](time.Minute)
+
+	var fetches atomic.Int64
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	fetch := func(context.Context) ([]brazeclient.Catalog, error) {
+		if fetches.Add(1) == 1 {
+			close(started)
+		}
+
+		<-release
+
+		return []brazeclient.Catalog{{Name: "centres"}}, nil
+	}
+
+	var wg sync.WaitGroup
+
+	wg.Go(func() {
+		catalogs, err := cache.Get(t.Context(), fetch)
+		assert.NoError(t, err)
+		assert.Len(t, catalogs, 1)
+	})
+
+	<-started
+
+	for range 5 {
+		wg.Go(func() {
+			catalogs, err := cache.Get(t.Context(), fetch)
+			assert.NoError(t, err)
+			assert.Len(t, catalogs, 1)
+		})
+	}
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), fetches.Load())
+}
+
+func TestCachedFetchExpiresAndInvalidates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.May, 29, 10, 0, 0, 0, time.UTC)
+
+	cache := newCachedFetch[[]brazeclient.Catalog](10 * time.Second)
+	cache.now = func() time.Time { return now }
+
+	fetches := 0
+	fetch := func(context.Context) ([]brazeclient.Catalog, error) {
+		fetches++
+
+		return []brazeclient.Catalog{}, nil
+	}
+
+	_, err := cache.Get(t.Context(), fetch)
+	require.NoError(t, err)
+
+	now = now.Add(5 * time.Second)
+	_, err = cache.Get(t.Context(), fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+
+	now = now.Add(5 * time.Second)
+	_, err = cache.Get(t.Context(), fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+
+	cache.Invalidate()
+	_, err = cache.Get(t.Context(), fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 3, fetches)
+}
+
+func TestCachedFetchDoesNotCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	cache := newCachedFetch[[]brazeclient.Catalog](time.Minute)
+
+	fetches := 0
+	fetch := func(context.Context) ([]brazeclient.Catalog, error) {
+		fetches++
+		if fetches == 1 {
+			return nil, errTestCachedFetch
+		}
+
+		return []brazeclient.Catalog{}, nil
+	}
+
+	_, err := cache.Get(t.Context(), fetch)
+	require.ErrorIs(t, err, errTestCachedFetch)
+
+	_, err = cache.Get(t.Context(), fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestCachedFetchOutlivesCancelledCaller(t *testing.T) {
+	t.Parallel()
+
+	cache := newCachedFetch[[]brazeclient.Catalog](time.Minute)
+
+	var fetches atomic.Int64
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	fetch := func(ctx context.Context) ([]brazeclient.Catalog, error) {
+		if fetches.Add(1) == 1 {
+			close(started)
+		}
+
+		select {
+		case <-release:
+			return []brazeclient.Catalog{{Name: "centres"}}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(t.Context())
+
+	var wg sync.WaitGroup
+
+	wg.Go(func() {
+		_, err := cache.Get(firstCtx, fetch)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	<-started
+
+	wg.Go(func() {
+		catalogs, err := cache.Get(t.Context(), fetch)
+		assert.NoError(t, err)
+		assert.Len(t, catalogs, 1)
+	})
+
+	cancelFirst()
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), fetches.Load())
+}
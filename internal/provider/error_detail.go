@@ -1,9 +1,26 @@
 package provider
 
+import (
+	"errors"
+)
+
 func detailFromError(err error) string {
 	if err == nil {
 		return ""
 	}
 
+	var permissionErr brazePermissionError
+	if errors.As(err, &permissionErr) {
+		return permissionErr.Detail() + "\n\n" + err.Error()
+	}
+
+	if errors.Is(err, errBrazeReadOnly) {
+		return "The provider is configured with read_only, so changes to Braze are refused.\n\n" + err.Error()
+	}
+
+	if errors.Is(err, errBrazeWorkspaceUnidentified) {
+		return "Create a catalog named " + brazeWorkspaceSentinelCatalogName + " in each workspace, with the workspace identifier as its description, to use expected_workspace.\n\n" + err.Error()
+	}
+
 	return err.Error()
 }
@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -64,3 +66,83 @@ func retryAfterDelay(value string, now func() time.Time) (time.Duration, bool) {
 
 	return delay, true
 }
+
+const rateLimitRemainingHeader = "X-Ratelimit-Remaining"
+
+// HTTPRateLimitGateTransport is shared by all requests made by a provider
+// instance. Once Braze reports that the rate limit is exhausted, it holds back
+// every request until the limit resets, so that concurrent requests wait
+// together instead of each being rejected and retried.
+type HTTPRateLimitGateTransport struct {
+	next http.RoundTripper
+	now  func() time.Time
+
+	mu           sync.Mutex
+	blockedUntil time.Time
+}
+
+func NewHTTPRateLimitGateTransport(next http.RoundTripper) *HTTPRateLimitGateTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &HTTPRateLimitGateTransport{
+		next: next,
+		now:  time.Now,
+	}
+}
+
+func (t *HTTPRateLimitGateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		//nolint:wrapcheck
+		return resp, err
+	}
+
+	if delay, ok := rateLimitExhaustedDelay(resp, t.now); ok {
+		t.block(t.now().Add(delay))
+	}
+
+	return resp, nil
+}
+
+func (t *HTTPRateLimitGateTransport) wait(ctx context.Context) error {
+	t.mu.Lock()
+	delay := t.blockedUntil.Sub(t.now())
+	t.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *HTTPRateLimitGateTransport) block(until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if until.After(t.blockedUntil) {
+		t.blockedUntil = until
+	}
+}
+
+func rateLimitExhaustedDelay(resp *http.Response, now func() time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.Header.Get(rateLimitRemainingHeader) != "0" {
+		return 0, false
+	}
+
+	return rateLimitDelay(resp.Header, now)
+}
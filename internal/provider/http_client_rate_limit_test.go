@@ -3,7 +3,9 @@ package provider
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -106,6 +108,75 @@ func TestRateLimitDelayRejectsInvalidHeaders(t *testing.T) {
 	}
 }
 
+func TestHTTPRateLimitGateTransportHoldsRequestsUntilReset(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client := &http.Client{Transport: NewHTTPRateLimitGateTransport(server.Client().Transport)}
+
+	get := func() *http.Response {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("http.NewRequestWithContext() error = %v", err)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("client.Do() error = %v", err)
+		}
+
+		resp.Body.Close()
+
+		return resp
+	}
+
+	if resp := get(); resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected first request to be rate limited, got %d", resp.StatusCode)
+	}
+
+	start := time.Now()
+
+	if resp := get(); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected second request to succeed, got %d", resp.StatusCode)
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("expected second request to wait for rate limit reset, waited %s", elapsed)
+	}
+}
+
+func TestHTTPRateLimitGateTransportIgnoresRemainingQuota(t *testing.T) {
+	t.Parallel()
+
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set(rateLimitRemainingHeader, "10")
+	resp.Header.Set(rateLimitResetHeader, strconvFormatInt(fixedTime().Add(time.Minute).Unix()))
+
+	if delay, ok := rateLimitExhaustedDelay(resp, fixedTime); ok {
+		t.Fatalf("expected no delay while quota remains, got %s", delay)
+	}
+
+	resp.Header.Set(rateLimitRemainingHeader, "0")
+
+	delay, ok := rateLimitExhaustedDelay(resp, fixedTime)
+	if !ok || delay != time.Minute {
+		t.Fatalf("expected 1m delay once quota is exhausted, got %s", delay)
+	}
+}
+
 func fixedTime() time.Time {
 	return time.Date(2026, time.May, 29, 10, 0, 0, 0, time.UTC)
 }
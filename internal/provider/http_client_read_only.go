@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var errBrazeReadOnly = errors.New("the Braze provider is read-only")
+
+// HTTPReadOnlyTransport refuses every request that could modify Braze. Every
+// Braze read operation is a GET, so anything else is refused before it is
+// sent. It belongs above the retrying transport so that refusals are not
+// retried.
+type HTTPReadOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func NewHTTPReadOnlyTransport(next http.RoundTripper) *HTTPReadOnlyTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &HTTPReadOnlyTransport{next: next}
+}
+
+func (t *HTTPReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		//nolint:wrapcheck
+		return t.next.RoundTrip(req)
+	}
+
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	return nil, fmt.Errorf("%w: refusing %s %s", errBrazeReadOnly, req.Method, req.URL.Path)
+}
@@ -0,0 +1,43 @@
+//nolint:testpackage
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPReadOnlyTransport(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	client := &http.Client{Transport: NewHTTPReadOnlyTransport(server.Client().Transport)}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/content_blocks/list", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL+"/content_blocks/create", strings.NewReader(`{}`))
+	require.NoError(t, err)
+
+	_, err = client.Do(req) //nolint:bodyclose
+	require.ErrorIs(t, err, errBrazeReadOnly)
+	assert.Contains(t, detailFromError(err), "read_only")
+	assert.Equal(t, int64(1), requests.Load())
+}
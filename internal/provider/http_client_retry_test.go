@@ -0,0 +1,91 @@
+//nolint:testpackage
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	brazeclient "github.com/cysp/terraform-provider-braze/internal/braze-client-go"
+	brazeclienttesting "github.com/cysp/terraform-provider-braze/internal/braze-client-go/testing"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestBrazeRetryingHTTPClientRetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	server, client := newRetryingTestContentBlockClient(t)
+
+	server.AddFault(brazeclient.CreateContentBlockOperation, brazeclienttesting.Fault{Times: 2, StatusCode: http.StatusServiceUnavailable})
+
+	created, err := client.Create(t.Context(), brazeContentBlockModel{
+		Name:    types.StringValue("content-block"),
+		Content: types.StringValue("lorem ipsum"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "lorem ipsum", created.Content.ValueString())
+	assert.Zero(t, server.PendingFaults(brazeclient.CreateContentBlockOperation))
+	assert.Equal(t, 1, server.CallCount(brazeclient.CreateContentBlockOperation))
+}
+
+func TestBrazeRetryingHTTPClientWaitsForRateLimitReset(t *testing.T) {
+	t.Parallel()
+
+	server, client := newRetryingTestContentBlockClient(t)
+	server.SetContentBlock("content-block", "content-block", "lorem ipsum", "", nil)
+	server.SetRateLimit(1, time.Second)
+
+	start := time.Now()
+
+	for range 3 {
+		_, err := client.Read(t.Context(), "content-block")
+		require.NoError(t, err)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Equal(t, 3, server.CallCount(brazeclient.GetContentBlockInfoOperation))
+}
+
+func TestBrazeRetryingHTTPClientRetriesDroppedUpdate(t *testing.T) {
+	t.Parallel()
+
+	server, client := newRetryingTestContentBlockClient(t)
+	server.SetContentBlock("content-block", "content-block", "lorem ipsum", "", nil)
+
+	server.AddFault(brazeclient.UpdateContentBlockOperation, brazeclienttesting.Fault{Times: 1, DropAfterHandle: true})
+
+	updated, err := client.Update(t.Context(), brazeContentBlockModel{
+		IDIdentityModel: IDIdentityModel{ID: types.StringValue("content-block")},
+		Name:            types.StringValue("content-block"),
+		Content:         types.StringValue("dolor sit amet"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "dolor sit amet", updated.Content.ValueString())
+	assert.Zero(t, server.PendingFaults(brazeclient.UpdateContentBlockOperation))
+	assert.Equal(t, 2, server.CallCount(brazeclient.UpdateContentBlockOperation))
+}
+
+func newRetryingTestContentBlockClient(t *testing.T) (*brazeclienttesting.Server, generatedContentBlockClient) {
+	t.Helper()
+
+	server, err := brazeclienttesting.NewBrazeServer()
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	client, err := brazeclient.NewClient(
+		httpServer.URL,
+		NewBrazeAPIKeySecuritySource("test"),
+		brazeclient.WithClient(newBrazeRetryingHTTPClient(httpServer.Client(), noop.NewTracerProvider().Tracer("test"))),
+	)
+	require.NoError(t, err)
+
+	return server, newGeneratedContentBlockClient(client, brazeLogPayloadsMetadata)
+}
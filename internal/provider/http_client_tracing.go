@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type httpAttemptCounterContextKey struct{}
+
+// HTTPAttemptCountingTransport marks each request with a counter that
+// HTTPAttemptTracingTransport increments for every attempt made on its behalf.
+// It belongs above the retrying transport, which reuses the request context
+// for each retry.
+type HTTPAttemptCountingTransport struct {
+	next http.RoundTripper
+}
+
+func NewHTTPAttemptCountingTransport(next http.RoundTripper) *HTTPAttemptCountingTransport {
+	return &HTTPAttemptCountingTransport{next: next}
+}
+
+func (t *HTTPAttemptCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := context.WithValue(req.Context(), httpAttemptCounterContextKey{}, &atomic.Int64{})
+
+	//nolint:wrapcheck
+	return t.next.RoundTrip(req.WithContext(ctx))
+}
+
+// HTTPAttemptTracingTransport records a client span for every HTTP attempt,
+// including retries, so that retry and rate limit waits are visible as gaps
+// between attempts within the enclosing operation span.
+type HTTPAttemptTracingTransport struct {
+	next   http.RoundTripper
+	tracer trace.Tracer
+}
+
+func NewHTTPAttemptTracingTransport(next http.RoundTripper, tracer trace.Tracer) *HTTPAttemptTracingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &HTTPAttemptTracingTransport{
+		next:   next,
+		tracer: tracer,
+	}
+}
+
+func (t *HTTPAttemptTracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.path", req.URL.Path),
+	}
+
+	if counter, ok := req.Context().Value(httpAttemptCounterContextKey{}).(*atomic.Int64); ok {
+		if resendCount := counter.Add(1) - 1; resendCount > 0 {
+			attributes = append(attributes, attribute.Int64("http.request.resend_count", resendCount))
+		}
+	}
+
+	ctx, span := t.tracer.Start(req.Context(), "HTTP "+req.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	defer span.End()
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		//nolint:wrapcheck
+		return resp, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if delay, ok := rateLimitDelay(resp.Header, time.Now); ok {
+			span.AddEvent("rate_limited", trace.WithAttributes(attribute.Int64("braze.rate_limit.delay_ms", delay.Milliseconds())))
+		}
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+
+	return resp, nil
+}
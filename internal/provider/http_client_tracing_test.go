@@ -0,0 +1,79 @@
+//nolint:testpackage
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestHTTPAttemptTracingTransportRecordsRetries(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	retryableClient := retryablehttp.NewClient()
+	retryableClient.Logger = nil
+	retryableClient.RetryWaitMin = time.Millisecond
+	retryableClient.RetryWaitMax = time.Millisecond
+	retryableClient.Backoff = brazeRateLimitBackoff
+	retryableClient.HTTPClient = server.Client()
+	retryableClient.HTTPClient.Transport = NewHTTPAttemptTracingTransport(retryableClient.HTTPClient.Transport, tracerProvider.Tracer("test"))
+
+	client := retryableClient.StandardClient()
+	client.Transport = NewHTTPAttemptCountingTransport(client.Transport)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL+"/catalogs", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	first := attributeMap(spans[0].Attributes())
+	assert.Equal(t, "/catalogs", first["url.path"].AsString())
+	assert.Equal(t, int64(http.StatusTooManyRequests), first["http.response.status_code"].AsInt64())
+	assert.NotContains(t, first, attribute.Key("http.request.resend_count"))
+	require.Len(t, spans[0].Events(), 1)
+	assert.Equal(t, "rate_limited", spans[0].Events()[0].Name)
+
+	second := attributeMap(spans[1].Attributes())
+	assert.Equal(t, int64(http.StatusOK), second["http.response.status_code"].AsInt64())
+	assert.Equal(t, int64(1), second["http.request.resend_count"].AsInt64())
+}
+
+func attributeMap(attributes []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	values := make(map[attribute.Key]attribute.Value, len(attributes))
+	for _, kv := range attributes {
+		values[kv.Key] = kv.Value
+	}
+
+	return values
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+var (
+	errHTTPTransportNotConfigurable = errors.New("http client transport cannot be configured")
+	errHTTPTransportProxyURL        = errors.New("proxy_url must be an http, https or socks5 URL")
+	errHTTPTransportCABundle        = errors.New("ca_bundle_file contains no PEM certificates")
+	errHTTPTransportClientKeyPair   = errors.New("client_certificate and client_key must be set together")
+)
+
+// HTTPTransportOptions are the network settings of the transport that
+// carries requests to Braze.
+type HTTPTransportOptions struct {
+	// ProxyURL replaces the proxy taken from the environment. Credentials in
+	// the URL are used to authenticate with the proxy.
+	ProxyURL string
+	// CABundleFile is a PEM file of certificates trusted in addition to the
+	// system roots.
+	CABundleFile string
+	// ClientCertificate and ClientKey are a PEM certificate and key presented
+	// to servers that require mutual TLS.
+	ClientCertificate string
+	ClientKey         string
+	// InsecureSkipVerify disables server certificate verification.
+	InsecureSkipVerify bool
+}
+
+func (o HTTPTransportOptions) isZero() bool {
+	return o == HTTPTransportOptions{}
+}
+
+// NewHTTPTransport returns a copy of base, or of http.DefaultTransport when
+// base is nil, with the options applied.
+func NewHTTPTransport(base http.RoundTripper, options HTTPTransportOptions) (*http.Transport, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	baseTransport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errHTTPTransportNotConfigurable, base)
+	}
+
+	transport := baseTransport.Clone()
+
+	if options.ProxyURL != "" {
+		proxyURL, err := url.Parse(options.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse proxy_url: %w", err)
+		}
+
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, errHTTPTransportProxyURL
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	if options.CABundleFile != "" {
+		rootCAs, err := loadHTTPTransportCABundle(options.CABundleFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (options.ClientCertificate == "") != (options.ClientKey == "") {
+		return nil, errHTTPTransportClientKeyPair
+	}
+
+	if options.ClientCertificate != "" {
+		certificate, err := tls.X509KeyPair([]byte(options.ClientCertificate), []byte(options.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("load client_certificate and client_key: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	//nolint:gosec // Verification is only disabled when explicitly configured.
+	tlsConfig.InsecureSkipVerify = tlsConfig.InsecureSkipVerify || options.InsecureSkipVerify
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+func loadHTTPTransportCABundle(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read ca_bundle_file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errHTTPTransportCABundle
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,174 @@
+//nolint:testpackage
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPTransportCABundle(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	caBundleFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caBundleFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	transport, err := NewHTTPTransport(nil, HTTPTransportOptions{CABundleFile: caBundleFile})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, doTestTransportGet(t, transport, server.URL))
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = http.DefaultTransport.RoundTrip(req) //nolint:bodyclose
+	require.ErrorAs(t, err, new(x509.UnknownAuthorityError))
+}
+
+func TestNewHTTPTransportInsecureSkipVerify(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	transport, err := NewHTTPTransport(nil, HTTPTransportOptions{InsecureSkipVerify: true})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, doTestTransportGet(t, transport, server.URL))
+}
+
+func TestNewHTTPTransportProxyURL(t *testing.T) {
+	t.Parallel()
+
+	var proxiedURL, proxyAuthorization string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		proxyAuthorization = r.Header.Get("Proxy-Authorization")
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(proxy.Close)
+
+	transport, err := NewHTTPTransport(nil, HTTPTransportOptions{ProxyURL: "http://user:secret@" + proxy.Listener.Addr().String()})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, doTestTransportGet(t, transport, "http://rest.braze.invalid/catalogs"))
+
+	assert.Equal(t, "http://rest.braze.invalid/catalogs", proxiedURL)
+	assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), proxyAuthorization)
+}
+
+func TestNewHTTPTransportClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	certificatePEM, keyPEM, certificate := newTestClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(certificate)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Len(t, r.TLS.PeerCertificates, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	transport, err := NewHTTPTransport(nil, HTTPTransportOptions{
+		ClientCertificate:  string(certificatePEM),
+		ClientKey:          string(keyPEM),
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, doTestTransportGet(t, transport, server.URL))
+}
+
+func TestNewHTTPTransportInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	certificatePEM, _, _ := newTestClientCertificate(t)
+
+	_, err := NewHTTPTransport(nil, HTTPTransportOptions{ClientCertificate: string(certificatePEM)})
+	require.ErrorIs(t, err, errHTTPTransportClientKeyPair)
+
+	_, err = NewHTTPTransport(nil, HTTPTransportOptions{ProxyURL: "ftp://proxy.example.com"})
+	require.ErrorIs(t, err, errHTTPTransportProxyURL)
+
+	caBundleFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caBundleFile, []byte("not a certificate"), 0o600))
+
+	_, err = NewHTTPTransport(nil, HTTPTransportOptions{CABundleFile: caBundleFile})
+	require.ErrorIs(t, err, errHTTPTransportCABundle)
+
+	_, err = NewHTTPTransport(NewHTTPReadOnlyTransport(nil), HTTPTransportOptions{InsecureSkipVerify: true})
+	require.ErrorIs(t, err, errHTTPTransportNotConfigurable)
+}
+
+func doTestTransportGet(t *testing.T, transport http.RoundTripper, url string) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	return resp.StatusCode
+}
+
+func newTestClientCertificate(t *testing.T) ([]byte, []byte, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-braze"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		certificate
+}
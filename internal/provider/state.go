@@ -33,6 +33,24 @@ func setNamedIdentityAndState(ctx context.Context, identity stateAttributeValueS
 	return diags
 }
 
+func setEmailIdentityAndState(ctx context.Context, identity stateAttributeValueSettable, state stateValueSettable, email string, value any) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("email"), email)...)
+	diags.Append(state.Set(ctx, value)...)
+
+	return diags
+}
+
+func setScheduleIdentityAndState(ctx context.Context, identity stateAttributeValueSettable, state stateValueSettable, scheduleID string, value any) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("schedule_id"), scheduleID)...)
+	diags.Append(state.Set(ctx, value)...)
+
+	return diags
+}
+
 func setCatalogItemIdentityAndState(
 	ctx context.Context,
 	identity stateAttributeValueSettable,
@@ -49,3 +67,31 @@ func setCatalogItemIdentityAndState(
 
 	return diags
 }
+
+func setTriggerScheduleIdentityAndState(
+	ctx context.Context,
+	identity stateAttributeValueSettable,
+	state stateValueSettable,
+	ownerAttribute string,
+	ownerID string,
+	scheduleID string,
+	value any,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(ownerAttribute), ownerID)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("schedule_id"), scheduleID)...)
+	diags.Append(state.Set(ctx, value)...)
+
+	return diags
+}
+
+func setSendIDIdentityAndState(ctx context.Context, identity stateAttributeValueSettable, state stateValueSettable, value *brazeSendIDModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("campaign_id"), value.CampaignID)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("send_id"), value.SendID)...)
+	diags.Append(state.Set(ctx, value)...)
+
+	return diags
+}
@@ -0,0 +1,8 @@
+resource "braze_preference_center" "test" {
+  name                        = var.preference_center_name
+  preference_center_title     = var.preference_center_title
+  preference_center_page_html = var.preference_center_page_html
+  confirmation_page_html      = var.confirmation_page_html
+  state                       = var.preference_center_state
+  options                     = var.preference_center_options
+}
@@ -0,0 +1,36 @@
+variable "preference_center_name" {
+  type    = string
+  default = "test_preference_center"
+}
+
+variable "preference_center_title" {
+  type    = string
+  default = null
+}
+
+variable "preference_center_page_html" {
+  type    = string
+  default = "<p>{{ preference_center_form }}</p>"
+}
+
+variable "confirmation_page_html" {
+  type    = string
+  default = "<p>Saved</p>"
+}
+
+variable "preference_center_state" {
+  type    = string
+  default = null
+}
+
+variable "preference_center_options" {
+  type = object({
+    meta_viewport_content = optional(string)
+    links_tags = optional(list(object({
+      rel  = string
+      type = optional(string)
+      href = string
+    })))
+  })
+  default = null
+}